@@ -0,0 +1,253 @@
+package nocodbgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Attachment is a file of an Attachment field
+type Attachment struct {
+	// Title is the name of the file
+	Title string `json:"title,omitempty"`
+	// MimeType is the MIME type of the file (e.g. "image/png")
+	MimeType string `json:"mimetype,omitempty"`
+	// Size is the size of the file in bytes
+	Size int64 `json:"size,omitempty"`
+	// URL is the URL of the file in an external storage, like S3, empty for the local storage
+	URL string `json:"url,omitempty"`
+	// Path is the path of the file in the local storage of the server, empty for external storages
+	Path string `json:"path,omitempty"`
+	// SignedURL is the temporary URL to download the file from an external storage
+	SignedURL string `json:"signedUrl,omitempty"`
+	// SignedPath is the temporary path to download the file from the local storage of the server
+	SignedPath string `json:"signedPath,omitempty"`
+
+	// source is the cell the attachment was read from, nil if it was decoded by other means
+	source *attachmentSource
+}
+
+// attachmentSource is the cell an attachment was read from, used to refresh its signed URLs
+type attachmentSource struct {
+	table    *Table
+	recordID RecordID
+	column   string
+}
+
+// readAttachmentsBuilder is used to build a query that reads the attachments of a cell with a fluent API
+type readAttachmentsBuilder struct {
+	table    *Table
+	recordID any
+	column   string
+
+	contextProvider[*readAttachmentsBuilder]
+}
+
+// ReadAttachments reads the attachments of an Attachment field of a record.
+//
+// The returned attachments remember the record they were read from, so Attachment.Download can
+// get a fresh signed URL when the one read has expired.
+//
+// Parameters:
+//   - recordID: The identifier of the record, can be a RecordID, any integer type or a string.
+//   - column:   The title of the Attachment field.
+//
+// Example:
+//
+//	attachments, err := table.ReadAttachments(recordID, "Invoice").Execute()
+//	// Handle error
+//	err = attachments[0].Download(ctx, file)
+func (t *Table) ReadAttachments(recordID any, column string) *readAttachmentsBuilder {
+	b := &readAttachmentsBuilder{
+		table:    t,
+		recordID: recordID,
+		column:   column,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *readAttachmentsBuilder) Execute() ([]Attachment, error) {
+	id, err := parseRecordID(b.recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := b.table.ReadRecord(id).ReturnFields(b.column).WithContext(b.contextProvider.ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := decodeAttachments(record.Data[b.column])
+	if err != nil {
+		return nil, withOperation(err, "ReadAttachments", b.table.tableID, id)
+	}
+
+	source := &attachmentSource{table: b.table, recordID: id, column: b.column}
+	for i := range attachments {
+		attachments[i].source = source
+	}
+
+	return attachments, nil
+}
+
+// decodeAttachments decodes the value of an Attachment field, which is a list of attachments or,
+// in some databases, the JSON encoding of the list as a string.
+func decodeAttachments(value any) ([]Attachment, error) {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		return []Attachment{}, nil
+	case string:
+		data = []byte(v)
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, fmt.Errorf("failed to marshal attachments: %w", err)
+		}
+	}
+
+	var attachments []Attachment
+	if err := json.Unmarshal(data, &attachments); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attachments: %w", err)
+	}
+	if attachments == nil {
+		attachments = []Attachment{}
+	}
+
+	return attachments, nil
+}
+
+// RefreshURL reads the attachment again from its record, returning it with fresh signed URLs.
+//
+// It fails with ErrAttachmentNotBound if the attachment wasn't read with Table.ReadAttachments,
+// and with ErrAttachmentNotFound if it was removed from the record.
+func (a Attachment) RefreshURL(ctx context.Context) (Attachment, error) {
+	if a.source == nil {
+		return Attachment{}, ErrAttachmentNotBound
+	}
+
+	attachments, err := a.source.table.ReadAttachments(a.source.recordID, a.source.column).WithContext(ctx).Execute()
+	if err != nil {
+		return Attachment{}, fmt.Errorf("failed to refresh attachment URL: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		if a.sameFile(attachment) {
+			return attachment, nil
+		}
+	}
+
+	return Attachment{}, fmt.Errorf("%w: %s", ErrAttachmentNotFound, a.Title)
+}
+
+// sameFile reports whether both attachments are the same stored file, ignoring their signed URLs.
+func (a Attachment) sameFile(other Attachment) bool {
+	switch {
+	case a.Path != "":
+		return a.Path == other.Path
+	case a.URL != "":
+		return a.URL == other.URL
+	}
+	return a.Title == other.Title && a.Size == other.Size
+}
+
+// Download writes the content of the file to w, streaming it without keeping it in memory.
+//
+// When the signed URL of the attachment has expired, a fresh one is read from the record with
+// RefreshURL and the download is retried once, so attachments read long ago can still be
+// downloaded.
+//
+// The download goes through the operation policy and the rate limit callback of the client. Files
+// stored in the server are requested with the API token and the request signer, which are not
+// sent to external storages.
+//
+// Example:
+//
+//	file, err := os.Create(attachment.Title)
+//	// Handle error
+//	defer file.Close()
+//
+//	err = attachment.Download(ctx, file)
+func (a Attachment) Download(ctx context.Context, w io.Writer) error {
+	if a.source == nil {
+		return ErrAttachmentNotBound
+	}
+
+	current := a
+	if current.downloadURL() == "" {
+		refreshed, err := a.RefreshURL(ctx)
+		if err != nil {
+			return err
+		}
+		if refreshed.downloadURL() == "" {
+			return fmt.Errorf("failed to download attachment %s: the attachment has no URL", a.Title)
+		}
+		current = refreshed
+	}
+
+	resp, err := current.get(ctx)
+	var opErr *OperationError
+	if errors.As(err, &opErr) && expiredStatus(opErr.StatusCode) {
+		refreshed, refreshErr := a.RefreshURL(ctx)
+		if refreshErr != nil {
+			return refreshErr
+		}
+		resp, err = refreshed.get(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download attachment %s: %w", a.Title, err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download attachment %s: %w", a.Title, err)
+	}
+
+	return nil
+}
+
+// downloadURL returns the URL to download the file from, preferring the signed URLs, or an empty
+// string if the attachment has none.
+func (a Attachment) downloadURL() string {
+	for _, candidate := range []string{a.SignedURL, a.SignedPath, a.URL, a.Path} {
+		if candidate != "" {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// get starts the download of the file through the client, so its operation policy and rate limit
+// callback apply. Paths in the server are sent with the API token and signed, while URLs of
+// external storages are requested without them.
+func (a Attachment) get(ctx context.Context) (*http.Response, error) {
+	client := a.source.table.client
+
+	target := a.downloadURL()
+	if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+		return client.openStream(ctx, http.MethodGet, target, nil, nil, nil)
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return nil, &OperationError{Method: http.MethodGet, Path: target, Err: fmt.Errorf("failed to parse URL: %w", err)}
+	}
+	onServer := strings.HasPrefix(target, client.baseURL+"/")
+
+	return client.openURL(ctx, http.MethodGet, parsed.EscapedPath(), parsed, onServer, nil, nil)
+}
+
+// expiredStatus reports whether the status code of a download is the one of an expired signed
+// URL, which external storages answer with 401 or 403 and the server with 404 or 410.
+func expiredStatus(statusCode int) bool {
+	return statusCode == http.StatusForbidden || statusCode == http.StatusNotFound || statusCode == http.StatusGone || statusCode == http.StatusUnauthorized
+}
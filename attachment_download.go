@@ -0,0 +1,154 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// attachmentDownloadConcurrency is the maximum number of attachments DownloadAttachments downloads at once
+const attachmentDownloadConcurrency = 4
+
+// DownloadAttachments downloads all the attachments of an Attachment field of a record to the
+// destination directory, several at a time, and returns the paths of the written files in the
+// order of the attachments.
+//
+// The files are named after the titles of the attachments, reduced to a plain file name so a
+// title can't write outside the directory, and numbered when several attachments have the same
+// name (e.g. "photo (2).jpg"). Existing files with the same names are replaced. Each file is
+// written to a temporary file first, so a failed download doesn't leave a partial file behind.
+//
+// Parameters:
+//   - recordID: The identifier of the record, can be a RecordID, any integer type or a string.
+//   - column:   The title of the Attachment field.
+//   - destDir:  The directory to write the files to, which is created if it doesn't exist.
+//
+// Example:
+//
+//	paths, err := table.DownloadAttachments(ctx, recordID, "Photos", "downloads/photos")
+func (t *Table) DownloadAttachments(ctx context.Context, recordID any, column string, destDir string) ([]string, error) {
+	attachments, err := t.ReadAttachments(recordID, column).WithContext(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create destination directory: %w", err)
+	}
+
+	names := attachmentFileNames(attachments)
+	paths := make([]string, len(attachments))
+	errs := make([]error, len(attachments))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, attachmentDownloadConcurrency)
+	for i, attachment := range attachments {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			path := filepath.Join(destDir, names[i])
+			if err := downloadAttachmentFile(ctx, attachment, path); err != nil {
+				errs[i] = err
+				// The other downloads are stopped, since the result is incomplete anyway
+				cancel()
+				return
+			}
+			paths[i] = path
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// downloadAttachmentFile downloads an attachment to a temporary file next to path, which is
+// renamed to path once complete.
+func downloadAttachmentFile(ctx context.Context, attachment Attachment, path string) error {
+	file, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file for attachment %s: %w", attachment.Title, err)
+	}
+	defer os.Remove(file.Name())
+
+	if err := attachment.Download(ctx, file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write attachment %s: %w", attachment.Title, err)
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("failed to write attachment %s: %w", attachment.Title, err)
+	}
+
+	return nil
+}
+
+// attachmentFileNames returns a safe and unique file name for each attachment.
+func attachmentFileNames(attachments []Attachment) []string {
+	names := make([]string, len(attachments))
+	used := map[string]bool{}
+
+	for i, attachment := range attachments {
+		name := safeFileName(attachment.Title)
+		ext := filepath.Ext(name)
+		stem := strings.TrimSuffix(name, ext)
+
+		for n := 2; used[strings.ToLower(name)]; n++ {
+			name = stem + " (" + strconv.Itoa(n) + ")" + ext
+		}
+
+		used[strings.ToLower(name)] = true
+		names[i] = name
+	}
+
+	return names
+}
+
+// safeFileName reduces a title to a file name without directories, control characters or the
+// characters not allowed in file names by common file systems.
+func safeFileName(title string) string {
+	// Titles can use both separators, whatever the operating system
+	title = title[strings.LastIndexAny(title, `/\`)+1:]
+
+	name := strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(`<>:"|?*`, r) {
+			return '_'
+		}
+		return r
+	}, title)
+
+	name = strings.TrimRight(strings.TrimSpace(name), ".")
+	if name == "" || strings.Trim(name, ".") == "" {
+		return "attachment"
+	}
+
+	return name
+}
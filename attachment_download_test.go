@@ -0,0 +1,87 @@
+package nocodbgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDownloadAttachments(t *testing.T) {
+	var active, maxActive atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/md_photos/records/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id":1,"Photos":[
+			{"title":"beach.jpg","path":"download/a"},
+			{"title":"../../etc/passwd","path":"download/b"},
+			{"title":"Beach.jpg","path":"download/c"},
+			{"title":"beach.jpg","path":"download/d"},
+			{"title":"..","path":"download/e"},
+			{"title":"a:b?.txt","path":"download/f"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/tables/md_photos/records/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id":2,"Photos":[{"title":"ok.jpg","path":"download/a"},{"title":"gone.jpg","path":"download/missing"}]}`))
+	})
+	mux.HandleFunc("GET /download/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("name") == "missing" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if n := active.Add(1); n > maxActive.Load() {
+			maxActive.Store(n)
+		}
+		time.Sleep(10 * time.Millisecond)
+		active.Add(-1)
+		_, _ = w.Write([]byte("content " + r.PathValue("name")))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("md_photos")
+	dir := filepath.Join(t.TempDir(), "photos")
+
+	paths, err := table.DownloadAttachments(context.Background(), 1, "Photos", dir)
+	if err != nil {
+		t.Fatalf("DownloadAttachments() error = %v", err)
+	}
+
+	var names []string
+	for _, path := range paths {
+		if filepath.Dir(path) != dir {
+			t.Errorf("DownloadAttachments() wrote %q outside %q", path, dir)
+		}
+		names = append(names, filepath.Base(path))
+	}
+	want := []string{"beach.jpg", "passwd", "Beach (2).jpg", "beach (3).jpg", "attachment", "a_b_.txt"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("DownloadAttachments() names = %q, want %q", names, want)
+	}
+	if content, _ := os.ReadFile(paths[3]); string(content) != "content d" {
+		t.Errorf("content of %s = %q", paths[3], content)
+	}
+	if maxActive.Load() > attachmentDownloadConcurrency {
+		t.Errorf("downloaded %d attachments at once, want at most %d", maxActive.Load(), attachmentDownloadConcurrency)
+	}
+
+	failedDir := filepath.Join(t.TempDir(), "failed")
+	if _, err := table.DownloadAttachments(context.Background(), 2, "Photos", failedDir); err == nil {
+		t.Error("DownloadAttachments() should fail when an attachment can't be downloaded")
+	}
+	entries, _ := os.ReadDir(failedDir)
+	for _, entry := range entries {
+		if entry.Name() != "ok.jpg" {
+			t.Errorf("DownloadAttachments() left %q behind", entry.Name())
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAttachmentDownload(t *testing.T) {
+	signedPath := "dltemp/old/invoice.pdf"
+	var reads int
+	var token string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/md_invoices/records/1", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		if r.URL.Query().Get("fields") != "Files" {
+			t.Errorf("fields = %q", r.URL.Query().Get("fields"))
+		}
+		_, _ = w.Write([]byte(`{"Id":1,"Files":[
+			{"title":"invoice.pdf","mimetype":"application/pdf","size":7,"path":"download/invoice.pdf","signedPath":"` + signedPath + `"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/tables/md_invoices/records/2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id":2,"Files":"[{\"title\":\"a.txt\",\"path\":\"download/a.txt\"}]"}`))
+	})
+	mux.HandleFunc("GET /dltemp/{token}/invoice.pdf", func(w http.ResponseWriter, r *http.Request) {
+		token = r.Header.Get("xc-token")
+		if r.PathValue("token") != "new" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("%PDF-1."))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("md_invoices")
+	ctx := context.Background()
+
+	attachments, err := table.ReadAttachments(1, "Files").WithContext(ctx).Execute()
+	if err != nil {
+		t.Fatalf("ReadAttachments() error = %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].Title != "invoice.pdf" || attachments[0].Size != 7 {
+		t.Fatalf("ReadAttachments() = %+v", attachments)
+	}
+
+	// The signed path expires before the download
+	signedPath = "dltemp/new/invoice.pdf"
+	var content bytes.Buffer
+	if err := attachments[0].Download(ctx, &content); err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	if content.String() != "%PDF-1." || reads != 2 || token != "test-token" {
+		t.Errorf("Download() = %q after %d reads with token %q", content.String(), reads, token)
+	}
+
+	refreshed, err := attachments[0].RefreshURL(ctx)
+	if err != nil {
+		t.Fatalf("RefreshURL() error = %v", err)
+	}
+	if refreshed.SignedPath != "dltemp/new/invoice.pdf" {
+		t.Errorf("RefreshURL() = %+v", refreshed)
+	}
+
+	attachments, err = table.ReadAttachments(2, "Files").Execute()
+	if err != nil {
+		t.Fatalf("ReadAttachments() error = %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].Path != "download/a.txt" {
+		t.Errorf("ReadAttachments() = %+v", attachments)
+	}
+
+	if err := (Attachment{Title: "a.txt"}).Download(ctx, &content); !errors.Is(err, ErrAttachmentNotBound) {
+		t.Errorf("Download() error = %v, want %v", err, ErrAttachmentNotBound)
+	}
+}
+
+func TestAttachmentDownloadThroughClient(t *testing.T) {
+	var externalHeader http.Header
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		externalHeader = r.Header.Clone()
+		_, _ = w.Write([]byte("external"))
+	}))
+	defer storage.Close()
+
+	limited := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/md_files/records/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id":1,"Files":[
+			{"title":"a.txt","path":"download/a.txt"},
+			{"title":"b.txt","url":"` + storage.URL + `/bucket/b.txt"},
+			{"title":"c.txt","path":"private/c.txt"}
+		]}`))
+	})
+	mux.HandleFunc("GET /download/a.txt", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "signed" || r.Header.Get("xc-token") != "test-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if limited {
+			w.Header().Set("X-Ratelimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"msg":"too many requests"}`))
+			return
+		}
+		_, _ = w.Write([]byte("server"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var rateLimits []RateLimit
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithRequestSigner(RequestSignerFunc(func(req *http.Request, body []byte) error {
+			req.Header.Set("X-Signature", "signed")
+			return nil
+		})).
+		WithRateLimitCallback(func(rateLimit RateLimit) { rateLimits = append(rateLimits, rateLimit) }).
+		WithOperationPolicy(func(op Operation) error {
+			if strings.HasPrefix(op.Path, "/private/") {
+				return errors.New("private files can't be downloaded")
+			}
+			return nil
+		}).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	ctx := context.Background()
+
+	attachments, err := client.Table("md_files").ReadAttachments(1, "Files").WithContext(ctx).Execute()
+	if err != nil || len(attachments) != 3 {
+		t.Fatalf("ReadAttachments() = %+v, %v", attachments, err)
+	}
+
+	// Files in the server are signed and sent with the API token
+	var content bytes.Buffer
+	if err := attachments[0].Download(ctx, &content); err != nil || content.String() != "server" {
+		t.Errorf("Download() = %q, %v, want %q", content.String(), err, "server")
+	}
+
+	// Neither reach external storages
+	content.Reset()
+	if err := attachments[1].Download(ctx, &content); err != nil || content.String() != "external" {
+		t.Errorf("Download() = %q, %v, want %q", content.String(), err, "external")
+	}
+	if externalHeader.Get("xc-token") != "" || externalHeader.Get("X-Signature") != "" {
+		t.Errorf("external storage headers = %v, want no token nor signature", externalHeader)
+	}
+
+	if err := attachments[2].Download(ctx, &content); !errors.Is(err, ErrOperationDenied) {
+		t.Errorf("Download() error = %v, want %v", err, ErrOperationDenied)
+	}
+
+	limited = true
+	err = attachments[0].Download(ctx, &content)
+	var opErr *OperationError
+	if !errors.As(err, &opErr) || opErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Download() error = %v, want a 429", err)
+	}
+	if len(rateLimits) != 1 || rateLimits[0].Path != "/download/a.txt" {
+		t.Errorf("rate limits = %+v, want the limit of the download", rateLimits)
+	}
+}
@@ -0,0 +1,232 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// uploadPath is the path of the storage endpoint that uploads attachments
+const uploadPath = "/api/v2/storage/upload"
+
+// uploadAttachmentBuilder is used to build an attachment upload with a fluent API
+type uploadAttachmentBuilder struct {
+	client     *Client
+	filename   string
+	reader     io.Reader
+	mimeType   string
+	size       int64
+	path       string
+	onProgress func(sent int64, total int64)
+
+	contextProvider[*uploadAttachmentBuilder]
+}
+
+// UploadAttachment uploads a file to the storage of NocoDB and returns the Attachment to set in
+// an Attachment field of a record.
+//
+// The file is streamed from the reader to the server with a chunked request, so it is never
+// loaded in memory and files of hundreds of megabytes can be uploaded. The reader is not closed.
+//
+// Parameters:
+//   - filename: The name of the file, which is also the title of the attachment.
+//   - r:        The content of the file.
+//
+// Example:
+//
+//	file, err := os.Open("report.pdf")
+//	// Handle error
+//	defer file.Close()
+//	info, err := file.Stat()
+//	// Handle error
+//
+//	attachment, err := client.UploadAttachment("report.pdf", file).
+//		WithSize(info.Size()).
+//		OnProgress(func(sent int64, total int64) {
+//			fmt.Printf("\r%d of %d bytes", sent, total)
+//		}).
+//		Execute()
+//	// Handle error
+//	err = table.UpdateRecord(map[string]any{"Id": recordID, "Files": []nocodbgo.Attachment{attachment}}).Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Storage/operation/storage-upload
+func (c *Client) UploadAttachment(filename string, r io.Reader) *uploadAttachmentBuilder {
+	b := &uploadAttachmentBuilder{
+		client:   c,
+		filename: filename,
+		reader:   r,
+		size:     -1,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// WithMimeType sets the MIME type of the file, which is guessed from the extension of the file
+// name by default.
+func (b *uploadAttachmentBuilder) WithMimeType(mimeType string) *uploadAttachmentBuilder {
+	b.mimeType = mimeType
+	return b
+}
+
+// WithSize sets the size of the file in bytes, which is passed as the total to the progress
+// callback. Without it the total is -1.
+func (b *uploadAttachmentBuilder) WithSize(size int64) *uploadAttachmentBuilder {
+	b.size = size
+	return b
+}
+
+// WithPath sets the folder of the storage the file is uploaded to (e.g. "noco/crm/invoices").
+func (b *uploadAttachmentBuilder) WithPath(path string) *uploadAttachmentBuilder {
+	b.path = path
+	return b
+}
+
+// OnProgress sets a callback that is called as the file is sent, with the bytes sent so far and
+// the size set with WithSize, or -1 if it's unknown.
+func (b *uploadAttachmentBuilder) OnProgress(onProgress func(sent int64, total int64)) *uploadAttachmentBuilder {
+	b.onProgress = onProgress
+	return b
+}
+
+// Execute finalizes and executes the operation, returning the uploaded attachment.
+func (b *uploadAttachmentBuilder) Execute() (Attachment, error) {
+	if b.filename == "" {
+		return Attachment{}, errors.New("file name is required")
+	}
+	if b.reader == nil {
+		return Attachment{}, errors.New("file content is required")
+	}
+
+	info := UploadInfo{Filename: b.filename, MimeType: b.contentType(), Size: b.size, Path: b.path}
+	reader := b.reader
+	for _, interceptor := range b.client.uploadInterceptors {
+		var err error
+		if reader, err = interceptor(b.contextProvider.ctx, info, reader); err != nil {
+			return Attachment{}, fmt.Errorf("%w: %s: %w", ErrUploadRejected, b.filename, err)
+		}
+		if reader == nil {
+			return Attachment{}, fmt.Errorf("%w: %s: the upload interceptor returned no reader", ErrUploadRejected, b.filename)
+		}
+	}
+
+	respBody, err := b.send(info, reader)
+	if err != nil {
+		return Attachment{}, withOperation(fmt.Errorf("failed to upload attachment: %w", err), "UploadAttachment", "", RecordID{})
+	}
+
+	var attachments []Attachment
+	if err := json.Unmarshal(respBody, &attachments); err != nil {
+		return Attachment{}, fmt.Errorf("failed to unmarshal upload response: %w", err)
+	}
+	if len(attachments) == 0 {
+		return Attachment{}, fmt.Errorf("failed to upload attachment: the response has no attachment")
+	}
+
+	return attachments[0], nil
+}
+
+// send streams the file to the storage endpoint as a multipart form, returning the response body.
+func (b *uploadAttachmentBuilder) send(info UploadInfo, reader io.Reader) ([]byte, error) {
+	var query url.Values
+	if b.path != "" {
+		query = url.Values{"path": {b.path}}
+	}
+
+	// The form is written to the request body as it is sent, so the file is never fully in memory
+	// unless the client has a request signer, which needs the whole body
+	pr, pw := io.Pipe()
+	form := multipart.NewWriter(pw)
+	written := make(chan error, 1)
+	go func() {
+		err := b.writeForm(form, info, reader)
+		pw.CloseWithError(err)
+		written <- err
+	}()
+
+	header := http.Header{"Content-Type": {form.FormDataContentType()}}
+	resp, err := b.client.openStream(b.contextProvider.ctx, http.MethodPost, uploadPath, query, header, pr)
+	// Stop the writer if the request ended before reading the whole form
+	pr.CloseWithError(io.ErrClosedPipe)
+	writeErr := <-written
+	if err != nil {
+		// Report why the form couldn't be written instead of the closed pipe
+		var opErr *OperationError
+		if writeErr != nil && !errors.Is(writeErr, io.ErrClosedPipe) && errors.As(err, &opErr) && opErr.StatusCode == 0 {
+			opErr.Err = fmt.Errorf("failed to send request: %w", writeErr)
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := readResponseBody(resp)
+	if err != nil {
+		return nil, &OperationError{Method: http.MethodPost, Path: uploadPath, StatusCode: resp.StatusCode, Err: fmt.Errorf("failed to read response body: %w", err)}
+	}
+
+	return respBody, nil
+}
+
+// contentType returns the MIME type of the file set with WithMimeType, or else guessed from the
+// extension of its name.
+func (b *uploadAttachmentBuilder) contentType() string {
+	if b.mimeType != "" {
+		return b.mimeType
+	}
+	if mimeType := mime.TypeByExtension(filepath.Ext(b.filename)); mimeType != "" {
+		return mimeType
+	}
+	return "application/octet-stream"
+}
+
+// writeForm writes the multipart form with the file, reporting the progress as it's read.
+func (b *uploadAttachmentBuilder) writeForm(form *multipart.Writer, info UploadInfo, reader io.Reader) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="files"; filename="%s"`, quoteEscaper.Replace(info.Filename)))
+	header.Set("Content-Type", info.MimeType)
+
+	part, err := form.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to write form: %w", err)
+	}
+
+	if b.onProgress != nil {
+		reader = &progressReader{reader: reader, total: info.Size, onProgress: b.onProgress}
+	}
+	if _, err := io.Copy(part, reader); err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return form.Close()
+}
+
+// quoteEscaper escapes the quotes and backslashes of the file name in the form header
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// progressReader reports the bytes read from a reader to a progress callback
+type progressReader struct {
+	reader     io.Reader
+	sent       int64
+	total      int64
+	onProgress func(sent int64, total int64)
+}
+
+// Read implements the io.Reader interface for progressReader.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.onProgress(r.sent, r.total)
+	}
+	return n, err
+}
@@ -0,0 +1,153 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUploadAttachment(t *testing.T) {
+	var transferEncoding []string
+	var path, mimeType string
+	var received int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/storage/upload", func(w http.ResponseWriter, r *http.Request) {
+		transferEncoding = r.TransferEncoding
+		path = r.URL.Query().Get("path")
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"msg":"` + err.Error() + `"}`))
+			return
+		}
+		part, err := reader.NextPart()
+		if err != nil || part.FormName() != "files" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"msg":"missing file"}`))
+			return
+		}
+		mimeType = part.Header.Get("Content-Type")
+		data, _ := io.ReadAll(part)
+		received = len(data)
+
+		_, _ = w.Write([]byte(`[{"path":"download/noco/` + part.FileName() + `","title":"` + part.FileName() + `","mimetype":"` + mimeType + `","size":` + strconv.Itoa(received) + `}]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	size := 3 << 20
+	var progress []int64
+	attachment, err := client.UploadAttachment("report.pdf", bytes.NewReader(make([]byte, size))).
+		WithSize(int64(size)).
+		WithPath("noco/crm").
+		OnProgress(func(sent int64, total int64) {
+			if total != int64(size) {
+				t.Errorf("OnProgress() total = %d", total)
+			}
+			progress = append(progress, sent)
+		}).
+		Execute()
+	if err != nil {
+		t.Fatalf("UploadAttachment() error = %v", err)
+	}
+
+	want := Attachment{Title: "report.pdf", MimeType: "application/pdf", Size: int64(size), Path: "download/noco/report.pdf"}
+	if attachment != want {
+		t.Errorf("UploadAttachment() = %+v, want %+v", attachment, want)
+	}
+	if len(transferEncoding) != 1 || transferEncoding[0] != "chunked" {
+		t.Errorf("TransferEncoding = %v, want chunked", transferEncoding)
+	}
+	if path != "noco/crm" || received != size {
+		t.Errorf("path = %q, received = %d", path, received)
+	}
+	if len(progress) < 2 || progress[len(progress)-1] != int64(size) {
+		t.Errorf("OnProgress() calls = %d, last = %v", len(progress), progress[len(progress)-1:])
+	}
+
+	if _, err := client.UploadAttachment("notes", strings.NewReader("hello")).Execute(); err != nil || mimeType != "application/octet-stream" {
+		t.Errorf("UploadAttachment() error = %v, mime type %q", err, mimeType)
+	}
+
+	readErr := errors.New("disk failure")
+	if _, err := client.UploadAttachment("broken.txt", io.MultiReader(strings.NewReader("partial"), &failingReader{err: readErr})).Execute(); !errors.Is(err, readErr) {
+		t.Errorf("UploadAttachment() error = %v, want %v", err, readErr)
+	}
+}
+
+func TestUploadAttachmentSignedAndLimited(t *testing.T) {
+	limited := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/storage/upload", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		sum := sha256.Sum256(body)
+		if r.Header.Get("X-Signature") != hex.EncodeToString(sum[:]) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"msg":"invalid signature"}`))
+			return
+		}
+		if limited {
+			w.Header().Set("X-Ratelimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"msg":"too many requests"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"path":"download/notes.txt","title":"notes.txt"}]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var rateLimits []RateLimit
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithRequestSigner(RequestSignerFunc(func(req *http.Request, body []byte) error {
+			sum := sha256.Sum256(body)
+			req.Header.Set("X-Signature", hex.EncodeToString(sum[:]))
+			return nil
+		})).
+		WithRateLimitCallback(func(rateLimit RateLimit) { rateLimits = append(rateLimits, rateLimit) }).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// The signer receives the whole form
+	if _, err := client.UploadAttachment("notes.txt", strings.NewReader("hello")).Execute(); err != nil {
+		t.Fatalf("UploadAttachment() error = %v", err)
+	}
+
+	limited = true
+	_, err = client.UploadAttachment("notes.txt", strings.NewReader("hello")).Execute()
+	var opErr *OperationError
+	if !errors.As(err, &opErr) || opErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("UploadAttachment() error = %v, want a 429", err)
+	}
+	if len(rateLimits) != 1 || rateLimits[0].Path != uploadPath || rateLimits[0].Method != http.MethodPost {
+		t.Errorf("rate limits = %+v, want the limit of the upload", rateLimits)
+	}
+}
+
+// failingReader is a reader that always fails with its error
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,32 @@
+package nocodbgo
+
+// Base represents a base (project) in NocoDB and provides methods for working with it through the meta API
+type Base struct {
+	client *Client
+	baseID string
+}
+
+// Base returns a new Base instance for the specified base ID.
+//
+// The base ID can be found in the URL of the base in the NocoDB user interface (e.g. "p_xxxxxxxxxxxxxx").
+func (c *Client) Base(baseID string) *Base {
+	return &Base{
+		client: c,
+		baseID: baseID,
+	}
+}
+
+// ID returns the identifier of the base.
+func (b *Base) ID() string {
+	return b.baseID
+}
+
+// Table returns a new Table instance for the specified table ID of the base.
+//
+// It works like Client.Table, but the table knows its base, which is required by the v1 data API
+// and saves reading the schema to build the URLs of its records.
+func (b *Base) Table(tableID string) *Table {
+	table := b.client.Table(tableID)
+	table.baseID = b.baseID
+	return table
+}
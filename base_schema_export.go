@@ -0,0 +1,91 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"io"
+)
+
+// BaseSchemaDocument is the exported schema of all the tables of a base, without their records
+type BaseSchemaDocument struct {
+	// Version is the version of the format of the document
+	Version int `json:"version"`
+	// Tables are the schemas of the tables, in the order of the base
+	Tables []SchemaDocument `json:"tables"`
+}
+
+// Table returns the schema of the table with the given title.
+func (d BaseSchemaDocument) Table(title string) (SchemaDocument, bool) {
+	for _, table := range d.Tables {
+		if table.Table == title {
+			return table, true
+		}
+	}
+	return SchemaDocument{}, false
+}
+
+// exportBaseSchemaBuilder is used to build a schema export of a base with a fluent API
+type exportBaseSchemaBuilder struct {
+	base   *Base
+	format SchemaFormat
+
+	contextProvider[*exportBaseSchemaBuilder]
+}
+
+// ExportSchema initializes a builder that writes the schemas of all the tables of the base, with
+// their columns, select options and views, in the given format. Records are not exported.
+//
+// Like Table.ExportSchema, the document has a stable order and no identifiers assigned by NocoDB,
+// so it can be versioned in git and imported in another base with schema.PlanBase to promote the
+// structure of a base from one environment to another. Only JSON documents can be read back, YAML
+// is export-only.
+//
+// Parameters:
+//   - format: The format of the document, SchemaFormatJSON or SchemaFormatYAML.
+//
+// Example:
+//
+//	file, err := os.Create("base.schema.json")
+//	// Handle error
+//	defer file.Close()
+//
+//	err = client.Base("p_xxxxxxxxxxxxxx").ExportSchema(nocodbgo.SchemaFormatJSON).WithContext(ctx).Execute(file)
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table/operation/db-table-list
+func (base *Base) ExportSchema(format SchemaFormat) *exportBaseSchemaBuilder {
+	b := &exportBaseSchemaBuilder{
+		base:   base,
+		format: format,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation, writing the schemas to w.
+func (b *exportBaseSchemaBuilder) Execute(w io.Writer) error {
+	if b.format != SchemaFormatJSON && b.format != SchemaFormatYAML {
+		return fmt.Errorf("unsupported schema format %q", b.format)
+	}
+
+	ctx := b.contextProvider.ctx
+	tables, err := b.base.ListTables().WithContext(ctx).Execute()
+	if err != nil {
+		return withOperation(err, "ExportSchema", "", RecordID{})
+	}
+
+	document := BaseSchemaDocument{
+		Version: schemaDocumentVersion,
+		Tables:  make([]SchemaDocument, 0, len(tables)),
+	}
+	for _, info := range tables {
+		table, err := b.base.Table(info.ID).schemaDocument(ctx)
+		if err != nil {
+			return withOperation(err, "ExportSchema", info.ID, RecordID{})
+		}
+		document.Tables = append(document.Tables, table)
+	}
+
+	return writeSchema(w, b.format, document)
+}
@@ -0,0 +1,216 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Source describes a data source of a base, either the default NocoDB database or an external database
+type Source struct {
+	// ID is the unique identifier of the source
+	ID string
+	// BaseID is the identifier of the base the source belongs to
+	BaseID string
+	// Alias is the name of the source
+	Alias string
+	// Type is the database type (e.g. "pg", "mysql2", "sqlite3")
+	Type string
+	// Enabled indicates if the source is enabled
+	Enabled bool
+	// IsMeta indicates if the source is the default NocoDB database instead of an external database
+	IsMeta bool
+	// IsSchemaReadOnly indicates if the schema of the source can't be modified from NocoDB
+	IsSchemaReadOnly bool
+	// IsDataReadOnly indicates if the data of the source can't be modified from NocoDB
+	IsDataReadOnly bool
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Source.
+// It handles the boolean flags being returned either as booleans, as 0/1 integers or as null.
+func (s *Source) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID               string  `json:"id"`
+		BaseID           string  `json:"base_id"`
+		Alias            *string `json:"alias"`
+		Type             string  `json:"type"`
+		Enabled          any     `json:"enabled"`
+		IsMeta           any     `json:"is_meta"`
+		IsSchemaReadOnly any     `json:"is_schema_readonly"`
+		IsDataReadOnly   any     `json:"is_data_readonly"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal source: %w", err)
+	}
+
+	*s = Source{
+		ID:               raw.ID,
+		BaseID:           raw.BaseID,
+		Alias:            stringOrEmpty(raw.Alias),
+		Type:             raw.Type,
+		Enabled:          metaBool(raw.Enabled),
+		IsMeta:           metaBool(raw.IsMeta),
+		IsSchemaReadOnly: metaBool(raw.IsSchemaReadOnly),
+		IsDataReadOnly:   metaBool(raw.IsDataReadOnly),
+	}
+
+	return nil
+}
+
+// listSourcesBuilder is used to build a query that lists the sources of a base with a fluent API
+type listSourcesBuilder struct {
+	base *Base
+
+	contextProvider[*listSourcesBuilder]
+}
+
+// ListSources lists the data sources attached to the base.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Source/operation/source-list
+func (base *Base) ListSources() *listSourcesBuilder {
+	b := &listSourcesBuilder{
+		base: base,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listSourcesBuilder) Execute() ([]Source, error) {
+	path := fmt.Sprintf("/api/v2/meta/bases/%s/sources/", b.base.baseID)
+	respBody, err := b.base.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	var response struct {
+		List []Source `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sources response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// MetaDiff describes the differences between the metadata NocoDB has about a table and the actual
+// schema of the table in its data source
+type MetaDiff struct {
+	// TableName is the name of the table in the database
+	TableName string `json:"table_name"`
+	// SourceID is the identifier of the source the table belongs to
+	SourceID string `json:"source_id"`
+	// Type is the type of object that changed (e.g. "table" or "view")
+	Type string `json:"type"`
+	// DetectedChanges are the changes detected in the table
+	DetectedChanges []MetaChange `json:"detectedChanges"`
+}
+
+// MetaChange is a change detected between the metadata and the schema of a table
+type MetaChange struct {
+	// Type is the type of change (e.g. "TABLE_NEW", "COLUMN_NEW", "TABLE_RELATION_ADD")
+	Type string `json:"type"`
+	// Msg is a human readable description of the change
+	Msg string `json:"msg"`
+}
+
+// metaDiffBuilder is used to build a query that reads the metadata differences of a base with a fluent API
+type metaDiffBuilder struct {
+	base     *Base
+	sourceID string
+
+	contextProvider[*metaDiffBuilder]
+}
+
+// MetaDiff reads the differences between the metadata of the base and the actual schemas of its
+// data sources, for example after the tables of an external database were altered by a migration.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Base/operation/base-meta-diff-get
+//   - https://meta-apis-v2.nocodb.com/#tag/Source/operation/source-meta-diff-get
+func (base *Base) MetaDiff() *metaDiffBuilder {
+	b := &metaDiffBuilder{
+		base: base,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// ForSource limits the operation to a single data source of the base.
+func (b *metaDiffBuilder) ForSource(sourceID string) *metaDiffBuilder {
+	b.sourceID = sourceID
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *metaDiffBuilder) Execute() ([]MetaDiff, error) {
+	respBody, err := b.base.client.request(b.contextProvider.ctx, http.MethodGet, b.base.metaDiffPath(b.sourceID), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read meta diff: %w", err)
+	}
+
+	var response []MetaDiff
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal meta diff response: %w", err)
+	}
+
+	return response, nil
+}
+
+// syncMetaBuilder is used to build a metadata synchronization of a base with a fluent API
+type syncMetaBuilder struct {
+	base     *Base
+	sourceID string
+
+	contextProvider[*syncMetaBuilder]
+}
+
+// SyncMeta synchronizes the metadata of the base with the actual schemas of its data sources, so
+// tables and columns added or changed directly in an external database become available in NocoDB.
+//
+// Example:
+//
+//	// After running the database migrations
+//	err := client.Base("p_xxxxxxxxxxxxxx").SyncMeta().ForSource("ds_xxxxxxxxxxxxxx").Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Base/operation/base-meta-diff-sync
+//   - https://meta-apis-v2.nocodb.com/#tag/Source/operation/source-meta-diff-sync
+func (base *Base) SyncMeta() *syncMetaBuilder {
+	b := &syncMetaBuilder{
+		base: base,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// ForSource limits the operation to a single data source of the base.
+func (b *syncMetaBuilder) ForSource(sourceID string) *syncMetaBuilder {
+	b.sourceID = sourceID
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *syncMetaBuilder) Execute() error {
+	if _, err := b.base.client.request(b.contextProvider.ctx, http.MethodPost, b.base.metaDiffPath(b.sourceID), nil, nil); err != nil {
+		return fmt.Errorf("failed to sync meta: %w", err)
+	}
+
+	return nil
+}
+
+// metaDiffPath returns the path of the meta diff endpoint for the whole base or for a single source.
+func (base *Base) metaDiffPath(sourceID string) string {
+	if sourceID == "" {
+		return fmt.Sprintf("/api/v2/meta/bases/%s/meta-diff", base.baseID)
+	}
+	return fmt.Sprintf("/api/v2/meta/bases/%s/meta-diff/%s", base.baseID, sourceID)
+}
@@ -0,0 +1,57 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBaseSources(t *testing.T) {
+	synced := ""
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/bases/p_1/sources/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[
+			{"id":"ds_meta","base_id":"p_1","alias":null,"type":"sqlite3","enabled":1,"is_meta":1},
+			{"id":"ds_pg","base_id":"p_1","alias":"Warehouse","type":"pg","enabled":true,"is_meta":null,"is_schema_readonly":true}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/bases/p_1/meta-diff/ds_pg", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"table_name":"orders","source_id":"ds_pg","type":"table","detectedChanges":[{"type":"COLUMN_NEW","msg":"New column(total)","cn":"total"}]}]`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/bases/p_1/meta-diff/{sourceID}", func(w http.ResponseWriter, r *http.Request) {
+		synced = r.PathValue("sourceID")
+		_, _ = w.Write([]byte(`{"msg":"The source meta has been synchronized successfully"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	base := client.Base("p_1")
+
+	sources, err := base.ListSources().Execute()
+	if err != nil {
+		t.Fatalf("ListSources() error = %v", err)
+	}
+	if len(sources) != 2 || !sources[0].IsMeta || !sources[0].Enabled || sources[1].IsMeta || !sources[1].IsSchemaReadOnly || sources[1].Alias != "Warehouse" {
+		t.Errorf("ListSources() = %+v", sources)
+	}
+
+	diff, err := base.MetaDiff().ForSource("ds_pg").Execute()
+	if err != nil {
+		t.Fatalf("MetaDiff() error = %v", err)
+	}
+	if len(diff) != 1 || diff[0].TableName != "orders" || diff[0].DetectedChanges[0].Type != "COLUMN_NEW" {
+		t.Errorf("MetaDiff() = %+v", diff)
+	}
+
+	if err := base.SyncMeta().ForSource("ds_pg").Execute(); err != nil {
+		t.Fatalf("SyncMeta() error = %v", err)
+	}
+	if synced != "ds_pg" {
+		t.Errorf("SyncMeta() synced source = %q, want ds_pg", synced)
+	}
+}
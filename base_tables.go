@@ -0,0 +1,123 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TableInfo describes a table of a base
+type TableInfo struct {
+	// ID is the unique identifier of the table
+	ID string `json:"id"`
+	// Title is the title of the table
+	Title string `json:"title"`
+	// TableName is the name of the table in the database
+	TableName string `json:"table_name"`
+}
+
+// listTablesBuilder is used to build a query that lists the tables of a base with a fluent API
+type listTablesBuilder struct {
+	base *Base
+
+	contextProvider[*listTablesBuilder]
+}
+
+// ListTables lists the tables of the base, in the order they are shown in the user interface.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table/operation/db-table-list
+func (base *Base) ListTables() *listTablesBuilder {
+	b := &listTablesBuilder{
+		base: base,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listTablesBuilder) Execute() ([]TableInfo, error) {
+	if b.base.baseID == "" {
+		return nil, ErrBaseIDRequired
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/bases/%s/tables", b.base.baseID)
+	respBody, err := b.base.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list tables: %w", err), "ListTables", "", RecordID{})
+	}
+
+	var response struct {
+		List []TableInfo `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tables response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// createTableBuilder is used to build a table creation with a fluent API
+type createTableBuilder struct {
+	base  *Base
+	title string
+
+	contextProvider[*createTableBuilder]
+}
+
+// CreateTable creates a new table in the base with only its primary key, an "Id" column, and
+// returns it. The other columns are added with Table.CreateColumn and the related methods.
+//
+// Parameters:
+//   - title: The title of the new table, also used as the name of the table in the database.
+//
+// Example:
+//
+//	table, err := base.CreateTable("Products").Execute()
+//	// Handle error
+//	_, err = table.CreateColumn("Name", nocodbgo.ColumnTypeSingleLineText).Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table/operation/db-table-create
+func (base *Base) CreateTable(title string) *createTableBuilder {
+	b := &createTableBuilder{
+		base:  base,
+		title: title,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createTableBuilder) Execute() (*Table, error) {
+	if b.base.baseID == "" {
+		return nil, ErrBaseIDRequired
+	}
+
+	body := map[string]any{
+		"title":      b.title,
+		"table_name": b.title,
+		"columns": []map[string]any{
+			{"title": "Id", "column_name": "id", "uidt": ColumnTypeID, "pk": true, "ai": true},
+		},
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/bases/%s/tables", b.base.baseID)
+	respBody, err := b.base.client.request(b.contextProvider.ctx, http.MethodPost, path, body, nil)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to create table: %w", err), "CreateTable", "", RecordID{})
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal table response: %w", err)
+	}
+
+	return b.base.Table(response.ID), nil
+}
@@ -0,0 +1,124 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestBaseTables(t *testing.T) {
+	var created []map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/bases/p_dev/tables", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"id":"md_tags","title":"Tags","table_name":"nc_tags"}]}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/bases/p_dev/tables", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		created = append(created, body)
+		_, _ = w.Write([]byte(`{"id":"md_new","title":"` + body["title"].(string) + `"}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/md_tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"md_tags","title":"Tags","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/md_tags/views", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"id":"vw_default","title":"Tags","type":3},{"id":"vw_form","title":"New tag","type":1}]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/views/{id}/filters", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/views/{id}/sorts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[]}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/tables/md_tags/galleries", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		created = append(created, body)
+		_, _ = w.Write([]byte(`{"id":"vw_gallery"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	base := client.Base("p_dev")
+	ctx := context.Background()
+
+	tables, err := base.ListTables().WithContext(ctx).Execute()
+	if err != nil {
+		t.Fatalf("ListTables() error = %v", err)
+	}
+	if want := []TableInfo{{ID: "md_tags", Title: "Tags", TableName: "nc_tags"}}; !reflect.DeepEqual(tables, want) {
+		t.Errorf("ListTables() = %+v, want %+v", tables, want)
+	}
+
+	table, err := base.CreateTable("Products").Execute()
+	if err != nil {
+		t.Fatalf("CreateTable() error = %v", err)
+	}
+	if table.tableID != "md_new" || table.baseID != "p_dev" {
+		t.Errorf("CreateTable() = %+v", table)
+	}
+	if columns := created[0]["columns"].([]any); len(columns) != 1 || columns[0].(map[string]any)["uidt"] != "ID" {
+		t.Errorf("CreateTable() columns = %v", created[0]["columns"])
+	}
+
+	views, err := base.Table("md_tags").ListViews().Execute()
+	if err != nil {
+		t.Fatalf("ListViews() error = %v", err)
+	}
+	wantViews := []ViewInfo{{ID: "vw_default", Title: "Tags", Type: "grid"}, {ID: "vw_form", Title: "New tag", Type: "form"}}
+	if !reflect.DeepEqual(views, wantViews) {
+		t.Errorf("ListViews() = %+v, want %+v", views, wantViews)
+	}
+
+	view, err := base.Table("md_tags").CreateView("Cards", "gallery").Execute()
+	if err != nil {
+		t.Fatalf("CreateView() error = %v", err)
+	}
+	if view.viewID != "vw_gallery" || view.viewType != 2 || created[1]["type"] != float64(2) {
+		t.Errorf("CreateView() = %+v, body %v", view, created[1])
+	}
+	if _, err := base.Table("md_tags").CreateView("Board", "timeline").Execute(); err == nil {
+		t.Error("CreateView() with an unknown type should fail")
+	}
+
+	var output bytes.Buffer
+	if err := base.ExportSchema(SchemaFormatJSON).WithContext(ctx).Execute(&output); err != nil {
+		t.Fatalf("ExportSchema() error = %v", err)
+	}
+	var document BaseSchemaDocument
+	if err := json.Unmarshal(output.Bytes(), &document); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	want := BaseSchemaDocument{Version: 1, Tables: []SchemaDocument{{
+		Version: 1,
+		Table:   "Tags",
+		Columns: []SchemaDocumentColumn{
+			{Title: "Id", Type: ColumnTypeID, PrimaryKey: true},
+			{Title: "Name", Type: ColumnTypeSingleLineText},
+		},
+		Views: []SchemaDocumentView{{Title: "Tags", Type: "grid"}, {Title: "New tag", Type: "form"}},
+	}}}
+	if !reflect.DeepEqual(document, want) {
+		t.Errorf("ExportSchema() =\n%s", output.String())
+	}
+	if _, ok := document.Table("Tags"); !ok {
+		t.Error("Table() should find the exported table")
+	}
+
+	if _, err := client.Base("").ListTables().Execute(); !errors.Is(err, ErrBaseIDRequired) {
+		t.Errorf("ListTables() error = %v, want %v", err, ErrBaseIDRequired)
+	}
+}
@@ -0,0 +1,66 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// BaseUser describes a user with access to a base
+type BaseUser struct {
+	// ID is the unique identifier of the user
+	ID string `json:"id"`
+	// Email is the email of the user
+	Email string `json:"email"`
+	// DisplayName is the display name of the user, empty if the user didn't set one
+	DisplayName string `json:"display_name"`
+	// Roles is the role of the user in the base (e.g. "editor")
+	Roles BaseRole `json:"roles"`
+}
+
+// listBaseUsersBuilder is used to build a query that lists the users of a base with a fluent API
+type listBaseUsersBuilder struct {
+	base *Base
+
+	contextProvider[*listBaseUsersBuilder]
+	headerProvider[*listBaseUsersBuilder]
+}
+
+// ListUsers lists the users with access to the base.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Auth/operation/auth-base-user-list
+func (base *Base) ListUsers() *listBaseUsersBuilder {
+	b := &listBaseUsersBuilder{
+		base: base,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listBaseUsersBuilder) Execute() ([]BaseUser, error) {
+	if b.base.baseID == "" {
+		return nil, ErrBaseIDRequired
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/bases/%s/users", b.base.baseID)
+	respBody, err := b.base.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, path, nil, nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list base users: %w", err), "ListBaseUsers", "", RecordID{})
+	}
+
+	var response struct {
+		Users struct {
+			List []BaseUser `json:"list"`
+		} `json:"users"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal base users response: %w", err)
+	}
+
+	return response.Users.List, nil
+}
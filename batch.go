@@ -0,0 +1,229 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Batch queues create, update, delete and link operations on one or more tables and executes them
+// sequentially. When an operation fails, the operations already executed are compensated in
+// reverse order, giving a best-effort transaction for multi-step scripts and migrations:
+//
+//   - Created records are deleted.
+//   - Updated records are restored to the values they had before the update.
+//   - Deleted records are created again from the values they had, with a new ID.
+//   - Created links are deleted.
+//
+// The compensation is not atomic, changes made by others in the meantime may be overwritten and
+// records read before a failed step may have been changed by it, so it's not a replacement for a
+// real transaction.
+//
+// Example:
+//
+//	result, err := client.Batch().
+//		Create(customers, map[string]any{"Name": "Ana"}).
+//		Update(orders, map[string]any{"Id": 7, "Status": "assigned"}).
+//		Delete(drafts, 12).
+//		WithContext(ctx).
+//		Execute()
+type Batch struct {
+	steps []batchStep
+
+	contextProvider[*Batch]
+	headerProvider[*Batch]
+}
+
+// batchStep is an operation queued in a batch
+type batchStep struct {
+	// name describes the operation in errors (e.g. "update record 7 of users")
+	name string
+	// run executes the operation and returns the function that compensates it
+	run func(ctx context.Context, b *Batch, result *BatchResult) (compensate func(ctx context.Context) error, err error)
+}
+
+// BatchResult is the result of executing a Batch
+type BatchResult struct {
+	// CreatedIDs are the IDs of the records created by the Create operations, in the order they were queued
+	CreatedIDs []RecordID
+}
+
+// Batch creates an empty batch of operations.
+func (c *Client) Batch() *Batch {
+	b := &Batch{}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Create queues the creation of a record, compensated by deleting it.
+//
+// Parameters:
+//   - table: The table where the record is created.
+//   - data: The data of the record, can be a map[string]any or a struct with JSON tags that match the table columns.
+func (b *Batch) Create(table *Table, data any) *Batch {
+	b.steps = append(b.steps, batchStep{
+		name: fmt.Sprintf("create record in %s", table.tableID),
+		run: func(ctx context.Context, b *Batch, result *BatchResult) (func(ctx context.Context) error, error) {
+			id, err := table.CreateRecord(data).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+			if err != nil {
+				return nil, err
+			}
+			result.CreatedIDs = append(result.CreatedIDs, id)
+
+			return func(ctx context.Context) error {
+				return table.DeleteRecord(id).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+			}, nil
+		},
+	})
+	return b
+}
+
+// Update queues the update of a record, compensated by restoring the updated fields to the values
+// read before the update.
+//
+// Parameters:
+//   - table: The table of the record.
+//   - data: The data to update the record with, can be a map[string]any or a struct with JSON tags that match the table columns. It must contain an "Id" field.
+func (b *Batch) Update(table *Table, data any) *Batch {
+	update := table.UpdateRecord(data)
+	recordID := update.recordID()
+
+	b.steps = append(b.steps, batchStep{
+		name: fmt.Sprintf("update record %v of %s", recordID, table.tableID),
+		run: func(ctx context.Context, b *Batch, result *BatchResult) (func(ctx context.Context) error, error) {
+			before, err := update.WithContext(ctx).withHeaders(b.headerProvider.rawHeader).WithPreImage().Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			restore := map[string]any{SystemFieldID: recordID}
+			for field := range update.data {
+				if field != SystemFieldID {
+					restore[field] = before.Data[field]
+				}
+			}
+
+			return func(ctx context.Context) error {
+				return table.UpdateRecord(restore).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+			}, nil
+		},
+	})
+	return b
+}
+
+// Delete queues the deletion of a record, compensated by creating it again from the values of its
+// writable columns read before the deletion. The restored record gets a new ID, and its links are
+// not restored.
+//
+// Parameters:
+//   - table: The table of the record.
+//   - recordID: The identifier of the record to delete, can be a RecordID, any integer type or a string.
+func (b *Batch) Delete(table *Table, recordID any) *Batch {
+	b.steps = append(b.steps, batchStep{
+		name: fmt.Sprintf("delete record %v of %s", recordID, table.tableID),
+		run: func(ctx context.Context, b *Batch, result *BatchResult) (func(ctx context.Context) error, error) {
+			recordID, err := parseRecordID(recordID)
+			if err != nil {
+				return nil, err
+			}
+			if recordID.IsZero() {
+				return nil, ErrRowIDRequired
+			}
+
+			schema, err := table.ReadSchema().WithContext(ctx).Execute()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read table schema: %w", err)
+			}
+
+			before, err := table.DeleteRecord(recordID).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).WithPreImage().Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			restore := map[string]any{}
+			for _, column := range schema.Columns {
+				if column.UIDT.IsReadOnly() || column.System || column.PrimaryKey {
+					continue
+				}
+				if value, ok := before.Data[column.Title]; ok {
+					restore[column.Title] = value
+				}
+			}
+
+			return func(ctx context.Context) error {
+				_, err := table.CreateRecord(restore).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+				return err
+			}, nil
+		},
+	})
+	return b
+}
+
+// Link queues the creation of links between a record and target records, compensated by deleting
+// the links.
+//
+// Parameters:
+//   - table: The table of the record.
+//   - linkFieldID: The identifier of the link field of the table.
+//   - recordID: The identifier of the record the targets are linked to, with the same forms accepted by CreateLinks.
+//   - targetRecords: The target records, with the same forms accepted by CreateLinks.
+func (b *Batch) Link(table *Table, linkFieldID string, recordID any, targetRecords any) *Batch {
+	b.steps = append(b.steps, batchStep{
+		name: fmt.Sprintf("link record %v of %s", recordID, table.tableID),
+		run: func(ctx context.Context, b *Batch, result *BatchResult) (func(ctx context.Context) error, error) {
+			err := table.CreateLinks(linkFieldID, recordID, targetRecords).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+			if err != nil {
+				return nil, err
+			}
+
+			return func(ctx context.Context) error {
+				return table.DeleteLinks(linkFieldID, recordID, targetRecords).WithContext(ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+			}, nil
+		},
+	})
+	return b
+}
+
+// Execute executes the queued operations in order, stopping at the first one that fails.
+//
+// When an operation fails, the executed operations are compensated in reverse order and the error
+// of the operation is returned, joined with the errors of the compensations that failed. The
+// compensations don't use the context of the batch, since the failure may have been its
+// cancellation.
+func (b *Batch) Execute() (BatchResult, error) {
+	ctx := b.contextProvider.ctx
+	result := BatchResult{}
+	compensations := make([]func(ctx context.Context) error, 0, len(b.steps))
+
+	for i, step := range b.steps {
+		if err := ctx.Err(); err != nil {
+			return BatchResult{}, errors.Join(err, b.compensate(compensations))
+		}
+
+		compensate, err := step.run(ctx, b, &result)
+		if err != nil {
+			err = fmt.Errorf("failed to %s (operation %d): %w", step.name, i+1, err)
+			return BatchResult{}, errors.Join(err, b.compensate(compensations))
+		}
+		compensations = append(compensations, compensate)
+	}
+
+	return result, nil
+}
+
+// compensate runs the compensations in reverse order, running all of them even if some fail.
+func (b *Batch) compensate(compensations []func(ctx context.Context) error) error {
+	ctx := context.WithoutCancel(b.contextProvider.ctx)
+
+	var errs []error
+	for i := len(compensations) - 1; i >= 0; i-- {
+		if err := compensations[i](ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to compensate operation %d: %w", i+1, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,76 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestBatch(t *testing.T) {
+	fake := nocodbgotest.New()
+	fake.DefineLink("customers", "cl_orders", "orders")
+	fake.Seed("customers", map[string]any{"Name": "Ana", "Tier": "gold"})
+	fake.Seed("orders", map[string]any{"Item": "Pen"}, map[string]any{"Item": "Ink"}, map[string]any{"Item": "Pad"})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/orders", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"orders","title":"Orders","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+			{"id":"cl_item","title":"Item","uidt":"SingleLineText"},
+			{"id":"cl_created","title":"CreatedAt","uidt":"CreatedTime","system":true}
+		]}`))
+	})
+	mux.Handle("/", fake)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	customers := client.Table("customers")
+	orders := client.Table("orders")
+
+	result, err := client.Batch().
+		Create(customers, map[string]any{"Name": "Bob"}).
+		Update(customers, map[string]any{"Id": 1, "Tier": "silver"}).
+		Link(customers, "cl_orders", 1, []int{1}).
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !reflect.DeepEqual(result.CreatedIDs, []RecordID{IntID(2)}) {
+		t.Errorf("CreatedIDs = %v, want [2]", result.CreatedIDs)
+	}
+
+	t.Run("compensates executed operations on failure", func(t *testing.T) {
+		_, err := client.Batch().
+			Create(customers, map[string]any{"Name": "Cid"}).
+			Update(customers, map[string]any{"Id": 1, "Tier": "bronze"}).
+			Delete(orders, 2).
+			Link(customers, "cl_orders", 1, []int{3}).
+			Link(customers, "cl_unknown", 1, []int{1}).
+			Execute()
+		if err == nil {
+			t.Fatal("Execute() error = nil, want the link error")
+		}
+
+		records := fake.Records("customers")
+		if len(records) != 2 || records[0]["Tier"] != "silver" {
+			t.Errorf("customers after compensation = %v, want Ana as silver and Bob", records)
+		}
+		items := []any{}
+		for _, record := range fake.Records("orders") {
+			items = append(items, record["Item"])
+		}
+		if !reflect.DeepEqual(items, []any{"Pen", "Pad", "Ink"}) {
+			t.Errorf("order items after compensation = %v, want [Pen Pad Ink]", items)
+		}
+		if linked := fake.LinkedIDs("customers", "cl_orders", 1); !reflect.DeepEqual(linked, []int64{1}) {
+			t.Errorf("LinkedIDs() after compensation = %v, want [1]", linked)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// benchmarkRecords is the number of records of the list and create benchmarks
+const benchmarkRecords = 1000
+
+// benchmarkUser is the struct the benchmark records are decoded into and encoded from
+type benchmarkUser struct {
+	ID        int      `json:"Id"`
+	Name      string   `json:"Name"`
+	Email     string   `json:"Email"`
+	Age       int      `json:"Age"`
+	Score     float64  `json:"Score"`
+	Active    bool     `json:"Active"`
+	Tags      []string `json:"Tags"`
+	CreatedAt string   `json:"CreatedAt"`
+}
+
+// newBenchmarkClient returns a client connected to a server that answers every request with the
+// given body.
+func newBenchmarkClient(b *testing.B, body []byte) *Client {
+	b.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_, _ = w.Write(body)
+	}))
+	b.Cleanup(server.Close)
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		b.Fatalf("Create() error = %v", err)
+	}
+	return client
+}
+
+// newBenchmarkUsers returns n users with all their fields set.
+func newBenchmarkUsers(n int) []benchmarkUser {
+	users := make([]benchmarkUser, n)
+	for i := range users {
+		users[i] = benchmarkUser{
+			ID:        i + 1,
+			Name:      fmt.Sprintf("User %d", i),
+			Email:     fmt.Sprintf("user%d@example.com", i),
+			Age:       20 + i%50,
+			Score:     float64(i) * 1.5,
+			Active:    i%2 == 0,
+			Tags:      []string{"customer", "newsletter"},
+			CreatedAt: "2024-01-02 03:04:05+00:00",
+		}
+	}
+	return users
+}
+
+func BenchmarkListRecordsDecode(b *testing.B) {
+	body, err := json.Marshal(map[string]any{
+		"list":     newBenchmarkUsers(benchmarkRecords),
+		"pageInfo": map[string]any{"totalRows": benchmarkRecords, "page": 1, "pageSize": benchmarkRecords, "isFirstPage": true, "isLastPage": true},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	table := newBenchmarkClient(b, body).Table("users")
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(body)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		response, err := table.ListRecords().Limit(benchmarkRecords).Execute()
+		if err != nil {
+			b.Fatal(err)
+		}
+		var users []benchmarkUser
+		if err := response.DecodeInto(&users); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCreateRecordsEncode(b *testing.B) {
+	ids := make([]map[string]any, benchmarkRecords)
+	for i := range ids {
+		ids[i] = map[string]any{"Id": i + 1}
+	}
+	body, err := json.Marshal(ids)
+	if err != nil {
+		b.Fatal(err)
+	}
+	table := newBenchmarkClient(b, body).Table("users")
+	users := newBenchmarkUsers(benchmarkRecords)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := table.CreateRecords(users).Execute(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFilterBuilding(b *testing.B) {
+	client, err := NewClient().WithBaseURL("http://localhost").WithAPIToken("test-token").Create()
+	if err != nil {
+		b.Fatal(err)
+	}
+	table := client.Table("users")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query := table.ListRecords().
+			WhereIsEqualTo("Name", "Ana").
+			WhereIsNotEqualTo("Email", "ana@example.com").
+			WhereIsGreaterThan("Age", "18").
+			WhereIsLessThanOrEqual("Age", "65").
+			WhereIsIn("Tags", "customer", "newsletter", "vip").
+			WhereIsBetween("Score", "10", "20").
+			WhereIsLike("Email", "%@example.com").
+			WhereIsNotNull("CreatedAt").
+			Where("(Active,eq,true)~or(Score,gt,100)").
+			SortAscBy("Name").
+			SortDescBy("Age").
+			ReturnFields("Id", "Name", "Email").
+			Limit(50).
+			Offset(100)
+		_ = query.buildQuery().Encode()
+	}
+}
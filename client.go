@@ -15,6 +15,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -34,20 +35,83 @@ type Client struct {
 
 	// httpClient is the HTTP client used to make requests
 	httpClient *http.Client
+
+	// cache stores GET responses for conditional requests, nil when disabled
+	cache *responseCache
+
+	// defaultPageSize is the page size of list queries without an explicit limit, 0 for the server default
+	defaultPageSize int
+
+	// maxPageSize is the maximum page size of list queries, 0 for no limit
+	maxPageSize int
+
+	// onRateLimit is called with the rate limit headers of the responses, nil when disabled
+	onRateLimit func(RateLimit)
+
+	// clock provides the current time and the timers used by the client
+	clock Clock
+
+	// apiVersion is the version of the data API used by the tables
+	apiVersion APIVersion
+
+	// defaultBaseID is the base of the tables not obtained from a Base, for the data API versions
+	// that address tables through their base
+	defaultBaseID string
+
+	// signer signs the requests before they are sent, if set
+	signer RequestSigner
+
+	// policy is consulted before each request, if set
+	policy func(op Operation) error
+
+	// mutationGuard is consulted before the bulk updates and deletes, if set
+	mutationGuard mutationGuard
+
+	// inflight deduplicates identical concurrent GET requests, nil when disabled
+	inflight *inflightGroup
+
+	// disableCompression stops asking for gzip compressed responses
+	disableCompression bool
+
+	// uploadInterceptors inspect the files before they are uploaded
+	uploadInterceptors []UploadInterceptor
+
+	// queries are the query specs registered with RegisterQuery
+	queries *queryRegistry
 }
 
 // NewClient creates a new client builder for configuring and creating a NocoDB client
 func NewClient() *clientBuilder {
 	return &clientBuilder{
 		httpClient: &http.Client{Timeout: defaultTimeout},
+		clock:      systemClock{},
+		apiVersion: APIVersionV2,
+		mutationGuard: mutationGuard{
+			threshold: defaultMutationGuardThreshold,
+		},
 	}
 }
 
 // clientBuilder is used to build a new Client with a fluent API
 type clientBuilder struct {
-	baseURL    string
-	apiToken   string
-	httpClient *http.Client
+	baseURL         string
+	apiToken        string
+	httpClient      *http.Client
+	cache           *responseCache
+	defaultPageSize int
+	maxPageSize     int
+	onRateLimit     func(RateLimit)
+	clock           Clock
+	apiVersion      APIVersion
+	defaultBaseID   string
+	transport       transportOptions
+	signer          RequestSigner
+	policy          func(op Operation) error
+	mutationGuard   mutationGuard
+	deduplicate     bool
+
+	uploadInterceptors []UploadInterceptor
+	disableCompression bool
 }
 
 // WithBaseURL sets the base URL for the NocoDB API.
@@ -94,6 +158,141 @@ func (b *clientBuilder) WithHTTPTimeout(timeout time.Duration) *clientBuilder {
 	return b
 }
 
+// WithResponseCache enables a response cache for GET requests.
+//
+// Responses that include an ETag or Last-Modified header are cached by URL and query, and later
+// requests to the same URL send If-None-Match and If-Modified-Since headers. When the server answers
+// with 304 Not Modified the cached body is returned, which saves bandwidth when polling the same lists.
+//
+// Parameters:
+//   - maxEntries: The maximum number of cached responses, the least recently used are evicted first.
+//     If zero or negative, it defaults to 1000.
+func (b *clientBuilder) WithResponseCache(maxEntries int) *clientBuilder {
+	b.cache = newResponseCache(maxEntries)
+	return b
+}
+
+// WithDefaultPageSize sets the number of records per page of the list queries that don't set a
+// limit, instead of the server default of 25.
+//
+// It also sets the page size used when fetching all the pages of a query with ExecuteAll or
+// Iterate, which defaults to 100.
+func (b *clientBuilder) WithDefaultPageSize(pageSize int) *clientBuilder {
+	b.defaultPageSize = max(pageSize, 0)
+	return b
+}
+
+// WithRateLimitCallback sets a function that is called with the rate limit state of every response
+// that includes x-ratelimit-* headers, to build adaptive throttling on top of the client.
+//
+// The function is called synchronously before the request returns, and concurrently when the
+// client is used from several goroutines.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithRateLimitCallback(func(rl nocodbgo.RateLimit) {
+//			if rl.Remaining < 5 {
+//				limiter.PauseUntil(rl.Reset)
+//			}
+//		}).
+//		Create()
+func (b *clientBuilder) WithRateLimitCallback(onRateLimit func(RateLimit)) *clientBuilder {
+	b.onRateLimit = onRateLimit
+	return b
+}
+
+// WithClock sets the clock used by the client for the current time and its timers, to test the
+// features that depend on time without waiting.
+//
+// If not set, or set to nil, the system clock is used.
+//
+// Example:
+//
+//	clock := nocodbgotest.NewClock(time.Now())
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL(fake.BaseURL()).
+//		WithAPIToken("test-token").
+//		WithHTTPClient(fake.HTTPClient()).
+//		WithClock(clock).
+//		Create()
+func (b *clientBuilder) WithClock(clock Clock) *clientBuilder {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	b.clock = clock
+	return b
+}
+
+// WithV1DataAPI makes the tables of the client use the v1 data API, for self-hosted NocoDB versions
+// that don't have the v2 data API yet.
+//
+// The same builders work on both APIs, so code can be moved between servers without changes. The
+// records (list, count, read, create, update and delete) and links operations are mapped to the v1
+// endpoints, and the table schema is read from the v1 meta API. The other operations, like views
+// and columns, keep using the v2 API.
+//
+// The v1 API addresses tables through their base. The tables obtained with Client.Table use the
+// given base, and the ones obtained with Base.Table use their own base.
+//
+// Parameters:
+//   - defaultBaseID: The identifier of the base of the tables obtained with Client.Table, can be empty if
+//     all the tables are obtained with Base.Table.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://nocodb.internal").
+//		WithAPIToken("your-api-token").
+//		WithV1DataAPI("p_xxxxxxxxxxxxxx").
+//		Create()
+func (b *clientBuilder) WithV1DataAPI(defaultBaseID string) *clientBuilder {
+	return b.WithAPIVersion(APIVersionV1).WithDefaultBaseID(defaultBaseID)
+}
+
+// WithAPIVersion sets the version of the data API used by the tables of the client.
+//
+// The records (list, count, read, create, update and delete) and links builders work the same on
+// every version, converting the requests and responses to the format of the selected version, so
+// code can move between API versions without changes. The other operations keep using the v2 API.
+//
+// The v1 and v3 APIs address tables through their base, set it with WithDefaultBaseID or obtain
+// the tables with Base.Table.
+//
+// If not set, it defaults to APIVersionV2.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://app.nocodb.com").
+//		WithAPIToken("your-api-token").
+//		WithAPIVersion(nocodbgo.APIVersionV3).
+//		WithDefaultBaseID("p_xxxxxxxxxxxxxx").
+//		Create()
+func (b *clientBuilder) WithAPIVersion(version APIVersion) *clientBuilder {
+	if version != "" {
+		b.apiVersion = version
+	}
+	return b
+}
+
+// WithDefaultBaseID sets the base of the tables obtained with Client.Table, required by the data API
+// versions that address tables through their base. The tables obtained with Base.Table use their
+// own base.
+func (b *clientBuilder) WithDefaultBaseID(baseID string) *clientBuilder {
+	b.defaultBaseID = baseID
+	return b
+}
+
+// WithMaxPageSize sets the maximum number of records per page of list queries. Larger limits
+// are reduced to it, to avoid requests above the limit of the server.
+func (b *clientBuilder) WithMaxPageSize(pageSize int) *clientBuilder {
+	b.maxPageSize = max(pageSize, 0)
+	return b
+}
+
 // Create builds and returns a new NocoDB client with the configured options.
 func (b *clientBuilder) Create() (*Client, error) {
 	if b.baseURL == "" {
@@ -108,13 +307,69 @@ func (b *clientBuilder) Create() (*Client, error) {
 		return nil, ErrHTTPClientRequired
 	}
 
+	switch b.apiVersion {
+	case APIVersionV1, APIVersionV2, APIVersionV3:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAPIVersion, b.apiVersion)
+	}
+
+	httpClient, err := b.transport.apply(b.httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var inflight *inflightGroup
+	if b.deduplicate {
+		inflight = newInflightGroup()
+	}
+
 	return &Client{
-		baseURL:    b.baseURL,
-		apiToken:   b.apiToken,
-		httpClient: b.httpClient,
+		baseURL:         b.baseURL,
+		apiToken:        b.apiToken,
+		httpClient:      httpClient,
+		cache:           b.cache,
+		defaultPageSize: b.defaultPageSize,
+		maxPageSize:     b.maxPageSize,
+		onRateLimit:     b.onRateLimit,
+		clock:           b.clock,
+		apiVersion:      b.apiVersion,
+		defaultBaseID:   b.defaultBaseID,
+		signer:          b.signer,
+		policy:          b.policy,
+		mutationGuard:   b.mutationGuard,
+		inflight:        inflight,
+		queries:         newQueryRegistry(),
+
+		disableCompression: b.disableCompression,
+		uploadInterceptors: b.uploadInterceptors,
 	}, nil
 }
 
+// pageSize returns the page size for a query with the given limit, using the default page size
+// of the client or else the fallback when the limit is not set, and capping it to the maximum
+// page size of the client. It returns 0 when there is no page size to set.
+func (c *Client) pageSize(limit int, fallback int) int {
+	if limit < 1 {
+		limit = c.defaultPageSize
+	}
+	if limit < 1 {
+		limit = fallback
+	}
+	if c.maxPageSize > 0 && (limit < 1 || limit > c.maxPageSize) {
+		limit = c.maxPageSize
+	}
+	return limit
+}
+
+// applyPageSize sets the "limit" query parameter of a list query according to the page size
+// options of the client.
+func (c *Client) applyPageSize(query url.Values, limit int) url.Values {
+	if pageSize := c.pageSize(limit, 0); pageSize > 0 {
+		query.Set("limit", strconv.Itoa(pageSize))
+	}
+	return query
+}
+
 // apiError represents an error returned by the NocoDB API
 type apiError struct {
 	Msg     string `json:"msg"`
@@ -156,9 +411,52 @@ func (e apiError) Error() string {
 //
 // Returns the response body as a byte slice or an error if the request fails.
 func (c *Client) request(ctx context.Context, method string, path string, body any, query url.Values) ([]byte, error) {
+	return c.requestWithHeader(ctx, method, path, body, query, nil)
+}
+
+// requestWithHeader works like request but also sends the provided headers with the request.
+//
+// Errors are returned as an *OperationError with the method and path of the request, which the
+// builders complete with the details of the operation.
+func (c *Client) requestWithHeader(ctx context.Context, method string, path string, body any, query url.Values, header http.Header) ([]byte, error) {
+	respBody, statusCode, err := c.send(ctx, method, path, body, query, header)
+	if err != nil {
+		return nil, &OperationError{
+			Method:     method,
+			Path:       "/" + strings.TrimPrefix(path, "/"),
+			StatusCode: statusCode,
+			Err:        err,
+		}
+	}
+	return respBody, nil
+}
+
+// send sends a request to the NocoDB API, returning the response body and the status code of the
+// response, which is 0 if no response was received.
+func (c *Client) send(ctx context.Context, method string, path string, body any, query url.Values, header http.Header) ([]byte, int, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := c.checkPolicy(method, path, body); err != nil {
+		return nil, 0, err
+	}
+
+	if c.inflight != nil && method == http.MethodGet {
+		key := inflightKey(c.baseURL, path, query, header)
+		return c.inflight.do(ctx, key, func(ctx context.Context) ([]byte, int, error) {
+			return c.sendRequest(ctx, method, path, body, query, header)
+		})
+	}
+
+	return c.sendRequest(ctx, method, path, body, query, header)
+}
+
+// sendRequest sends a request to the NocoDB API like send, after the policy has been checked.
+func (c *Client) sendRequest(ctx context.Context, method string, path string, body any, query url.Values, header http.Header) ([]byte, int, error) {
 	parsedUrl, err := url.Parse(fmt.Sprintf("%s/%s", c.baseURL, strings.TrimPrefix(path, "/")))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse URL: %w", err)
+		return nil, 0, fmt.Errorf("failed to parse URL: %w", err)
 	}
 
 	if query != nil {
@@ -166,48 +464,188 @@ func (c *Client) request(ctx context.Context, method string, path string, body a
 	}
 
 	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, 0, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	if ctx == nil {
-		ctx = context.Background()
-	}
 	req, err := http.NewRequestWithContext(ctx, method, parsedUrl.String(), reqBody)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 	req.Header.Set("xc-token", c.apiToken)
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	c.setAcceptEncoding(req)
+
+	cacheKey := parsedUrl.String()
+	useCache := c.cache != nil && method == http.MethodGet
+	cached, isCached := responseCacheEntry{}, false
+	if useCache {
+		cached, isCached = c.cache.get(cacheKey)
+		if isCached {
+			cached.setConditionalHeaders(req)
+		}
+	}
+
+	if c.signer != nil {
+		if err := c.signer.SignRequest(req, jsonBody); err != nil {
+			return nil, 0, fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, 0, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	if c.onRateLimit != nil {
+		if rateLimit, ok := parseRateLimit(resp.Header, c.clock.Now()); ok {
+			rateLimit.Method = method
+			rateLimit.Path = "/" + strings.TrimPrefix(path, "/")
+			c.onRateLimit(rateLimit)
+		}
+	}
+
+	if isCached && resp.StatusCode == http.StatusNotModified {
+		return cached.body, resp.StatusCode, nil
+	}
+
+	respBody, err := readResponseBody(resp)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, resp.StatusCode, responseError(resp.StatusCode, respBody)
+	}
+
+	if useCache {
+		c.cache.store(cacheKey, resp.Header, respBody)
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// openStream sends a request to the NocoDB API like request, but streams the request body and
+// returns the response without reading its body, so large files can be sent and received. The
+// caller must close the body of the response.
+//
+// The request goes through the same operation policy, request signer and rate limit callback as
+// the other requests. The signer receives the body, so it's read into memory first when a signer
+// is configured. Responses with an error status code are read and returned as an *OperationError.
+func (c *Client) openStream(ctx context.Context, method string, path string, query url.Values, header http.Header, body io.Reader) (*http.Response, error) {
+	path = "/" + strings.TrimPrefix(path, "/")
+
+	target, err := url.Parse(c.baseURL + path)
+	if err != nil {
+		return nil, &OperationError{Method: method, Path: path, Err: fmt.Errorf("failed to parse URL: %w", err)}
+	}
+	if query != nil {
+		target.RawQuery = query.Encode()
+	}
+
+	return c.openURL(ctx, method, path, target, true, header, body)
+}
+
+// openURL sends a request to a full URL like openStream, for the files stored in the server and
+// in external storages. The path is the one given to the operation policy and reported in errors.
+//
+// The API token and the request signer are only used for the server, so they never reach other
+// hosts, while the operation policy and the rate limit callback apply to every request.
+func (c *Client) openURL(ctx context.Context, method string, path string, target *url.URL, onServer bool, header http.Header, body io.Reader) (*http.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	opErr := &OperationError{Method: method, Path: path}
+
+	if err := c.checkPolicy(method, path, nil); err != nil {
+		opErr.Err = err
+		return nil, opErr
+	}
+
+	var err error
+	var signedBody []byte
+	if onServer && c.signer != nil && body != nil {
+		if signedBody, err = io.ReadAll(body); err != nil {
+			opErr.Err = fmt.Errorf("failed to read request body: %w", err)
+			return nil, opErr
+		}
+		body = bytes.NewReader(signedBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		opErr.Err = fmt.Errorf("failed to create request: %w", err)
+		return nil, opErr
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if onServer {
+		req.Header.Set("xc-token", c.apiToken)
+
+		if c.signer != nil {
+			if err := c.signer.SignRequest(req, signedBody); err != nil {
+				opErr.Err = fmt.Errorf("failed to sign request: %w", err)
+				return nil, opErr
+			}
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		opErr.Err = fmt.Errorf("failed to send request: %w", err)
+		return nil, opErr
+	}
+
+	if c.onRateLimit != nil {
+		if rateLimit, ok := parseRateLimit(resp.Header, c.clock.Now()); ok {
+			rateLimit.Method = method
+			rateLimit.Path = opErr.Path
+			c.onRateLimit(rateLimit)
+		}
 	}
 
 	if resp.StatusCode >= 400 {
-		var apiErr apiError
-		if err := json.Unmarshal(respBody, &apiErr); err != nil {
-			return nil, fmt.Errorf("status code %d: failed to unmarshal API error: %w", resp.StatusCode, err)
+		defer resp.Body.Close()
+		opErr.StatusCode = resp.StatusCode
+		respBody, err := readResponseBody(resp)
+		if err != nil {
+			opErr.Err = fmt.Errorf("failed to read response body: %w", err)
+			return nil, opErr
 		}
-		return nil, fmt.Errorf("status code %d: API error: %s", resp.StatusCode, apiErr.Error())
+		opErr.Err = responseError(resp.StatusCode, respBody)
+		return nil, opErr
 	}
 
-	return respBody, nil
+	return resp, nil
+}
+
+// responseError returns the error of a response with an error status code, with the message of
+// the API error in its body.
+func responseError(statusCode int, body []byte) error {
+	var apiErr apiError
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return fmt.Errorf("status code %d: failed to unmarshal API error: %w", statusCode, err)
+	}
+	return fmt.Errorf("status code %d: API error: %s", statusCode, apiErr.Error())
 }
 
 // Table returns a new Table instance for the specified table ID.
@@ -224,4 +662,25 @@ func (c *Client) Table(tableID string) *Table {
 type Table struct {
 	client  *Client
 	tableID string
+
+	// baseID is the identifier of the base of the table, empty if the table was not obtained from a Base
+	baseID string
+
+	// readLoader coalesces concurrent record reads, nil when disabled
+	readLoader *recordLoader
+
+	// defaults are the query options applied to the queries built from the table
+	defaults QueryDefaults
+
+	// timestamps are the caller-managed timestamp columns stamped on create and update
+	timestamps tableTimestamps
+
+	// createDefaults are the values filled in on the records created from the table
+	createDefaults map[string]any
+
+	// beforeCreateHooks are called with every record created from the table
+	beforeCreateHooks []func(record map[string]any)
+
+	// aliases resolves the old titles of the renamed columns, nil when the table has none
+	aliases *columnAliases
 }
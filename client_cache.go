@@ -0,0 +1,101 @@
+package nocodbgo
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+)
+
+const (
+	// defaultResponseCacheSize is the default maximum number of responses kept by the response cache
+	defaultResponseCacheSize = 1000
+)
+
+// responseCache stores the bodies of GET responses along with their validators (ETag and
+// Last-Modified) so they can be revalidated with conditional requests.
+//
+// It is safe for concurrent use and evicts the least recently used entries when full.
+type responseCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+// responseCacheEntry is a cached response
+type responseCacheEntry struct {
+	key          string
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// newResponseCache creates a response cache that holds up to maxEntries responses.
+func newResponseCache(maxEntries int) *responseCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultResponseCacheSize
+	}
+
+	return &responseCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+// get returns the cached response for the given key and whether it was found.
+func (c *responseCache) get(key string) (responseCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return responseCacheEntry{}, false
+	}
+
+	c.order.MoveToFront(element)
+	return *element.Value.(*responseCacheEntry), true
+}
+
+// store caches the response body for the given key if the response has validators, otherwise it
+// removes any previously cached response for the key.
+func (c *responseCache) store(key string, header http.Header, body []byte) {
+	etag := header.Get("ETag")
+	lastModified := header.Get("Last-Modified")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+
+	if etag == "" && lastModified == "" {
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&responseCacheEntry{
+		key:          key,
+		etag:         etag,
+		lastModified: lastModified,
+		body:         body,
+	})
+
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*responseCacheEntry).key)
+	}
+}
+
+// setConditionalHeaders adds the If-None-Match and If-Modified-Since headers to the request
+// using the validators of the cached response.
+func (e responseCacheEntry) setConditionalHeaders(req *http.Request) {
+	if e.etag != "" {
+		req.Header.Set("If-None-Match", e.etag)
+	}
+	if e.lastModified != "" {
+		req.Header.Set("If-Modified-Since", e.lastModified)
+	}
+}
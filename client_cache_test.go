@@ -0,0 +1,71 @@
+package nocodbgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestResponseCache(t *testing.T) {
+	var requests, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"list":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithResponseCache(0).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	query := url.Values{"limit": []string{"10"}}
+	for i := 0; i < 3; i++ {
+		body, err := client.request(context.Background(), http.MethodGet, "/api/v2/tables/t1/records", nil, query)
+		if err != nil {
+			t.Fatalf("request() error = %v", err)
+		}
+		if string(body) != `{"list":[]}` {
+			t.Errorf("request() body = %s, want cached body", body)
+		}
+	}
+
+	if requests.Load() != 3 || notModified.Load() != 2 {
+		t.Errorf("requests = %d, not modified = %d, want 3 and 2", requests.Load(), notModified.Load())
+	}
+}
+
+func TestResponseCacheEviction(t *testing.T) {
+	cache := newResponseCache(2)
+	header := http.Header{"Etag": []string{`"v1"`}}
+
+	cache.store("a", header, []byte("a"))
+	cache.store("b", header, []byte("b"))
+	cache.get("a")
+	cache.store("c", header, []byte("c"))
+
+	if _, ok := cache.get("b"); ok {
+		t.Error("get(b) found, want evicted as least recently used")
+	}
+	if entry, ok := cache.get("a"); !ok || string(entry.body) != "a" {
+		t.Errorf("get(a) = %v, %v, want cached", entry, ok)
+	}
+
+	cache.store("a", http.Header{}, []byte("a2"))
+	if _, ok := cache.get("a"); ok {
+		t.Error("get(a) found, want removed after a response without validators")
+	}
+}
@@ -0,0 +1,58 @@
+package nocodbgo
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WithCompression sets if the client asks the server for gzip compressed responses, which is
+// enabled by default.
+//
+// The client sends "Accept-Encoding: gzip" explicitly and decompresses the responses itself, so
+// compression also works with custom transports that disable the automatic decompression of the
+// Go HTTP transport. Disable it when a proxy in front of NocoDB doesn't handle compression well,
+// or to save CPU when the server is on the same network.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithCompression(false).
+//		Create()
+func (b *clientBuilder) WithCompression(enabled bool) *clientBuilder {
+	b.disableCompression = !enabled
+	return b
+}
+
+// setAcceptEncoding asks for a gzip compressed response, unless compression is disabled or the
+// headers of the request already set the accepted encodings.
+func (c *Client) setAcceptEncoding(req *http.Request) {
+	if c.disableCompression || req.Header.Get("Accept-Encoding") != "" {
+		return
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+}
+
+// readResponseBody reads the body of a response, decompressing it if it's gzip compressed and the
+// transport didn't decompress it already.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return io.ReadAll(resp.Body)
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress response: %w", err)
+	}
+	defer reader.Close()
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress response: %w", err)
+	}
+	return body, nil
+}
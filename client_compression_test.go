@@ -0,0 +1,59 @@
+package nocodbgo
+
+import (
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompression(t *testing.T) {
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		body := `{"list":[{"Id":1,"Name":"Ana"}],"pageInfo":{"isLastPage":true}}`
+		if acceptEncoding != "gzip" {
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(body))
+		_ = gz.Close()
+	}))
+	defer server.Close()
+
+	for _, tt := range []struct {
+		name       string
+		enabled    bool
+		wantHeader string
+	}{
+		{name: "enabled", enabled: true, wantHeader: "gzip"},
+		{name: "disabled", enabled: false, wantHeader: ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// Custom transport without the automatic decompression of the Go HTTP transport
+			httpClient := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+			client, err := NewClient().
+				WithBaseURL(server.URL).
+				WithAPIToken("test-token").
+				WithHTTPClient(httpClient).
+				WithCompression(tt.enabled).
+				Create()
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			result, err := client.Table("users").ListRecords().Execute()
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if len(result.List) != 1 || result.List[0]["Name"] != "Ana" {
+				t.Errorf("Execute() = %v, want Ana", result.List)
+			}
+			if acceptEncoding != tt.wantHeader {
+				t.Errorf("Accept-Encoding = %q, want %q", acceptEncoding, tt.wantHeader)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// inflightGroup deduplicates identical concurrent GET requests, so they share one HTTP call.
+//
+// It is safe for concurrent use.
+type inflightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// inflightCall is a request in flight and its result once done
+type inflightCall struct {
+	done       chan struct{}
+	body       []byte
+	statusCode int
+	err        error
+}
+
+// newInflightGroup creates an empty inflightGroup.
+func newInflightGroup() *inflightGroup {
+	return &inflightGroup{calls: map[string]*inflightCall{}}
+}
+
+// do calls send unless an identical request is already in flight, in which case it waits for its
+// result instead.
+//
+// The shared call runs with a context that is not canceled when the caller that started it gives
+// up, so the other callers still get the result. Each caller stops waiting when its own context is
+// done.
+func (g *inflightGroup) do(ctx context.Context, key string, send func(ctx context.Context) ([]byte, int, error)) ([]byte, int, error) {
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if !ok {
+		call = &inflightCall{done: make(chan struct{})}
+		g.calls[key] = call
+
+		go func() {
+			call.body, call.statusCode, call.err = send(context.WithoutCancel(ctx))
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.body, call.statusCode, call.err
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+}
+
+// inflightKey returns the key that identifies identical requests, made of the URL and the headers
+// of the request.
+func inflightKey(baseURL string, path string, query url.Values, header http.Header) string {
+	var key bytes.Buffer
+	key.WriteString(baseURL)
+	key.WriteString("/")
+	key.WriteString(strings.TrimPrefix(path, "/"))
+	if query != nil {
+		key.WriteString("?")
+		key.WriteString(query.Encode())
+	}
+	key.WriteString("\n")
+	_ = header.Write(&key)
+	return key.String()
+}
+
+// WithRequestDeduplication makes identical concurrent GET requests share one HTTP call, reducing
+// the load on the server when many goroutines read the same records at the same time, for example
+// when rendering the same page for many users.
+//
+// Requests are identical when they have the same URL, query and headers. The callers receive the
+// same response body, so they must not modify it.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithRequestDeduplication().
+//		Create()
+func (b *clientBuilder) WithRequestDeduplication() *clientBuilder {
+	b.deduplicate = true
+	return b
+}
@@ -0,0 +1,73 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestDeduplication(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"list":[{"Id":1,"Name":"Ana"}],"pageInfo":{"isLastPage":true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithRequestDeduplication().
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	const callers = 10
+	var wg, started sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			result, err := table.ListRecords().WhereIsEqualTo("Name", "Ana").Execute()
+			if err == nil && len(result.List) != 1 {
+				err = errors.New("missing records")
+			}
+			errs <- err
+		}()
+	}
+
+	// A canceled caller stops waiting without affecting the others
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := table.ListRecords().WithContext(ctx).WhereIsEqualTo("Name", "Ana").Execute(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Execute() with a canceled context error = %v, want %v", err, context.Canceled)
+	}
+
+	// Give the callers time to join the shared request before the server answers
+	started.Wait()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Execute() error = %v", err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server received %d requests, want 1 shared request", got)
+	}
+}
@@ -0,0 +1,75 @@
+package nocodbgo
+
+import "fmt"
+
+// defaultMutationGuardThreshold is the number of records a mutation can affect without consulting
+// the mutation guard, so single record updates and deletes are not guarded
+const defaultMutationGuardThreshold = 1
+
+// MutationInfo describes a bulk update or delete the client is about to run, for the guard set
+// with WithMutationGuard
+type MutationInfo struct {
+	// Operation is the name of the method of the mutation: "UpdateRecords", "DeleteRecords" or
+	// "DeleteRecordsWhere"
+	Operation string
+	// TableID is the identifier of the table of the mutation
+	TableID string
+	// Records is the number of records the mutation affects
+	Records int
+	// Filters are the filters that select the records of DeleteRecordsWhere, empty otherwise
+	Filters []string
+}
+
+// mutationGuard is the guard consulted before the bulk mutations and the size above which it is
+type mutationGuard struct {
+	guard     func(op MutationInfo) error
+	threshold int
+}
+
+// WithMutationGuard sets a guard that is called before the updates and deletes of more records
+// than the threshold set with WithMutationGuardThreshold, one by default. When the guard returns
+// an error, nothing is changed and the operation fails with an error that wraps both
+// ErrOperationDenied and the error of the guard.
+//
+// Unlike WithOperationPolicy, which sees the requests, the guard sees whole operations, so a
+// DeleteRecordsWhere is confirmed once with all its records even if it's sent in several requests.
+// Interactive tools can use it to ask for confirmation and services to enforce limits.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithMutationGuard(func(op nocodbgo.MutationInfo) error {
+//			if !confirm(fmt.Sprintf("%s will change %d records of %s, continue?", op.Operation, op.Records, op.TableID)) {
+//				return errors.New("canceled by the user")
+//			}
+//			return nil
+//		}).
+//		WithMutationGuardThreshold(10).
+//		Create()
+func (b *clientBuilder) WithMutationGuard(guard func(op MutationInfo) error) *clientBuilder {
+	b.mutationGuard.guard = guard
+	return b
+}
+
+// WithMutationGuardThreshold sets the number of records an update or delete can affect without
+// calling the guard set with WithMutationGuard. Zero calls the guard for every update and delete.
+func (b *clientBuilder) WithMutationGuardThreshold(records int) *clientBuilder {
+	b.mutationGuard.threshold = max(records, 0)
+	return b
+}
+
+// checkMutation calls the mutation guard of the client about a mutation, if any and if the
+// mutation affects more records than the threshold.
+func (c *Client) checkMutation(op MutationInfo) error {
+	if c.mutationGuard.guard == nil || op.Records <= c.mutationGuard.threshold {
+		return nil
+	}
+
+	if err := c.mutationGuard.guard(op); err != nil {
+		return fmt.Errorf("%w: %w", ErrOperationDenied, err)
+	}
+
+	return nil
+}
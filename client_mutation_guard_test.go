@@ -0,0 +1,60 @@
+package nocodbgo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestMutationGuard(t *testing.T) {
+	fake := nocodbgotest.New()
+	ids := fake.Seed("users",
+		map[string]any{"Name": "Ana", "Status": "expired"},
+		map[string]any{"Name": "Bob", "Status": "expired"},
+		map[string]any{"Name": "Cid", "Status": "expired"},
+		map[string]any{"Name": "Dan", "Status": "active"},
+	)
+
+	errTooMany := errors.New("too many records")
+	var calls []MutationInfo
+	client, err := NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		WithMutationGuard(func(op MutationInfo) error {
+			calls = append(calls, op)
+			if op.Records > 2 {
+				return errTooMany
+			}
+			return nil
+		}).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	users := client.Table("users")
+
+	if err := users.DeleteRecord(ids[3]).Execute(); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+	if err := users.UpdateRecords([]map[string]any{{"Id": ids[0], "Name": "Ana"}, {"Id": ids[1], "Name": "Bob"}}).Execute(); err != nil {
+		t.Fatalf("UpdateRecords() error = %v", err)
+	}
+	_, err = users.DeleteRecordsWhere().WhereIsEqualTo("Status", "expired").Execute()
+	if !errors.Is(err, ErrOperationDenied) || !errors.Is(err, errTooMany) {
+		t.Errorf("DeleteRecordsWhere() error = %v, want %v and %v", err, ErrOperationDenied, errTooMany)
+	}
+	if got := len(fake.Records("users")); got != 3 {
+		t.Errorf("records = %d, want 3", got)
+	}
+
+	want := []MutationInfo{
+		{Operation: "UpdateRecords", TableID: "users", Records: 2},
+		{Operation: "DeleteRecordsWhere", TableID: "users", Records: 3, Filters: []string{"(Status,eq,expired)"}},
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("guard calls = %+v, want %+v", calls, want)
+	}
+}
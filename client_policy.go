@@ -0,0 +1,79 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Operation describes a request the client is about to send, for the policy set with
+// WithOperationPolicy
+type Operation struct {
+	// Method is the HTTP method of the request (e.g. "DELETE")
+	Method string
+	// Path is the path of the request, without the base URL and the query (e.g. "/api/v2/tables/m_xxx/records")
+	Path string
+	// Meta reports if the request is for the meta API (tables, columns, views, bases...) instead of
+	// the data API
+	Meta bool
+	// Records is the number of records in the body of the request when the body is a list of
+	// records, as in bulk creates, updates and deletes, 0 otherwise
+	Records int
+}
+
+// IsWrite reports if the operation changes data, that is, if its method is not GET or HEAD.
+func (o Operation) IsWrite() bool {
+	return o.Method != http.MethodGet && o.Method != http.MethodHead
+}
+
+// WithOperationPolicy sets a policy that is consulted before each request of the client. When the
+// policy returns an error, the request is not sent and the operation fails with an error that
+// wraps both ErrOperationDenied and the error of the policy.
+//
+// It lets platform teams forbid operations centrally, for example bulk deletes or schema changes
+// from services that should only work with records.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithOperationPolicy(func(op nocodbgo.Operation) error {
+//			if op.Meta && op.IsWrite() {
+//				return errors.New("schema changes are not allowed from this service")
+//			}
+//			if op.Method == http.MethodDelete && op.Records > 100 {
+//				return fmt.Errorf("deleting %d records at once is not allowed", op.Records)
+//			}
+//			return nil
+//		}).
+//		Create()
+func (b *clientBuilder) WithOperationPolicy(policy func(op Operation) error) *clientBuilder {
+	b.policy = policy
+	return b
+}
+
+// checkPolicy consults the operation policy of the client about a request, if any.
+func (c *Client) checkPolicy(method string, path string, body any) error {
+	if c.policy == nil {
+		return nil
+	}
+
+	op := Operation{
+		Method: method,
+		Path:   "/" + strings.TrimPrefix(path, "/"),
+		Meta:   strings.Contains(path, "/meta/"),
+	}
+	if body != nil {
+		if value := reflect.ValueOf(body); value.Kind() == reflect.Slice {
+			op.Records = value.Len()
+		}
+	}
+
+	if err := c.policy(op); err != nil {
+		return fmt.Errorf("%w: %w", ErrOperationDenied, err)
+	}
+
+	return nil
+}
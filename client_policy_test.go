@@ -0,0 +1,66 @@
+package nocodbgo
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestOperationPolicy(t *testing.T) {
+	fake := nocodbgotest.New()
+	fake.Seed("users", map[string]any{"Name": "Ana"}, map[string]any{"Name": "Bob"}, map[string]any{"Name": "Cid"})
+
+	errTooMany := errors.New("too many records")
+	var ops []Operation
+	client, err := NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		WithOperationPolicy(func(op Operation) error {
+			ops = append(ops, op)
+			if op.Meta && op.IsWrite() {
+				return errors.New("schema changes are not allowed")
+			}
+			if op.Method == http.MethodDelete && op.Records > 1 {
+				return errTooMany
+			}
+			return nil
+		}).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	if _, err := table.ListRecords().Execute(); err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if err := table.DeleteRecord(1).Execute(); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+
+	err = table.DeleteRecords([]int{2, 3}).Execute()
+	if !errors.Is(err, ErrOperationDenied) || !errors.Is(err, errTooMany) {
+		t.Errorf("DeleteRecords() error = %v, want %v and %v", err, ErrOperationDenied, errTooMany)
+	}
+	if records := fake.Records("users"); len(records) != 2 {
+		t.Errorf("records after the denied delete = %v, want 2 records", records)
+	}
+
+	if err := client.View("vw_1").DeleteFilter("fi_1").Execute(); !errors.Is(err, ErrOperationDenied) {
+		t.Errorf("DeleteFilter() error = %v, want %v", err, ErrOperationDenied)
+	}
+
+	want := []Operation{
+		{Method: http.MethodGet, Path: "/api/v2/tables/users/records"},
+		{Method: http.MethodDelete, Path: "/api/v2/tables/users/records", Records: 1},
+		{Method: http.MethodDelete, Path: "/api/v2/tables/users/records", Records: 2},
+		{Method: http.MethodDelete, Path: "/api/v2/meta/filters/fi_1", Meta: true},
+	}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("operations =\n%+v\nwant\n%+v", ops, want)
+	}
+}
@@ -0,0 +1,56 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is the rate limit state reported by the x-ratelimit-* headers of a response.
+//
+// The fields whose header is missing or invalid are left at their zero value.
+type RateLimit struct {
+	// Limit is the maximum number of requests allowed in the current window (x-ratelimit-limit)
+	Limit int
+	// Remaining is the number of requests left in the current window (x-ratelimit-remaining)
+	Remaining int
+	// Reset is the time when the current window resets (x-ratelimit-reset)
+	Reset time.Time
+	// Method is the HTTP method of the request the response belongs to
+	Method string
+	// Path is the path of the request the response belongs to, without the base URL and the query
+	Path string
+}
+
+// unixResetThreshold is the value of the x-ratelimit-reset header from which it's considered a
+// Unix timestamp instead of a number of seconds until the reset
+const unixResetThreshold = 1_000_000_000
+
+// parseRateLimit parses the x-ratelimit-* headers of a response. It reports false if the response
+// has none of them.
+//
+// The reset header is accepted both as the number of seconds until the reset and as a Unix
+// timestamp, since proxies in front of NocoDB use both conventions.
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	limit := header.Get("X-Ratelimit-Limit")
+	remaining := header.Get("X-Ratelimit-Remaining")
+	reset := header.Get("X-Ratelimit-Reset")
+	if limit == "" && remaining == "" && reset == "" {
+		return RateLimit{}, false
+	}
+
+	var rateLimit RateLimit
+	rateLimit.Limit, _ = strconv.Atoi(strings.TrimSpace(limit))
+	rateLimit.Remaining, _ = strconv.Atoi(strings.TrimSpace(remaining))
+
+	if seconds, err := strconv.ParseFloat(strings.TrimSpace(reset), 64); err == nil && seconds >= 0 {
+		if seconds >= unixResetThreshold {
+			rateLimit.Reset = time.Unix(int64(seconds), 0)
+		} else {
+			rateLimit.Reset = now.Add(time.Duration(seconds * float64(time.Second)))
+		}
+	}
+
+	return rateLimit, true
+}
@@ -0,0 +1,84 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   RateLimit
+		wantOK bool
+	}{
+		{name: "no headers", header: http.Header{}},
+		{
+			name:   "seconds until reset",
+			header: http.Header{"X-Ratelimit-Limit": {"100"}, "X-Ratelimit-Remaining": {"42"}, "X-Ratelimit-Reset": {"30"}},
+			want:   RateLimit{Limit: 100, Remaining: 42, Reset: now.Add(30 * time.Second)},
+			wantOK: true,
+		},
+		{
+			name:   "unix reset",
+			header: http.Header{"X-Ratelimit-Reset": {"1709636400"}},
+			want:   RateLimit{Reset: time.Unix(1709636400, 0)},
+			wantOK: true,
+		},
+		{
+			name:   "invalid values",
+			header: http.Header{"X-Ratelimit-Limit": {"lots"}, "X-Ratelimit-Remaining": {"7"}, "X-Ratelimit-Reset": {"soon"}},
+			want:   RateLimit{Remaining: 7},
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRateLimit(tt.header, now)
+			if ok != tt.wantOK || got.Limit != tt.want.Limit || got.Remaining != tt.want.Remaining || !got.Reset.Equal(tt.want.Reset) {
+				t.Errorf("parseRateLimit() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestClientRateLimitCallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/tables/users/records" {
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", "99")
+		}
+		_, _ = w.Write([]byte(`{"list":[],"pageInfo":{},"count":0}`))
+	}))
+	defer server.Close()
+
+	var got []RateLimit
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithRateLimitCallback(func(rl RateLimit) { got = append(got, rl) }).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := client.Table("users").ListRecords().Execute(); err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if _, err := client.Table("users").CountRecords().Execute(); err != nil {
+		t.Fatalf("CountRecords() error = %v", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("callback called %d times, want 1", len(got))
+	}
+	want := RateLimit{Limit: 100, Remaining: 99, Method: http.MethodGet, Path: "/api/v2/tables/users/records"}
+	if got[0] != want {
+		t.Errorf("RateLimit = %+v, want %+v", got[0], want)
+	}
+}
@@ -0,0 +1,45 @@
+package nocodbgo
+
+import "net/http"
+
+// RequestSigner signs the requests of the client before they are sent, for deployments where
+// NocoDB sits behind a gateway that requires signed requests (e.g. an HMAC of the method, path and
+// body in a header).
+type RequestSigner interface {
+	// SignRequest is called with every request right before it's sent, with all its headers set,
+	// and the encoded body of the request, which is nil for requests without a body. It can add
+	// headers or query parameters to the request, and returning an error aborts the request.
+	//
+	// The bodies that are otherwise streamed, like the files of attachment uploads, are read into
+	// memory before the request is signed.
+	SignRequest(req *http.Request, body []byte) error
+}
+
+// RequestSignerFunc is a function that implements the RequestSigner interface.
+type RequestSignerFunc func(req *http.Request, body []byte) error
+
+// SignRequest implements the RequestSigner interface for RequestSignerFunc.
+func (f RequestSignerFunc) SignRequest(req *http.Request, body []byte) error {
+	return f(req, body)
+}
+
+// WithRequestSigner sets a signer that is invoked with every request before it's sent, after the
+// body has been encoded.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://gateway.example.com").
+//		WithAPIToken("your-api-token").
+//		WithRequestSigner(nocodbgo.RequestSignerFunc(func(req *http.Request, body []byte) error {
+//			mac := hmac.New(sha256.New, secret)
+//			mac.Write([]byte(req.Method + req.URL.RequestURI()))
+//			mac.Write(body)
+//			req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+//			return nil
+//		})).
+//		Create()
+func (b *clientBuilder) WithRequestSigner(signer RequestSigner) *clientBuilder {
+	b.signer = signer
+	return b
+}
@@ -0,0 +1,73 @@
+package nocodbgo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestSigner(t *testing.T) {
+	secret := []byte("gateway-secret")
+	sign := func(method, uri string, body []byte) string {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(method + uri))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("X-Signature") != sign(r.Method, r.URL.RequestURI(), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"msg":"invalid signature"}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`[{"Id":1}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"list":[],"pageInfo":{"isLastPage":true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithRequestSigner(RequestSignerFunc(func(req *http.Request, body []byte) error {
+			if req.Header.Get("xc-token") == "" {
+				return errors.New("signed before the headers were set")
+			}
+			req.Header.Set("X-Signature", sign(req.Method, req.URL.RequestURI(), body))
+			return nil
+		})).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	if _, err := table.ListRecords().WhereIsEqualTo("Name", "Ana").Execute(); err != nil {
+		t.Errorf("ListRecords() error = %v", err)
+	}
+	if _, err := table.CreateRecord(map[string]any{"Name": "Ana"}).Execute(); err != nil {
+		t.Errorf("CreateRecord() error = %v", err)
+	}
+
+	wantErr := errors.New("no signing key")
+	failing, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithRequestSigner(RequestSignerFunc(func(*http.Request, []byte) error { return wantErr })).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := failing.Table("users").ListRecords().Execute(); !errors.Is(err, wantErr) {
+		t.Errorf("ListRecords() with a failing signer error = %v, want %v", err, wantErr)
+	}
+}
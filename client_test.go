@@ -1,9 +1,15 @@
 package nocodbgo
 
 import (
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
 )
 
 func TestClientBuilder(t *testing.T) {
@@ -98,3 +104,162 @@ func TestClientBuilder(t *testing.T) {
 		t.Errorf("Create() error = %v, want %v", err, ErrHTTPClientRequired)
 	}
 }
+
+func TestClientPageSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		defaultSize int
+		maxSize     int
+		limit       int
+		wantQuery   string
+		wantPage    int
+	}{
+		{name: "server default", wantQuery: "", wantPage: defaultIteratorPageSize},
+		{name: "explicit limit", limit: 10, wantQuery: "10", wantPage: 10},
+		{name: "client default", defaultSize: 200, wantQuery: "200", wantPage: 200},
+		{name: "explicit limit over default", defaultSize: 200, limit: 5, wantQuery: "5", wantPage: 5},
+		{name: "capped limit", maxSize: 1000, limit: 5000, wantQuery: "1000", wantPage: 1000},
+		{name: "capped default", defaultSize: 500, maxSize: 100, wantQuery: "100", wantPage: 100},
+		{name: "max without limit", maxSize: 50, wantQuery: "50", wantPage: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient().
+				WithBaseURL("https://example.com").
+				WithAPIToken("test-token").
+				WithDefaultPageSize(tt.defaultSize).
+				WithMaxPageSize(tt.maxSize).
+				Create()
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			b := client.Table("tbl").ListRecords()
+			if tt.limit > 0 {
+				b = b.Limit(tt.limit)
+			}
+			if got := b.buildQuery().Get("limit"); got != tt.wantQuery {
+				t.Errorf("limit = %q, want %q", got, tt.wantQuery)
+			}
+			if got := client.pageSize(b.paginationProvider.rawLimit, defaultIteratorPageSize); got != tt.wantPage {
+				t.Errorf("pageSize() = %d, want %d", got, tt.wantPage)
+			}
+		})
+	}
+}
+
+func TestRecordBuildersWithHeader(t *testing.T) {
+	fake := nocodbgotest.New()
+	var tenants []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Method+" "+r.Header.Get("X-Tenant-ID"))
+		if r.Header.Get("xc-token") != "test-token" {
+			t.Errorf("xc-token = %q, want test-token", r.Header.Get("xc-token"))
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users").WithReadBatching(time.Millisecond)
+
+	id, err := table.CreateRecord(map[string]any{"Name": "Ana"}).WithHeader("X-Tenant-ID", "acme").Execute()
+	if err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if _, err := table.ReadRecord(id).WithHeader("X-Tenant-ID", "acme").Execute(); err != nil {
+		t.Fatalf("ReadRecord() error = %v", err)
+	}
+	err = table.UpdateRecord(map[string]any{"Id": id, "Name": "Bob"}).
+		WithHeader("X-Tenant-ID", "acme").
+		IfUnmodifiedSince(time.Now().Add(time.Hour)).
+		Execute()
+	if err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	if _, err := table.ListRecords().WithHeader("X-Tenant-ID", "other").Clone().Execute(); err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if err := table.DeleteRecord(id).Execute(); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+
+	want := []string{"POST acme", "GET acme", "GET acme", "PATCH acme", "GET other", "DELETE "}
+	if !slices.Equal(tenants, want) {
+		t.Errorf("requests = %v, want %v", tenants, want)
+	}
+}
+
+func TestOperationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"msg":"Record not found"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		execute func() error
+		want    OperationError
+	}{
+		{
+			name: "read record",
+			execute: func() error {
+				_, err := client.Table("users").ReadRecord(7).Execute()
+				return err
+			},
+			want: OperationError{Operation: "ReadRecord", TableID: "users", RecordID: IntID(7), Method: http.MethodGet, Path: "/api/v2/tables/users/records/7"},
+		},
+		{
+			name: "delete record",
+			execute: func() error {
+				return client.Table("users").DeleteRecord(3).Execute()
+			},
+			want: OperationError{Operation: "DeleteRecord", TableID: "users", RecordID: IntID(3), Method: http.MethodDelete, Path: "/api/v2/tables/users/records"},
+		},
+		{
+			name: "update record",
+			execute: func() error {
+				return client.Table("users").UpdateRecord(map[string]any{"Id": 5, "Name": "Ana"}).Execute()
+			},
+			want: OperationError{Operation: "UpdateRecord", TableID: "users", RecordID: IntID(5), Method: http.MethodPatch, Path: "/api/v2/tables/users/records"},
+		},
+		{
+			name: "list records",
+			execute: func() error {
+				_, err := client.Table("users").ListRecords().Execute()
+				return err
+			},
+			want: OperationError{Operation: "ListRecords", TableID: "users", Method: http.MethodGet, Path: "/api/v2/tables/users/records"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.execute()
+
+			var opErr *OperationError
+			if !errors.As(err, &opErr) {
+				t.Fatalf("error = %v, want an OperationError", err)
+			}
+			got := *opErr
+			got.Err = nil
+			tt.want.StatusCode = http.StatusNotFound
+			if got != tt.want {
+				t.Errorf("OperationError = %+v, want %+v", got, tt.want)
+			}
+			if !strings.Contains(err.Error(), "Record not found") {
+				t.Errorf("Error() = %q, want the API message", err.Error())
+			}
+		})
+	}
+}
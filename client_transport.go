@@ -0,0 +1,98 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// transportOptions are the tuning options of the HTTP transport set on the client builder, applied
+// to a copy of the transport of the HTTP client when the client is created
+type transportOptions struct {
+	maxIdleConnsPerHost *int
+	idleConnTimeout     *time.Duration
+	forceAttemptHTTP2   *bool
+}
+
+// isSet reports if any of the options has been set.
+func (o transportOptions) isSet() bool {
+	return o.maxIdleConnsPerHost != nil || o.idleConnTimeout != nil || o.forceAttemptHTTP2 != nil
+}
+
+// apply returns a copy of the HTTP client whose transport is a copy of the original one with the
+// options set, so the HTTP client given to the builder is never modified.
+//
+// It returns an error if the HTTP client uses a custom transport that is not an *http.Transport,
+// since its connection pool can't be configured.
+func (o transportOptions) apply(httpClient *http.Client) (*http.Client, error) {
+	if !o.isSet() {
+		return httpClient, nil
+	}
+
+	var transport *http.Transport
+	switch t := httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf("the transport options require an *http.Transport, got %T", t)
+	}
+
+	if o.maxIdleConnsPerHost != nil {
+		transport.MaxIdleConnsPerHost = *o.maxIdleConnsPerHost
+		if transport.MaxIdleConns > 0 && transport.MaxIdleConns < *o.maxIdleConnsPerHost {
+			transport.MaxIdleConns = *o.maxIdleConnsPerHost
+		}
+	}
+	if o.idleConnTimeout != nil {
+		transport.IdleConnTimeout = *o.idleConnTimeout
+	}
+	if o.forceAttemptHTTP2 != nil {
+		transport.ForceAttemptHTTP2 = *o.forceAttemptHTTP2
+	}
+
+	client := *httpClient
+	client.Transport = transport
+	return &client, nil
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept open to the NocoDB
+// server, instead of the default of 2 of the Go HTTP transport. Services that send many concurrent
+// requests should raise it to reuse connections instead of opening new ones.
+//
+// The option is applied to a copy of the transport of the HTTP client, which must be the default
+// transport or an *http.Transport.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithMaxIdleConnsPerHost(64).
+//		WithIdleConnTimeout(2 * time.Minute).
+//		Create()
+func (b *clientBuilder) WithMaxIdleConnsPerHost(maxIdleConns int) *clientBuilder {
+	b.transport.maxIdleConnsPerHost = &maxIdleConns
+	return b
+}
+
+// WithIdleConnTimeout sets how long an idle connection to the NocoDB server is kept open before
+// closing it. Zero means no limit.
+//
+// The option is applied to a copy of the transport of the HTTP client, which must be the default
+// transport or an *http.Transport.
+func (b *clientBuilder) WithIdleConnTimeout(timeout time.Duration) *clientBuilder {
+	b.transport.idleConnTimeout = &timeout
+	return b
+}
+
+// WithForceAttemptHTTP2 sets if the transport attempts HTTP/2 even when it has a custom TLS or
+// dial configuration, which disables HTTP/2 in the Go HTTP transport unless this is set.
+//
+// The option is applied to a copy of the transport of the HTTP client, which must be the default
+// transport or an *http.Transport.
+func (b *clientBuilder) WithForceAttemptHTTP2(force bool) *clientBuilder {
+	b.transport.forceAttemptHTTP2 = &force
+	return b
+}
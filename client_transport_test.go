@@ -0,0 +1,59 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// roundTripperFunc is an http.RoundTripper implemented by a function
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements the http.RoundTripper interface for roundTripperFunc.
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClientTransportOptions(t *testing.T) {
+	original := &http.Transport{MaxIdleConns: 10}
+	httpClient := &http.Client{Transport: original, Timeout: time.Second}
+
+	client, err := NewClient().
+		WithBaseURL("https://example.com").
+		WithAPIToken("test-token").
+		WithHTTPClient(httpClient).
+		WithMaxIdleConnsPerHost(64).
+		WithIdleConnTimeout(time.Minute).
+		WithForceAttemptHTTP2(true).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", client.httpClient.Transport)
+	}
+	if transport.MaxIdleConnsPerHost != 64 || transport.MaxIdleConns != 64 || transport.IdleConnTimeout != time.Minute || !transport.ForceAttemptHTTP2 {
+		t.Errorf("Transport = %+v, want the options applied", transport)
+	}
+	if client.httpClient.Timeout != time.Second {
+		t.Errorf("Timeout = %v, want the timeout of the HTTP client", client.httpClient.Timeout)
+	}
+	if original.MaxIdleConnsPerHost != 0 || httpClient.Transport != original {
+		t.Error("Create() modified the HTTP client given to the builder")
+	}
+
+	client, err = NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").WithIdleConnTimeout(time.Second).Create()
+	if err != nil {
+		t.Fatalf("Create() with the default transport error = %v", err)
+	}
+	if transport := client.httpClient.Transport.(*http.Transport); transport == http.DefaultTransport || transport.IdleConnTimeout != time.Second {
+		t.Errorf("Transport = %+v, want a copy of the default transport with the option applied", transport)
+	}
+
+	custom := &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, nil })}
+	if _, err := NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").WithHTTPClient(custom).WithMaxIdleConnsPerHost(8).Create(); err == nil {
+		t.Error("Create() with a custom round tripper error = nil, want an error")
+	}
+}
@@ -0,0 +1,50 @@
+package nocodbgo
+
+import (
+	"context"
+	"io"
+)
+
+// UploadInfo describes a file about to be uploaded with UploadAttachment
+type UploadInfo struct {
+	// Filename is the name of the file
+	Filename string
+	// MimeType is the MIME type the file is uploaded with
+	MimeType string
+	// Size is the size of the file in bytes set with WithSize, or -1 if it's unknown
+	Size int64
+	// Path is the folder of the storage set with WithPath, empty for the default one
+	Path string
+}
+
+// UploadInterceptor inspects a file before it's uploaded, with its metadata and its content.
+//
+// It returns the reader to upload, which is either r or a reader that wraps it, for example to
+// check the content as it's streamed. Returning an error rejects the upload before anything is
+// sent, and an error returned by the reader while the file is sent aborts the upload.
+type UploadInterceptor func(ctx context.Context, info UploadInfo, r io.Reader) (io.Reader, error)
+
+// WithUploadInterceptor adds an interceptor that is called with every file uploaded with
+// UploadAttachment before it's sent, to validate its size or MIME type or to scan its content.
+// When several interceptors are added, they are called in order, each with the reader returned by
+// the previous one.
+//
+// Example:
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL("https://example.com").
+//		WithAPIToken("your-api-token").
+//		WithUploadInterceptor(func(ctx context.Context, info nocodbgo.UploadInfo, r io.Reader) (io.Reader, error) {
+//			if !strings.HasPrefix(info.MimeType, "image/") {
+//				return nil, fmt.Errorf("%s is not an image", info.Filename)
+//			}
+//			if info.Size < 0 || info.Size > 10<<20 {
+//				return nil, fmt.Errorf("%s is larger than 10 MB", info.Filename)
+//			}
+//			return r, nil
+//		}).
+//		Create()
+func (b *clientBuilder) WithUploadInterceptor(interceptor UploadInterceptor) *clientBuilder {
+	b.uploadInterceptors = append(b.uploadInterceptors, interceptor)
+	return b
+}
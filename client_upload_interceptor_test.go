@@ -0,0 +1,83 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadInterceptor(t *testing.T) {
+	var uploads int
+	var received string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/storage/upload", func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		file, header, err := r.FormFile("files")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"msg":"missing file"}`))
+			return
+		}
+		data, _ := io.ReadAll(file)
+		received = string(data)
+		_, _ = w.Write([]byte(`[{"path":"download/` + header.Filename + `","title":"` + header.Filename + `"}]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var infos []UploadInfo
+	virus := errors.New("EICAR test signature found")
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithUploadInterceptor(func(ctx context.Context, info UploadInfo, r io.Reader) (io.Reader, error) {
+			infos = append(infos, info)
+			if !strings.HasPrefix(info.MimeType, "text/") {
+				return nil, errors.New("only text files are allowed")
+			}
+			return r, nil
+		}).
+		WithUploadInterceptor(func(ctx context.Context, info UploadInfo, r io.Reader) (io.Reader, error) {
+			// Scan the whole file before uploading it
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Contains(data, []byte("EICAR")) {
+				return nil, virus
+			}
+			return bytes.NewReader(bytes.ToUpper(data)), nil
+		}).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	attachment, err := client.UploadAttachment("notes.txt", strings.NewReader("hello")).WithSize(5).WithPath("noco/docs").Execute()
+	if err != nil {
+		t.Fatalf("UploadAttachment() error = %v", err)
+	}
+	if attachment.Title != "notes.txt" || received != "HELLO" {
+		t.Errorf("UploadAttachment() = %+v, received %q", attachment, received)
+	}
+	want := UploadInfo{Filename: "notes.txt", MimeType: "text/plain; charset=utf-8", Size: 5, Path: "noco/docs"}
+	if len(infos) != 1 || infos[0] != want {
+		t.Errorf("interceptor infos = %+v, want %+v", infos, want)
+	}
+
+	if _, err := client.UploadAttachment("photo.png", strings.NewReader("png")).Execute(); !errors.Is(err, ErrUploadRejected) {
+		t.Errorf("UploadAttachment() error = %v, want %v", err, ErrUploadRejected)
+	}
+	if _, err := client.UploadAttachment("eicar.txt", strings.NewReader("X5O EICAR")).Execute(); !errors.Is(err, ErrUploadRejected) || !errors.Is(err, virus) {
+		t.Errorf("UploadAttachment() error = %v, want %v", err, virus)
+	}
+	if uploads != 1 {
+		t.Errorf("uploads = %d, want 1", uploads)
+	}
+}
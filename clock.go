@@ -0,0 +1,26 @@
+package nocodbgo
+
+import "time"
+
+// Clock provides the current time and the timers used by the client, so that the features that
+// depend on time (read batching windows, mirror polling and rate limit resets) can be tested
+// without waiting. The nocodbgotest package provides a manual implementation.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock backed by the time package, used by default
+type systemClock struct{}
+
+// Now implements Clock using time.Now.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After implements Clock using time.After.
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+// cli holds the state shared by all the commands
+type cli struct {
+	client *nocodbgo.Client
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+// commands are the subcommands of the tool, by name
+var commands = map[string]func(c *cli, args []string) error{
+	"list":   (*cli).list,
+	"get":    (*cli).get,
+	"create": (*cli).create,
+	"update": (*cli).update,
+	"delete": (*cli).delete,
+	"import": (*cli).importCSV,
+	"export": (*cli).export,
+}
+
+// commandNames returns the names of the commands sorted and separated by commas.
+func commandNames() string {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// parseGlobalFlags parses the flags that configure the client and returns the remaining arguments.
+func parseGlobalFlags(args []string, getenv func(string) string) (*cli, []string, error) {
+	flags := flag.NewFlagSet("nocodb", flag.ContinueOnError)
+	baseURL := flags.String("url", getenv("NOCODB_URL"), "base URL of the NocoDB server (env NOCODB_URL)")
+	apiToken := flags.String("token", getenv("NOCODB_TOKEN"), "API token (env NOCODB_TOKEN)")
+	baseID := flags.String("base", getenv("NOCODB_BASE"), "base of the tables, required by the v1 and v3 data APIs (env NOCODB_BASE)")
+	apiVersion := flags.String("api", string(nocodbgo.APIVersionV2), "version of the data API: v1, v2 or v3")
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	client, err := nocodbgo.NewClient().
+		WithBaseURL(*baseURL).
+		WithAPIToken(*apiToken).
+		WithAPIVersion(nocodbgo.APIVersion(*apiVersion)).
+		WithDefaultBaseID(*baseID).
+		Create()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return &cli{client: client}, flags.Args(), nil
+}
+
+// stringsFlag is a flag that can be repeated to collect several values
+type stringsFlag []string
+
+// String implements the flag.Value interface for stringsFlag.
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, " ")
+}
+
+// Set implements the flag.Value interface for stringsFlag.
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// queryFlags are the flags that filter, sort and paginate the records of the list and export commands
+type queryFlags struct {
+	where  stringsFlag
+	equals stringsFlag
+	sort   string
+	fields string
+	limit  int
+	offset int
+}
+
+// register adds the query flags to the flag set.
+func (q *queryFlags) register(flags *flag.FlagSet) {
+	flags.Var(&q.where, "where", "filter in NocoDB syntax, e.g. \"(Age,gt,18)\" (repeatable)")
+	flags.Var(&q.equals, "eq", "equality filter as column=value (repeatable)")
+	flags.StringVar(&q.sort, "sort", "", "comma separated columns to sort by, prefixed with - for descending order")
+	flags.StringVar(&q.fields, "fields", "", "comma separated columns to return")
+	flags.IntVar(&q.limit, "limit", 0, "maximum number of records")
+	flags.IntVar(&q.offset, "offset", 0, "number of records to skip")
+}
+
+// listQuery is the part of the list builder configured by the query flags
+type listQuery[T any] interface {
+	Where(filter string) T
+	WhereIsEqualTo(column string, value string) T
+	SortAscBy(column string) T
+	SortDescBy(column string) T
+	ReturnFields(fields ...string) T
+	Limit(limit int) T
+	Offset(offset int) T
+}
+
+// applyQueryFlags configures the query with the query flags.
+func applyQueryFlags[T listQuery[T]](query T, q *queryFlags) (T, error) {
+	for _, filter := range q.where {
+		query = query.Where(filter)
+	}
+	for _, equal := range q.equals {
+		column, value, ok := strings.Cut(equal, "=")
+		if !ok {
+			return query, fmt.Errorf("invalid -eq filter %q, expected column=value", equal)
+		}
+		query = query.WhereIsEqualTo(column, value)
+	}
+	for _, column := range splitList(q.sort) {
+		if descending, ok := strings.CutPrefix(column, "-"); ok {
+			query = query.SortDescBy(descending)
+		} else {
+			query = query.SortAscBy(column)
+		}
+	}
+	if fields := splitList(q.fields); len(fields) > 0 {
+		query = query.ReturnFields(fields...)
+	}
+	if q.limit != 0 {
+		query = query.Limit(q.limit)
+	}
+	if q.offset != 0 {
+		query = query.Offset(q.offset)
+	}
+	return query, nil
+}
+
+// splitList splits a comma separated list, ignoring the empty items.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseCommand parses the flags of a command and checks it received the expected number of
+// positional arguments, the first of them the table.
+func parseCommand(flags *flag.FlagSet, args []string, minArgs int, usage string) ([]string, error) {
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: nocodb %s %s\n", flags.Name(), usage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() < minArgs {
+		flags.Usage()
+		return nil, fmt.Errorf("%s: expected %s", flags.Name(), usage)
+	}
+	return flags.Args(), nil
+}
+
+// writeJSON writes the value to the output as indented JSON.
+func (c *cli) writeJSON(value any) error {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// readInput reads the argument, or the input if the argument is "-".
+func (c *cli) readInput(arg string) ([]byte, error) {
+	if arg != "-" {
+		return []byte(arg), nil
+	}
+	return io.ReadAll(c.stdin)
+}
+
+// readRecords reads a JSON object or list of objects from the argument, reporting if it was a list.
+func (c *cli) readRecords(arg string) ([]map[string]any, bool, error) {
+	data, err := c.readInput(arg)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	var records []map[string]any
+	if err := json.Unmarshal(data, &records); err == nil {
+		return records, true, nil
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, false, fmt.Errorf("invalid JSON, expected an object or a list of objects: %w", err)
+	}
+	return []map[string]any{record}, false, nil
+}
+
+// parseIDs parses record IDs.
+func parseIDs(args []string) ([]int64, error) {
+	ids := make([]int64, len(args))
+	for i, arg := range args {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid record ID %q", arg)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// list prints a page of the records of a table.
+func (c *cli) list(args []string) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	var q queryFlags
+	q.register(flags)
+	args, err := parseCommand(flags, args, 1, "[flags] <table-id>")
+	if err != nil {
+		return err
+	}
+
+	query, err := applyQueryFlags(c.client.Table(args[0]).ListRecords(), &q)
+	if err != nil {
+		return err
+	}
+	response, err := query.Execute()
+	if err != nil {
+		return err
+	}
+
+	return c.writeJSON(response)
+}
+
+// get prints a record.
+func (c *cli) get(args []string) error {
+	flags := flag.NewFlagSet("get", flag.ContinueOnError)
+	fields := flags.String("fields", "", "comma separated columns to return")
+	args, err := parseCommand(flags, args, 2, "[flags] <table-id> <record-id>")
+	if err != nil {
+		return err
+	}
+
+	ids, err := parseIDs(args[1:2])
+	if err != nil {
+		return err
+	}
+
+	query := c.client.Table(args[0]).ReadRecord(ids[0])
+	if fields := splitList(*fields); len(fields) > 0 {
+		query = query.ReturnFields(fields...)
+	}
+	response, err := query.Execute()
+	if err != nil {
+		return err
+	}
+
+	return c.writeJSON(response.Data)
+}
+
+// create creates records and prints their IDs.
+func (c *cli) create(args []string) error {
+	flags := flag.NewFlagSet("create", flag.ContinueOnError)
+	args, err := parseCommand(flags, args, 2, "<table-id> <json|->")
+	if err != nil {
+		return err
+	}
+
+	records, isList, err := c.readRecords(args[1])
+	if err != nil {
+		return err
+	}
+
+	ids, err := c.client.Table(args[0]).CreateRecords(records).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !isList && len(ids) == 1 {
+		return c.writeJSON(ids[0])
+	}
+	return c.writeJSON(ids)
+}
+
+// update updates records identified by their "Id" field.
+func (c *cli) update(args []string) error {
+	flags := flag.NewFlagSet("update", flag.ContinueOnError)
+	args, err := parseCommand(flags, args, 2, "<table-id> <json|->")
+	if err != nil {
+		return err
+	}
+
+	records, _, err := c.readRecords(args[1])
+	if err != nil {
+		return err
+	}
+
+	return c.client.Table(args[0]).UpdateRecords(records).Execute()
+}
+
+// delete deletes records by their ID.
+func (c *cli) delete(args []string) error {
+	flags := flag.NewFlagSet("delete", flag.ContinueOnError)
+	args, err := parseCommand(flags, args, 2, "<table-id> <record-id>...")
+	if err != nil {
+		return err
+	}
+
+	ids, err := parseIDs(args[1:])
+	if err != nil {
+		return err
+	}
+
+	return c.client.Table(args[0]).DeleteRecords(ids).Execute()
+}
+
+// importCSV imports the records of a CSV file and prints their IDs.
+func (c *cli) importCSV(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	chunkSize := flags.Int("chunk", 0, "number of records created per request")
+	validate := flags.Bool("validate", false, "check the CSV columns against the table schema before importing")
+	args, err := parseCommand(flags, args, 2, "[flags] <table-id> <file.csv|->")
+	if err != nil {
+		return err
+	}
+
+	input := c.stdin
+	if args[1] != "-" {
+		file, err := os.Open(args[1])
+		if err != nil {
+			return fmt.Errorf("failed to open CSV file: %w", err)
+		}
+		defer file.Close()
+		input = file
+	}
+
+	query := c.client.Table(args[0]).ImportCSV(input)
+	if *chunkSize > 0 {
+		query = query.WithChunkSize(*chunkSize)
+	}
+	if *validate {
+		query = query.WithSchemaValidation()
+	}
+	ids, err := query.Execute()
+	if err != nil {
+		return err
+	}
+
+	return c.writeJSON(ids)
+}
+
+// export writes all the matching records of a table as JSON Lines.
+func (c *cli) export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	var q queryFlags
+	q.register(flags)
+	args, err := parseCommand(flags, args, 1, "[flags] <table-id>")
+	if err != nil {
+		return err
+	}
+
+	query, err := applyQueryFlags(c.client.Table(args[0]).ListRecords(), &q)
+	if err != nil {
+		return err
+	}
+
+	return query.ExportJSONL(c.stdout)
+}
@@ -0,0 +1,61 @@
+// Command nocodb is a command line tool to work with the records of NocoDB tables, built on top of
+// the nocodbgo SDK.
+//
+// Usage:
+//
+//	nocodb [global flags] <command> [flags] <table-id> [arguments]
+//
+// The commands are:
+//
+//	list    list the records of a table
+//	get     read a record by its ID
+//	create  create records from a JSON object or list of objects
+//	update  update records from a JSON object or list of objects with their "Id"
+//	delete  delete records by their ID
+//	import  import records from a CSV file
+//	export  export records as JSON Lines
+//
+// The URL and API token of the NocoDB server are read from the -url and -token global flags, or
+// from the NOCODB_URL and NOCODB_TOKEN environment variables.
+//
+// Example:
+//
+//	export NOCODB_URL=https://app.nocodb.com
+//	export NOCODB_TOKEN=your-api-token
+//	nocodb list -where "(Age,gt,18)" -sort -Age -limit 10 m_xxxxxxxxxxxxxx
+//	echo '{"Name":"Ana"}' | nocodb create m_xxxxxxxxxxxxxx -
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Getenv); err != nil {
+		fmt.Fprintf(os.Stderr, "nocodb: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command line with the given arguments, input, output and environment.
+func run(args []string, stdin io.Reader, stdout io.Writer, getenv func(string) string) error {
+	cli, args, err := parseGlobalFlags(args, getenv)
+	if err != nil {
+		return err
+	}
+	cli.stdin = stdin
+	cli.stdout = stdout
+
+	if len(args) == 0 {
+		return fmt.Errorf("missing command, expected one of: %s", commandNames())
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q, expected one of: %s", args[0], commandNames())
+	}
+
+	return command(cli, args[1:])
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo"
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestCommands(t *testing.T) {
+	server := nocodbgotest.NewServer()
+	defer server.Close()
+
+	env := map[string]string{"NOCODB_URL": server.BaseURL(), "NOCODB_TOKEN": "test-token"}
+	nocodb := func(stdin string, args ...string) string {
+		t.Helper()
+		var stdout bytes.Buffer
+		if err := run(args, strings.NewReader(stdin), &stdout, func(key string) string { return env[key] }); err != nil {
+			t.Fatalf("nocodb %s: %v", strings.Join(args, " "), err)
+		}
+		return strings.TrimSpace(stdout.String())
+	}
+
+	if got := nocodb("", "create", "users", `{"Name":"Ana","Age":30}`); got != "1" {
+		t.Errorf("create = %s, want 1", got)
+	}
+	if got := nocodb(`[{"Name":"Bob","Age":17},{"Name":"Cid","Age":40}]`, "create", "users", "-"); got != "[\n  2,\n  3\n]" {
+		t.Errorf("create list = %s, want [2 3]", got)
+	}
+
+	var list nocodbgo.ListResponse
+	if err := json.Unmarshal([]byte(nocodb("", "list", "-where", "(Age,gt,18)", "-sort", "-Age", "-fields", "Name", "users")), &list); err != nil {
+		t.Fatalf("list output: %v", err)
+	}
+	if len(list.List) != 2 || list.List[0]["Name"] != "Cid" || list.List[1]["Name"] != "Ana" {
+		t.Errorf("list = %+v, want Cid and Ana", list.List)
+	}
+
+	nocodb("", "update", "users", `{"Id":2,"Age":18}`)
+	var record map[string]any
+	if err := json.Unmarshal([]byte(nocodb("", "get", "users", "2")), &record); err != nil || record["Age"] != float64(18) {
+		t.Errorf("get = %v, %v, want Age 18", record, err)
+	}
+
+	nocodb("", "delete", "users", "1", "3")
+	if got := nocodb("", "export", "-eq", "Name=Bob", "users"); !strings.Contains(got, `"Name":"Bob"`) || strings.Count(got, "\n") != 0 {
+		t.Errorf("export = %s, want only Bob", got)
+	}
+
+	csvPath := filepath.Join(t.TempDir(), "users.csv")
+	if err := os.WriteFile(csvPath, []byte("Name,Age\nDan,22\nEve,35\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	nocodb("", "import", "-chunk", "1", "users", csvPath)
+	if records := server.Records("users"); len(records) != 3 {
+		t.Errorf("records after import = %v, want 3", records)
+	}
+
+	var stdout bytes.Buffer
+	if err := run([]string{"drop", "users"}, nil, &stdout, func(key string) string { return env[key] }); err == nil {
+		t.Error("unknown command error = nil")
+	}
+}
@@ -0,0 +1,130 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Collaborator is a NocoDB user as returned in User fields and in the CreatedBy and UpdatedBy
+// system fields
+type Collaborator struct {
+	// ID is the unique identifier of the user
+	ID string `json:"id,omitempty"`
+	// Email is the email address of the user
+	Email string `json:"email,omitempty"`
+	// DisplayName is the display name of the user, empty if the user didn't set one
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Collaborator.
+//
+// It decodes a user object, the first user of a list (single User fields are returned as a list
+// by some NocoDB versions) or a plain email address. A null value or an empty list decode into
+// the zero Collaborator.
+func (c *Collaborator) UnmarshalJSON(data []byte) error {
+	collaborators, err := decodeCollaborators(data)
+	if err != nil {
+		return err
+	}
+
+	*c = Collaborator{}
+	if len(collaborators) > 0 {
+		*c = collaborators[0]
+	}
+	return nil
+}
+
+// Collaborators is the list of users of a User field that allows multiple users.
+//
+// Example:
+//
+//	type Task struct {
+//		ID        int                    `json:"Id"`
+//		Owner     nocodbgo.Collaborator  `json:"Owner"`
+//		Reviewers nocodbgo.Collaborators `json:"Reviewers"`
+//	}
+type Collaborators []Collaborator
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Collaborators.
+//
+// It decodes a list of user objects, a single user object or a comma separated list of email
+// addresses. A null value decodes into an empty list.
+func (c *Collaborators) UnmarshalJSON(data []byte) error {
+	collaborators, err := decodeCollaborators(data)
+	if err != nil {
+		return err
+	}
+
+	*c = collaborators
+	return nil
+}
+
+// Emails returns the email addresses of the users.
+func (c Collaborators) Emails() []string {
+	emails := make([]string, 0, len(c))
+	for _, collaborator := range c {
+		emails = append(emails, collaborator.Email)
+	}
+	return emails
+}
+
+// collaboratorJSON is the user object as returned by NocoDB, where the display name can be null
+type collaboratorJSON struct {
+	ID          string  `json:"id"`
+	Email       string  `json:"email"`
+	DisplayName *string `json:"display_name"`
+}
+
+// decodeCollaborators decodes the value of a User field in any of the shapes NocoDB returns it.
+func decodeCollaborators(data []byte) ([]Collaborator, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return []Collaborator{}, nil
+	}
+
+	switch data[0] {
+	case '[':
+		var raw []collaboratorJSON
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal collaborators: %w", err)
+		}
+		collaborators := make([]Collaborator, 0, len(raw))
+		for _, r := range raw {
+			collaborators = append(collaborators, r.collaborator())
+		}
+		return collaborators, nil
+
+	case '{':
+		var raw collaboratorJSON
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal collaborator: %w", err)
+		}
+		return []Collaborator{raw.collaborator()}, nil
+
+	case '"':
+		var emails string
+		if err := json.Unmarshal(data, &emails); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal collaborators: %w", err)
+		}
+		collaborators := []Collaborator{}
+		for _, email := range strings.Split(emails, ",") {
+			if email = strings.TrimSpace(email); email != "" {
+				collaborators = append(collaborators, Collaborator{Email: email})
+			}
+		}
+		return collaborators, nil
+	}
+
+	return nil, fmt.Errorf("failed to unmarshal collaborators: unexpected value %s", data)
+}
+
+// collaborator converts the raw user object into a Collaborator.
+func (r collaboratorJSON) collaborator() Collaborator {
+	return Collaborator{
+		ID:          r.ID,
+		Email:       r.Email,
+		DisplayName: stringOrEmpty(r.DisplayName),
+	}
+}
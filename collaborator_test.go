@@ -0,0 +1,72 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestCollaboratorsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Collaborators
+	}{
+		{
+			name: "list of users",
+			data: `[{"id":"us_1","email":"john@example.com","display_name":"John"},{"id":"us_2","email":"jane@example.com","display_name":null}]`,
+			want: Collaborators{{ID: "us_1", Email: "john@example.com", DisplayName: "John"}, {ID: "us_2", Email: "jane@example.com"}},
+		},
+		{
+			name: "single user",
+			data: `{"id":"us_1","email":"john@example.com"}`,
+			want: Collaborators{{ID: "us_1", Email: "john@example.com"}},
+		},
+		{
+			name: "comma separated emails",
+			data: `"john@example.com, jane@example.com"`,
+			want: Collaborators{{Email: "john@example.com"}, {Email: "jane@example.com"}},
+		},
+		{
+			name: "null",
+			data: `null`,
+			want: Collaborators{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Collaborators
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollaboratorDecode(t *testing.T) {
+	type Task struct {
+		Owner     Collaborator  `json:"Owner"`
+		Reviewers Collaborators `json:"Reviewers"`
+	}
+
+	data := map[string]any{
+		"Owner":     []any{map[string]any{"id": "us_1", "email": "john@example.com", "display_name": "John"}},
+		"Reviewers": []any{map[string]any{"id": "us_2", "email": "jane@example.com"}},
+	}
+
+	var task Task
+	if err := decodeInto(data, &task); err != nil {
+		t.Fatalf("decodeInto() error = %v", err)
+	}
+	if task.Owner.DisplayName != "John" || !slices.Equal(task.Reviewers.Emails(), []string{"jane@example.com"}) {
+		t.Errorf("decodeInto() = %+v", task)
+	}
+
+	if err := decodeInto(map[string]any{"Owner": 42}, &task); err == nil {
+		t.Error("decodeInto() with invalid user error = nil, want error")
+	}
+}
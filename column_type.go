@@ -0,0 +1,107 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ColumnType is the data type of a column in the user interface of NocoDB, known as "uidt" in the
+// meta API
+type ColumnType string
+
+// Column types supported by NocoDB
+const (
+	ColumnTypeID                  ColumnType = "ID"
+	ColumnTypeSingleLineText      ColumnType = "SingleLineText"
+	ColumnTypeLongText            ColumnType = "LongText"
+	ColumnTypeAttachment          ColumnType = "Attachment"
+	ColumnTypeCheckbox            ColumnType = "Checkbox"
+	ColumnTypeMultiSelect         ColumnType = "MultiSelect"
+	ColumnTypeSingleSelect        ColumnType = "SingleSelect"
+	ColumnTypeCollaborator        ColumnType = "Collaborator"
+	ColumnTypeUser                ColumnType = "User"
+	ColumnTypeDate                ColumnType = "Date"
+	ColumnTypeYear                ColumnType = "Year"
+	ColumnTypeTime                ColumnType = "Time"
+	ColumnTypeDateTime            ColumnType = "DateTime"
+	ColumnTypeDuration            ColumnType = "Duration"
+	ColumnTypePhoneNumber         ColumnType = "PhoneNumber"
+	ColumnTypeEmail               ColumnType = "Email"
+	ColumnTypeURL                 ColumnType = "URL"
+	ColumnTypeNumber              ColumnType = "Number"
+	ColumnTypeDecimal             ColumnType = "Decimal"
+	ColumnTypeCurrency            ColumnType = "Currency"
+	ColumnTypePercent             ColumnType = "Percent"
+	ColumnTypeRating              ColumnType = "Rating"
+	ColumnTypeGeoData             ColumnType = "GeoData"
+	ColumnTypeGeometry            ColumnType = "Geometry"
+	ColumnTypeJSON                ColumnType = "JSON"
+	ColumnTypeSpecificDBType      ColumnType = "SpecificDBType"
+	ColumnTypeForeignKey          ColumnType = "ForeignKey"
+	ColumnTypeLinks               ColumnType = "Links"
+	ColumnTypeLinkToAnotherRecord ColumnType = "LinkToAnotherRecord"
+	ColumnTypeLookup              ColumnType = "Lookup"
+	ColumnTypeRollup              ColumnType = "Rollup"
+	ColumnTypeCount               ColumnType = "Count"
+	ColumnTypeFormula             ColumnType = "Formula"
+	ColumnTypeButton              ColumnType = "Button"
+	ColumnTypeBarcode             ColumnType = "Barcode"
+	ColumnTypeQrCode              ColumnType = "QrCode"
+	ColumnTypeAutoNumber          ColumnType = "AutoNumber"
+	ColumnTypeCreatedTime         ColumnType = "CreatedTime"
+	ColumnTypeLastModifiedTime    ColumnType = "LastModifiedTime"
+	ColumnTypeCreatedBy           ColumnType = "CreatedBy"
+	ColumnTypeLastModifiedBy      ColumnType = "LastModifiedBy"
+	ColumnTypeOrder               ColumnType = "Order"
+)
+
+// columnTypes are the valid column types
+var columnTypes = []ColumnType{
+	ColumnTypeID, ColumnTypeSingleLineText, ColumnTypeLongText, ColumnTypeAttachment, ColumnTypeCheckbox,
+	ColumnTypeMultiSelect, ColumnTypeSingleSelect, ColumnTypeCollaborator, ColumnTypeUser, ColumnTypeDate,
+	ColumnTypeYear, ColumnTypeTime, ColumnTypeDateTime, ColumnTypeDuration, ColumnTypePhoneNumber,
+	ColumnTypeEmail, ColumnTypeURL, ColumnTypeNumber, ColumnTypeDecimal, ColumnTypeCurrency,
+	ColumnTypePercent, ColumnTypeRating, ColumnTypeGeoData, ColumnTypeGeometry, ColumnTypeJSON,
+	ColumnTypeSpecificDBType, ColumnTypeForeignKey, ColumnTypeLinks, ColumnTypeLinkToAnotherRecord,
+	ColumnTypeLookup, ColumnTypeRollup, ColumnTypeCount, ColumnTypeFormula, ColumnTypeButton,
+	ColumnTypeBarcode, ColumnTypeQrCode, ColumnTypeAutoNumber, ColumnTypeCreatedTime,
+	ColumnTypeLastModifiedTime, ColumnTypeCreatedBy, ColumnTypeLastModifiedBy, ColumnTypeOrder,
+}
+
+// readOnlyColumnTypes are the column types whose values are computed by NocoDB and can't be written
+var readOnlyColumnTypes = []ColumnType{
+	ColumnTypeID, ColumnTypeAutoNumber, ColumnTypeFormula, ColumnTypeLookup, ColumnTypeRollup,
+	ColumnTypeLinks, ColumnTypeLinkToAnotherRecord, ColumnTypeCount, ColumnTypeButton,
+	ColumnTypeBarcode, ColumnTypeQrCode, ColumnTypeCreatedTime, ColumnTypeLastModifiedTime,
+	ColumnTypeCreatedBy, ColumnTypeLastModifiedBy,
+}
+
+// ParseColumnType returns the column type with the given name, or an error wrapping
+// ErrUnsupportedColumnType if NocoDB has no such column type.
+//
+// Example:
+//
+//	columnType, err := nocodbgo.ParseColumnType("SingleLineText")
+func ParseColumnType(name string) (ColumnType, error) {
+	columnType := ColumnType(name)
+	if !columnType.IsValid() {
+		return "", fmt.Errorf("%w: unknown column type %q", ErrUnsupportedColumnType, name)
+	}
+	return columnType, nil
+}
+
+// IsValid reports if the column type is one of the column types of NocoDB.
+func (t ColumnType) IsValid() bool {
+	return slices.Contains(columnTypes, t)
+}
+
+// IsReadOnly reports if the values of columns of the type are computed by NocoDB and can't be
+// written, like formulas, lookups or the creation time.
+func (t ColumnType) IsReadOnly() bool {
+	return slices.Contains(readOnlyColumnTypes, t)
+}
+
+// IsLink reports if the column type links records to the records of another table.
+func (t ColumnType) IsLink() bool {
+	return t == ColumnTypeLinks || t == ColumnTypeLinkToAnotherRecord
+}
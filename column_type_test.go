@@ -0,0 +1,33 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestColumnType(t *testing.T) {
+	columnType, err := ParseColumnType("LongText")
+	if err != nil || columnType != ColumnTypeLongText {
+		t.Errorf("ParseColumnType() = %q, %v, want %q", columnType, err, ColumnTypeLongText)
+	}
+	if _, err := ParseColumnType("longtext"); !errors.Is(err, ErrUnsupportedColumnType) {
+		t.Errorf("ParseColumnType() error = %v, want %v", err, ErrUnsupportedColumnType)
+	}
+
+	if !ColumnTypeFormula.IsReadOnly() || ColumnTypeNumber.IsReadOnly() {
+		t.Errorf("IsReadOnly() is wrong for Formula or Number")
+	}
+	if !ColumnTypeLinkToAnotherRecord.IsLink() || ColumnTypeLookup.IsLink() {
+		t.Errorf("IsLink() is wrong for LinkToAnotherRecord or Lookup")
+	}
+
+	// Column types of newer NocoDB versions are kept, even if they are not known
+	var column Column
+	if err := json.Unmarshal([]byte(`{"id":"cl_1","uidt":"FutureType"}`), &column); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if column.UIDT != "FutureType" || column.UIDT.IsValid() {
+		t.Errorf("UIDT = %q, IsValid() = %v", column.UIDT, column.UIDT.IsValid())
+	}
+}
@@ -0,0 +1,369 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// unmarshalerType is the reflect.Type of the json.Unmarshaler interface
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// ratType is the reflect.Type of big.Rat, used for Currency, Decimal and Percent fields
+var ratType = reflect.TypeFor[big.Rat]()
+
+// structFieldsCache caches the fields of the struct types records are decoded into, by type
+var structFieldsCache sync.Map
+
+// normalizeValue adapts a value returned by NocoDB to the shape expected by the Go type it is
+// going to be decoded into, for the fields whose representation depends on the server version
+// or the database backend.
+//
+// Values whose type implements json.Unmarshaler are left untouched, as they handle their own
+// decoding.
+func normalizeValue(value any, t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// Optional values are normalized as the type they wrap
+	if t.Implements(optionalFieldType) {
+		return normalizeValue(value, reflect.Zero(t).Interface().(optionalField).optionalValueType())
+	}
+
+	if value == nil || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return value
+	}
+
+	// big.Rat only decodes from strings, so numbers are formatted back with all their decimals
+	if t == ratType {
+		switch number := value.(type) {
+		case float64:
+			return strconv.FormatFloat(number, 'f', -1, 64)
+		case json.Number:
+			return number.String()
+		}
+		return value
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		record, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+		info := structFields(t)
+		normalized := make(map[string]any, len(record))
+		for column, fieldValue := range record {
+			field, ok := info.byColumn[column]
+			if !ok {
+				// Columns named like a field mapped to another column are not decoded into it
+				if renamed, ok := info.byJSONName[column]; ok && renamed.column != column {
+					continue
+				}
+				normalized[column] = fieldValue
+				continue
+			}
+
+			if field.jsonEncoded {
+				fieldValue = decodeJSONField(fieldValue)
+			}
+			normalized[field.jsonName] = normalizeValue(fieldValue, field.typ)
+		}
+		return normalized
+
+	case reflect.Slice, reflect.Array:
+		switch v := value.(type) {
+		case string:
+			// MultiSelect fields are returned as a comma separated string by some server versions
+			if t.Elem().Kind() == reflect.String {
+				return splitMultiSelect(v)
+			}
+		case []any:
+			normalized := make([]any, len(v))
+			for i, item := range v {
+				normalized[i] = normalizeValue(item, t.Elem())
+			}
+			return normalized
+		case []map[string]any:
+			normalized := make([]any, len(v))
+			for i, item := range v {
+				normalized[i] = normalizeValue(item, t.Elem())
+			}
+			return normalized
+		}
+
+	case reflect.Bool:
+		// Checkbox fields are returned as booleans, 0/1 or strings depending on the database backend
+		if checked, ok := checkboxValue(value); ok {
+			return checked
+		}
+
+	case reflect.Map:
+		record, ok := value.(map[string]any)
+		if !ok || t.Key().Kind() != reflect.String {
+			return value
+		}
+		normalized := make(map[string]any, len(record))
+		for name, fieldValue := range record {
+			normalized[name] = normalizeValue(fieldValue, t.Elem())
+		}
+		return normalized
+	}
+
+	return value
+}
+
+// unmarshalRecords decodes a response with records like json.Unmarshal, but without rounding the
+// numbers that a float64 can't hold with all their digits, such as the exact values of Currency,
+// Decimal and Percent fields or integers above 2^53. Those are kept as json.Number and the rest
+// are float64, so the records can still be read as usual.
+func unmarshalRecords(body []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	return nil
+}
+
+// exactNumbers converts the json.Number values of a decoded record, in place, to float64 unless
+// they would lose digits, returning the converted value.
+func exactNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		return exactNumber(v)
+	case map[string]any:
+		for key, item := range v {
+			v[key] = exactNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = exactNumbers(item)
+		}
+	}
+	return value
+}
+
+// exactNumber returns a number as a float64 if it holds all its digits, or else as is.
+func exactNumber(number json.Number) any {
+	f, err := number.Float64()
+	if err != nil {
+		return number
+	}
+
+	// A float64 holds any decimal number of up to 15 significant digits
+	digits := 0
+	for _, r := range strings.TrimLeft(strings.TrimLeft(number.String(), "-"), "0.") {
+		if r == 'e' || r == 'E' {
+			break
+		}
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if digits <= 15 {
+		return f
+	}
+
+	exact, ok := new(big.Rat).SetString(number.String())
+	if !ok {
+		return number
+	}
+	rounded, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if exact.Cmp(rounded) == 0 {
+		return f
+	}
+	return number
+}
+
+// decodeJSONField decodes the value of a field stored as a JSON string. Values that are not strings
+// are returned as is, as JSON fields are already decoded by some server versions.
+func decodeJSONField(value any) any {
+	encoded, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if strings.TrimSpace(encoded) == "" {
+		return nil
+	}
+
+	var decoded any
+	if err := json.Unmarshal([]byte(encoded), &decoded); err != nil {
+		// Left as is so the error is reported when decoding into the field
+		return value
+	}
+	return decoded
+}
+
+// toColumnNames converts a record built from a struct of the given type, keyed by the JSON names
+// of its fields, into a record keyed by the NocoDB column names of the fields. The fields with
+// the `nocodb:",json"` tag option are encoded into JSON strings.
+func toColumnNames(record map[string]any, t reflect.Type) (map[string]any, error) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return record, nil
+	}
+
+	fields := structFields(t).byJSONName
+	converted := make(map[string]any, len(record))
+	for name, value := range record {
+		field, ok := fields[name]
+		if !ok {
+			converted[name] = value
+			continue
+		}
+
+		if field.jsonEncoded && value != nil {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode field %q as JSON: %w", name, err)
+			}
+			value = string(encoded)
+		}
+		converted[field.column] = value
+	}
+
+	return converted, nil
+}
+
+// checkboxValue converts the value of a Checkbox field into a bool. It reports false if the value
+// is not a valid checkbox value.
+func checkboxValue(value any) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case float64:
+		return v != 0, true
+	case json.Number:
+		number, err := v.Float64()
+		return number != 0, err == nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "1":
+			return true, true
+		case "false", "0", "":
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// splitMultiSelect splits the comma separated options of a MultiSelect field.
+func splitMultiSelect(value string) []string {
+	options := []string{}
+	for _, option := range strings.Split(value, ",") {
+		if option = strings.TrimSpace(option); option != "" {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// structField describes a field of a struct records are decoded into or encoded from
+type structField struct {
+	// jsonName is the name of the field in its JSON representation
+	jsonName string
+	// column is the name of the NocoDB column of the field, set with the `nocodb:"Column"` tag and
+	// defaulting to the JSON name
+	column string
+	// index is the index sequence of the field for reflect.Value.FieldByIndexErr
+	index []int
+	// typ is the type of the field
+	typ reflect.Type
+	// jsonEncoded indicates the field is stored as a JSON string, set with the `nocodb:",json"` tag
+	jsonEncoded bool
+}
+
+// structInfo describes the fields of a struct records are decoded into or encoded from
+type structInfo struct {
+	// byColumn contains the fields by the name of their NocoDB column
+	byColumn map[string]structField
+	// byJSONName contains the fields by their JSON name
+	byJSONName map[string]structField
+}
+
+// structFields returns the fields of a struct, including the fields promoted from embedded
+// structs.
+//
+// The fields are mapped to NocoDB columns with the `nocodb:"Column"` tag, falling back to the
+// name in the json tag. The tag accepts options after the column name, like `nocodb:"Column,json"`
+// or `nocodb:",json"`.
+func structFields(t reflect.Type) structInfo {
+	if cached, ok := structFieldsCache.Load(t); ok {
+		return cached.(structInfo)
+	}
+
+	info := structInfo{
+		byColumn:   map[string]structField{},
+		byJSONName: map[string]structField{},
+	}
+	add := func(field structField) {
+		// Fields of the outer struct take precedence over the promoted ones
+		if _, ok := info.byJSONName[field.jsonName]; ok {
+			return
+		}
+		if _, ok := info.byColumn[field.column]; ok {
+			return
+		}
+		info.byColumn[field.column] = field
+		info.byJSONName[field.jsonName] = field
+	}
+
+	var promoted []structField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for _, embeddedField := range structFields(fieldType).byJSONName {
+				embeddedField.index = append([]int{i}, embeddedField.index...)
+				promoted = append(promoted, embeddedField)
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		column, options, _ := strings.Cut(field.Tag.Get("nocodb"), ",")
+		if column == "" {
+			column = name
+		}
+
+		add(structField{
+			jsonName:    name,
+			column:      column,
+			index:       []int{i},
+			typ:         field.Type,
+			jsonEncoded: slices.Contains(strings.Split(options, ","), "json"),
+		})
+	}
+
+	for _, field := range promoted {
+		add(field)
+	}
+
+	structFieldsCache.Store(t, info)
+	return info
+}
@@ -0,0 +1,101 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stringDisplayKeys are the keys of the objects returned for attachment, user and button fields
+// that hold their display value, in order of preference
+var stringDisplayKeys = []string{"title", "display_name", "email", "label", "url"}
+
+// DecodeStringMap converts the read response data into a map of strings, formatting the values
+// for display. It's convenient to fill templates and write CSV files.
+//
+// The values are formatted as follows:
+//   - Null values are empty strings.
+//   - Numbers are written without exponent (e.g. 1500000 instead of 1.5e+06).
+//   - Timestamps are formatted as RFC 3339, and dates are left as YYYY-MM-DD.
+//   - The options of MultiSelect fields are joined with commas.
+//   - Attachments and users are written as their title or name, and linked records and other
+//     objects as JSON.
+//
+// Example:
+//
+//	record, err := table.ReadRecord(recordID).Execute()
+//	// Handle error
+//	err = tmpl.Execute(w, record.DecodeStringMap())
+func (r ReadResponse) DecodeStringMap() map[string]string {
+	return stringMap(r.Data)
+}
+
+// DecodeStringMaps converts the list response data into a slice of maps of strings, formatting
+// the values like ReadResponse.DecodeStringMap.
+//
+// Example:
+//
+//	result, err := table.ListRecords().Execute()
+//	// Handle error
+//	for _, row := range result.DecodeStringMaps() {
+//		err = writer.Write([]string{row["Name"], row["Email"], row["CreatedAt"]})
+//	}
+func (r ListResponse) DecodeStringMaps() []map[string]string {
+	rows := make([]map[string]string, len(r.List))
+	for i, record := range r.List {
+		rows[i] = stringMap(record)
+	}
+	return rows
+}
+
+// stringMap formats all the values of a record as strings.
+func stringMap(record map[string]any) map[string]string {
+	row := make(map[string]string, len(record))
+	for field, value := range record {
+		row[field] = formatString(value)
+	}
+	return row
+}
+
+// formatString formats a value returned by NocoDB as a string for display.
+func formatString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		// Dates have no time, so only the timestamps are parsed and formatted
+		if len(v) > len(dateLayout) {
+			if parsed, err := parseTimestamp(v); err == nil {
+				return parsed.Format(time.RFC3339)
+			}
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			if formatted := formatString(item); formatted != "" {
+				items = append(items, formatted)
+			}
+		}
+		return strings.Join(items, ",")
+	case map[string]any:
+		for _, key := range stringDisplayKeys {
+			if display, ok := v[key].(string); ok && display != "" {
+				return display
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
@@ -0,0 +1,51 @@
+package nocodbgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeStringMaps(t *testing.T) {
+	response := ListResponse{List: []map[string]any{{
+		"Id":          float64(1),
+		"Name":        "Ana",
+		"Salary":      float64(1500000),
+		"Rating":      4.5,
+		"Active":      true,
+		"Notes":       nil,
+		"Birthday":    "1990-05-17",
+		"CreatedAt":   "2024-01-02 03:04:05+00:00",
+		"Tags":        []any{"vip", "newsletter"},
+		"Avatar":      []any{map[string]any{"title": "ana.png", "url": "https://example.com/ana.png"}},
+		"Owner":       map[string]any{"id": "us_1", "email": "ana@example.com", "display_name": "Ana"},
+		"Address":     map[string]any{"City": "Lima"},
+		"Location":    "-12.04,-77.03",
+		"Description": "2024 was a good year",
+	}}}
+
+	want := []map[string]string{{
+		"Id":          "1",
+		"Name":        "Ana",
+		"Salary":      "1500000",
+		"Rating":      "4.5",
+		"Active":      "true",
+		"Notes":       "",
+		"Birthday":    "1990-05-17",
+		"CreatedAt":   "2024-01-02T03:04:05Z",
+		"Tags":        "vip,newsletter",
+		"Avatar":      "ana.png",
+		"Owner":       "Ana",
+		"Address":     `{"City":"Lima"}`,
+		"Location":    "-12.04,-77.03",
+		"Description": "2024 was a good year",
+	}}
+
+	if got := response.DecodeStringMaps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeStringMaps() =\n%v\nwant\n%v", got, want)
+	}
+
+	read := ReadResponse{Data: response.List[0]}
+	if got := read.DecodeStringMap(); !reflect.DeepEqual(got, want[0]) {
+		t.Errorf("DecodeStringMap() =\n%v\nwant\n%v", got, want[0])
+	}
+}
@@ -1,6 +1,9 @@
 package nocodbgo
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrHTTPClientRequired is returned when attempting to create a client without providing an HTTP client
@@ -17,4 +20,176 @@ var (
 
 	// ErrLinkFieldIDRequired is returned when attempting to perform an operation that requires a link field ID without providing one
 	ErrLinkFieldIDRequired = errors.New("link field ID is required")
+
+	// ErrInvalidRecordID is returned when a value cannot be used as a record ID
+	ErrInvalidRecordID = errors.New("invalid record ID")
+
+	// ErrColumnNotFound is returned when a column referenced by an operation does not exist in the table schema
+	ErrColumnNotFound = errors.New("column not found")
+
+	// ErrColumnReadOnly is returned when attempting to write a value into a column that cannot be written
+	ErrColumnReadOnly = errors.New("column is read-only")
+
+	// ErrRecordNotFound is returned when a record referenced by an operation does not exist in the table
+	ErrRecordNotFound = errors.New("record not found")
+
+	// ErrSharedViewUUIDRequired is returned when attempting to query a shared view without providing its UUID
+	ErrSharedViewUUIDRequired = errors.New("shared view UUID is required")
+
+	// ErrColumnIDRequired is returned when attempting to perform an operation that requires a column ID without providing one
+	ErrColumnIDRequired = errors.New("column ID is required")
+
+	// ErrFilterIDRequired is returned when attempting to perform an operation that requires a filter ID without providing one
+	ErrFilterIDRequired = errors.New("filter ID is required")
+
+	// ErrSortIDRequired is returned when attempting to perform an operation that requires a sort ID without providing one
+	ErrSortIDRequired = errors.New("sort ID is required")
+
+	// ErrSelectOptionNotFound is returned when an option referenced by an operation does not exist in a select column
+	ErrSelectOptionNotFound = errors.New("select option not found")
+
+	// ErrUnsupportedColumnType is returned when an operation is not supported by the type of the column
+	ErrUnsupportedColumnType = errors.New("unsupported column type")
+
+	// ErrBaseIDRequired is returned when attempting to perform an operation that requires a base ID without providing one
+	ErrBaseIDRequired = errors.New("base ID is required")
+
+	// ErrWorkspaceIDRequired is returned when attempting to perform a workspace operation without providing a workspace ID
+	ErrWorkspaceIDRequired = errors.New("workspace ID is required")
+
+	// ErrInvalidQuery is returned when a filter, sort or pagination option of a query is not valid
+	ErrInvalidQuery = errors.New("invalid query")
+
+	// ErrQueryNameRequired is returned when attempting to register a query without providing a name
+	ErrQueryNameRequired = errors.New("query name is required")
+
+	// ErrQueryNotFound is returned when a saved query referenced by an operation has not been registered
+	ErrQueryNotFound = errors.New("saved query not found")
+
+	// ErrUnsupportedAPIVersion is returned when the client is configured with an unknown data API version
+	ErrUnsupportedAPIVersion = errors.New("unsupported API version")
+
+	// ErrOperationDenied is returned when the operation policy of the client forbids a request
+	ErrOperationDenied = errors.New("operation denied by policy")
+
+	// ErrConflict is returned when a record was modified by someone else since the time the operation expected
+	ErrConflict = errors.New("record was modified concurrently")
+
+	// ErrTooManyRecords is returned when an operation would affect more records than the maximum allowed
+	ErrTooManyRecords = errors.New("operation affects too many records")
+
+	// ErrTrashNotSupported is returned when the NocoDB server doesn't keep deleted records in a trash
+	ErrTrashNotSupported = errors.New("record trash is not supported by the server")
+
+	// ErrUserNotFound is returned when a user referenced by an operation is not a user of the base
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrUserIDRequired is returned when attempting to perform an operation that requires a user ID without providing one
+	ErrUserIDRequired = errors.New("user ID is required")
+
+	// ErrInvalidRole is returned when a role is not known or can't be used by an operation
+	ErrInvalidRole = errors.New("invalid role")
+
+	// ErrAttachmentNotFound is returned when an attachment is no longer in the cell it was read from
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	// ErrAttachmentNotBound is returned when downloading an attachment that wasn't read with Table.ReadAttachments
+	ErrAttachmentNotBound = errors.New("attachment is not bound to a record")
+
+	// ErrUploadRejected is returned when an upload interceptor rejects a file before it's uploaded
+	ErrUploadRejected = errors.New("upload rejected")
 )
+
+// OperationError is returned when a request to the NocoDB API fails, with the details of the
+// operation and the request that failed so failures can be grouped by operation.
+//
+// It wraps the underlying error, so errors.Is keeps working with the sentinel errors, and can be
+// retrieved with errors.As.
+//
+// Example:
+//
+//	var opErr *nocodbgo.OperationError
+//	if errors.As(err, &opErr) {
+//		logger.Error("nocodb request failed", "operation", opErr.Operation, "table", opErr.TableID, "status", opErr.StatusCode)
+//	}
+type OperationError struct {
+	// Operation is the name of the method that started the operation (e.g. "ListRecords"), empty
+	// for the operations that are not on a table
+	Operation string
+	// TableID is the identifier of the table of the operation, if any
+	TableID string
+	// RecordID is the identifier of the record of the operation, zero if it's not about a single record
+	RecordID RecordID
+	// Method is the HTTP method of the request that failed
+	Method string
+	// Path is the path of the request that failed, without the base URL and the query
+	Path string
+	// StatusCode is the HTTP status code of the response, 0 if no response was received
+	StatusCode int
+	// Err is the underlying error
+	Err error
+}
+
+// Error implements the error interface for OperationError
+func (e *OperationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// withOperation adds the operation, table and record of an operation to the OperationError wrapped
+// by err, if any, returning err unchanged otherwise.
+//
+// The returned error wraps err, so the message and the wrapped errors are kept. The OperationError
+// of err is copied instead of modified since errors can be shared, like those of batched reads.
+func withOperation(err error, operation string, tableID string, recordID RecordID) error {
+	var opErr *OperationError
+	if !errors.As(err, &opErr) {
+		return err
+	}
+
+	annotated := *opErr
+	annotated.Operation = operation
+	if tableID != "" {
+		annotated.TableID = tableID
+	}
+	if !recordID.IsZero() {
+		annotated.RecordID = recordID
+	}
+	annotated.Err = err
+	return &annotated
+}
+
+// DecodeError is returned when records can't be decoded into structs, with the record and the
+// field that failed so a malformed record can be found among many.
+//
+// Example:
+//
+//	var decodeErr *nocodbgo.DecodeError
+//	if errors.As(err, &decodeErr) {
+//		log.Printf("record %d has an invalid %s: %v", decodeErr.Index, decodeErr.Field, decodeErr.Err)
+//	}
+type DecodeError struct {
+	// Index is the index of the record in the list, 0 when decoding a single record
+	Index int
+	// Field is the column of the field that failed, empty if it couldn't be determined
+	Field string
+	// Err is the underlying error
+	Err error
+}
+
+// Error implements the error interface for DecodeError
+func (e *DecodeError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("record %d: %v", e.Index, e.Err)
+	}
+	return fmt.Sprintf("record %d, field %q: %v", e.Index, e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
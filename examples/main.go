@@ -48,7 +48,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error creating user: %v", err)
 	}
-	fmt.Printf("User created with ID: %d\n", userID)
+	fmt.Printf("User created with ID: %v\n", userID)
 
 	// Read a record
 	readResponse, err := table.ReadRecord(userID).
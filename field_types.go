@@ -0,0 +1,317 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout is the layout NocoDB uses for the values of Date fields
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date without time or time zone, matching the values of NocoDB Date fields.
+//
+// The zero Date encodes as null, so it clears the field on create and update.
+//
+// Example:
+//
+//	type Employee struct {
+//		ID        int           `json:"Id"`
+//		BirthDate nocodbgo.Date `json:"BirthDate"`
+//	}
+//
+//	employee := Employee{BirthDate: nocodbgo.NewDate(1990, time.May, 17)}
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the Date for the given year, month and day.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the Date of the given time in its location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a date in the "YYYY-MM-DD" format. Timestamps are also accepted, in which case
+// the date in the time zone of the timestamp is returned.
+func ParseDate(value string) (Date, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Date{}, nil
+	}
+
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return DateOf(t), nil
+	}
+
+	t, err := parseTimestamp(value)
+	if err != nil {
+		return Date{}, fmt.Errorf("failed to parse date %q", value)
+	}
+	return DateOf(t), nil
+}
+
+// IsZero reports whether the date is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Time returns the time at the start of the date in the given location.
+func (d Date) Time(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// String returns the date in the "YYYY-MM-DD" format.
+func (d Date) String() string {
+	return d.Time(time.UTC).Format(dateLayout)
+}
+
+// MarshalJSON implements the json.Marshaler interface for Date.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal date: %w", err)
+	}
+
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// Duration is the value of a NocoDB Duration field, which stores durations as a number of seconds.
+//
+// It can be converted to and from time.Duration.
+//
+// Example:
+//
+//	type Call struct {
+//		ID     int               `json:"Id"`
+//		Length nocodbgo.Duration `json:"Length"`
+//	}
+//
+//	call := Call{Length: nocodbgo.Duration(90 * time.Second)}
+type Duration time.Duration
+
+// String returns the duration formatted as a time.Duration.
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// MarshalJSON implements the json.Marshaler interface for Duration.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).Seconds())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Duration.
+// It accepts the number of seconds as a number or as a numeric string. Null decodes into zero.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = 0
+		return nil
+	}
+
+	raw := strings.Trim(string(data), `"`)
+	if raw == "" {
+		*d = 0
+		return nil
+	}
+
+	seconds, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return fmt.Errorf("failed to unmarshal duration %s", data)
+	}
+
+	*d = Duration(math.Round(seconds * float64(time.Second)))
+	return nil
+}
+
+// GeoPoint is a location stored in a NocoDB GeoData field, which stores the latitude and the
+// longitude as a "latitude;longitude" string.
+//
+// Use a *GeoPoint field for locations that can be empty.
+//
+// Example:
+//
+//	type Store struct {
+//		ID       int                `json:"Id"`
+//		Location *nocodbgo.GeoPoint `json:"Location"`
+//	}
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// ParseGeoPoint parses a location in the "latitude;longitude" format of GeoData fields.
+func ParseGeoPoint(value string) (GeoPoint, error) {
+	lat, lng, ok := strings.Cut(value, ";")
+	if !ok {
+		return GeoPoint{}, fmt.Errorf("failed to parse geo point %q", value)
+	}
+
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil || latitude < -90 || latitude > 90 {
+		return GeoPoint{}, fmt.Errorf("failed to parse geo point %q: invalid latitude", value)
+	}
+
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if err != nil || longitude < -180 || longitude > 180 {
+		return GeoPoint{}, fmt.Errorf("failed to parse geo point %q: invalid longitude", value)
+	}
+
+	return GeoPoint{Lat: latitude, Lng: longitude}, nil
+}
+
+// String returns the location in the "latitude;longitude" format of GeoData fields.
+func (p GeoPoint) String() string {
+	return strconv.FormatFloat(p.Lat, 'f', -1, 64) + ";" + strconv.FormatFloat(p.Lng, 'f', -1, 64)
+}
+
+// MarshalJSON implements the json.Marshaler interface for GeoPoint.
+func (p GeoPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for GeoPoint.
+func (p *GeoPoint) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal geo point: %w", err)
+	}
+
+	parsed, err := ParseGeoPoint(value)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+// maxDecimalPlaces is the maximum number of decimal places used to format a Decimal whose value
+// can't be represented exactly with a finite number of decimals
+const maxDecimalPlaces = 20
+
+// Decimal is an exact decimal number for Currency, Decimal and Percent fields, that avoids the
+// rounding errors of float64 when working with financial data.
+//
+// The zero Decimal is 0. Decimal values are immutable and safe to copy.
+//
+// Example:
+//
+//	type Invoice struct {
+//		ID    int              `json:"Id"`
+//		Total nocodbgo.Decimal `json:"Total"`
+//	}
+//
+//	fmt.Println(invoice.Total.StringFixed(2)) // "1234.50"
+type Decimal struct {
+	rat *big.Rat
+}
+
+// NewDecimal parses a decimal number such as "1234.5", "-0.25" or "1e3".
+func NewDecimal(value string) (Decimal, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return Decimal{}, fmt.Errorf("failed to parse decimal %q", value)
+	}
+	return Decimal{rat: rat}, nil
+}
+
+// DecimalFromRat returns a Decimal with the value of the given rational number.
+func DecimalFromRat(rat *big.Rat) Decimal {
+	if rat == nil {
+		return Decimal{}
+	}
+	return Decimal{rat: new(big.Rat).Set(rat)}
+}
+
+// Rat returns the value of the decimal as a new big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(d.rat)
+}
+
+// Float64 returns the nearest float64 value of the decimal.
+func (d Decimal) Float64() float64 {
+	value, _ := d.Rat().Float64()
+	return value
+}
+
+// StringFixed returns the decimal rounded to the given number of decimal places, with the
+// trailing zeros kept (e.g. "1234.50" with two places).
+func (d Decimal) StringFixed(places int) string {
+	return d.Rat().FloatString(max(places, 0))
+}
+
+// String returns the decimal with the decimal places needed to represent it exactly, or rounded
+// to 20 decimal places if it can't be represented exactly.
+func (d Decimal) String() string {
+	rat := d.Rat()
+	scaled := new(big.Rat).Set(rat)
+	ten := big.NewRat(10, 1)
+	for places := 0; places < maxDecimalPlaces; places++ {
+		if scaled.IsInt() {
+			return rat.FloatString(places)
+		}
+		scaled.Mul(scaled, ten)
+	}
+	return rat.FloatString(maxDecimalPlaces)
+}
+
+// MarshalJSON implements the json.Marshaler interface for Decimal.
+// The decimal is encoded as a JSON number with all its decimal places.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Decimal.
+// It accepts the value as a JSON number or as a string, as some databases return decimals as
+// strings to preserve their precision. Null decodes into zero.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	raw := strings.Trim(string(data), `"`)
+	if raw == "null" || raw == "" {
+		*d = Decimal{}
+		return nil
+	}
+
+	parsed, err := NewDecimal(raw)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
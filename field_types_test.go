@@ -0,0 +1,208 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDateJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want Date
+	}{
+		{data: `"2024-03-05"`, want: NewDate(2024, time.March, 5)},
+		{data: `"2024-03-05 00:00:00+00:00"`, want: NewDate(2024, time.March, 5)},
+		{data: `null`, want: Date{}},
+		{data: `""`, want: Date{}},
+	}
+
+	for _, tt := range tests {
+		var got Date
+		if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", tt.data, err)
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.data, got, tt.want)
+		}
+	}
+
+	var invalid Date
+	if err := json.Unmarshal([]byte(`"05/03/2024"`), &invalid); err == nil {
+		t.Error("Unmarshal() with invalid date error = nil, want error")
+	}
+
+	data, err := json.Marshal(map[string]Date{"Due": NewDate(2024, time.February, 30), "Empty": {}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"Due":"2024-03-01","Empty":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want time.Duration
+	}{
+		{data: `74040`, want: 20*time.Hour + 34*time.Minute},
+		{data: `"90.5"`, want: 90*time.Second + 500*time.Millisecond},
+		{data: `null`, want: 0},
+	}
+
+	for _, tt := range tests {
+		var got Duration
+		if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", tt.data, err)
+		}
+		if time.Duration(got) != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.data, got, tt.want)
+		}
+	}
+
+	var invalid Duration
+	if err := json.Unmarshal([]byte(`"1h"`), &invalid); err == nil {
+		t.Error("Unmarshal() with invalid duration error = nil, want error")
+	}
+
+	data, err := json.Marshal(Duration(90 * time.Second))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != "90" {
+		t.Errorf("Marshal() = %s, want 90", data)
+	}
+}
+
+func TestGeoPointJSON(t *testing.T) {
+	type Store struct {
+		Location *GeoPoint `json:"Location"`
+	}
+
+	var store Store
+	if err := json.Unmarshal([]byte(`{"Location":"52.520008;13.404954"}`), &store); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if store.Location == nil || *store.Location != (GeoPoint{Lat: 52.520008, Lng: 13.404954}) {
+		t.Errorf("Unmarshal() = %+v", store.Location)
+	}
+
+	data, err := json.Marshal(store)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"Location":"52.520008;13.404954"}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	store = Store{}
+	if err := json.Unmarshal([]byte(`{"Location":null}`), &store); err != nil || store.Location != nil {
+		t.Errorf("Unmarshal(null) = %+v, %v, want nil", store.Location, err)
+	}
+
+	for _, invalid := range []string{`"52.52"`, `"95;13.4"`, `"52.52;north"`} {
+		var point GeoPoint
+		if err := json.Unmarshal([]byte(invalid), &point); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want error", invalid)
+		}
+	}
+
+	b := &listRecordsBuilder{}
+	b.filterProvider = newFilterProvider(b)
+	b.WhereIsAtLocation("Location", GeoPoint{Lat: 52.52, Lng: -13.4}).WhereIsNotAtLocation("Location", GeoPoint{})
+	if want := []string{"(Location,eq,52.52;-13.4)", "(Location,neq,0;0)"}; !slices.Equal(b.filterProvider.rawFilters, want) {
+		t.Errorf("filters = %v, want %v", b.filterProvider.rawFilters, want)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	type Invoice struct {
+		Total    Decimal  `json:"Total"`
+		Tax      Decimal  `json:"Tax"`
+		Discount *big.Rat `json:"Discount"`
+		Rate     Decimal  `json:"Rate"`
+	}
+
+	data := map[string]any{
+		"Total":    float64(1234.5),
+		"Tax":      "0.10000000000000000001",
+		"Discount": float64(0.1),
+		"Rate":     nil,
+	}
+
+	var invoice Invoice
+	if err := decodeInto(data, &invoice); err != nil {
+		t.Fatalf("decodeInto() error = %v", err)
+	}
+	if got := invoice.Total.StringFixed(2); got != "1234.50" {
+		t.Errorf("Total.StringFixed(2) = %q, want 1234.50", got)
+	}
+	if got := invoice.Tax.String(); got != "0.10000000000000000001" {
+		t.Errorf("Tax.String() = %q, want 0.10000000000000000001", got)
+	}
+	if invoice.Discount == nil || invoice.Discount.Cmp(big.NewRat(1, 10)) != 0 {
+		t.Errorf("Discount = %v, want 1/10", invoice.Discount)
+	}
+	if got := invoice.Rate.String(); got != "0" {
+		t.Errorf("Rate.String() = %q, want 0", got)
+	}
+
+	sum := new(big.Rat).Add(invoice.Total.Rat(), invoice.Discount)
+	encoded, err := json.Marshal(map[string]Decimal{"Sum": DecimalFromRat(sum), "Third": DecimalFromRat(big.NewRat(1, 3))})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"Sum":1234.6,"Third":0.33333333333333333333}`; string(encoded) != want {
+		t.Errorf("Marshal() = %s, want %s", encoded, want)
+	}
+
+	if _, err := NewDecimal("12,5"); err == nil {
+		t.Error("NewDecimal() with invalid value error = nil, want error")
+	}
+}
+
+func TestDecodeListExactNumbers(t *testing.T) {
+	client, err := NewClient().WithBaseURL("http://localhost").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	body := []byte(`{"list":[{"Id":9007199254740993,"Qty":3,"Rate":0.1,"Price":12345678901234567.89,"Tags":[{"Weight":1.5}]}],"pageInfo":{"isLastPage":true}}`)
+	response, err := client.Table("orders").decodeList(body, nil)
+	if err != nil {
+		t.Fatalf("decodeList() error = %v", err)
+	}
+
+	// The numbers a float64 holds exactly keep their usual type
+	record := response.List[0]
+	if record["Qty"] != float64(3) || record["Rate"] != 0.1 || record["Tags"].([]any)[0].(map[string]any)["Weight"] != 1.5 {
+		t.Errorf("decodeList() = %v, want float64 values", record)
+	}
+	if record["Id"] != json.Number("9007199254740993") || record["Price"] != json.Number("12345678901234567.89") {
+		t.Errorf("decodeList() = %v, want the large numbers as json.Number", record)
+	}
+
+	var orders []struct {
+		ID    int64   `json:"Id"`
+		Price Decimal `json:"Price"`
+	}
+	if err := response.DecodeInto(&orders); err != nil {
+		t.Fatalf("DecodeInto() error = %v", err)
+	}
+	if orders[0].ID != 9007199254740993 || orders[0].Price.String() != "12345678901234567.89" {
+		t.Errorf("DecodeInto() = %+v, want the exact values", orders[0])
+	}
+
+	var prices []struct {
+		Price *big.Rat `json:"Price"`
+	}
+	if err := response.DecodeInto(&prices); err != nil {
+		t.Fatalf("DecodeInto() error = %v", err)
+	}
+	if want, _ := new(big.Rat).SetString("12345678901234567.89"); prices[0].Price.Cmp(want) != 0 {
+		t.Errorf("Price = %v, want %v", prices[0].Price, want)
+	}
+}
@@ -3,24 +3,116 @@ package nocodbgo
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"slices"
 )
 
 // decodeInto converts data from a map or slice of maps into the provided destination struct or slice of structs.
-// It uses JSON marshaling and unmarshaling internally to perform the conversion.
+// It uses JSON marshaling and unmarshaling internally to perform the conversion, after normalizing
+// the values whose representation varies between NocoDB versions (see normalizeValue).
 func decodeInto(data any, dest any) error {
+	if destType := reflect.TypeOf(dest); destType != nil {
+		data = normalizeValue(data, destType)
+	}
+
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("failed to marshal data: %w", err)
 	}
 
 	if err := json.Unmarshal(jsonData, dest); err != nil {
-		return fmt.Errorf("failed to unmarshal data: %w", err)
+		return fmt.Errorf("failed to unmarshal data: %w", locateDecodeError(data, reflect.TypeOf(dest), err))
 	}
 
 	return nil
 }
 
-// structToMap converts a struct into a map[string]any using the struct's JSON tags.
+// locateDecodeError finds the record and the field of the data that failed to decode into the
+// destination type, decoding them one by one, and returns a DecodeError with them. The error is
+// returned unchanged if the data is not a record or a list of records.
+func locateDecodeError(data any, destType reflect.Type, err error) error {
+	for destType != nil && destType.Kind() == reflect.Pointer {
+		destType = destType.Elem()
+	}
+	if destType == nil {
+		return err
+	}
+
+	switch v := data.(type) {
+	case map[string]any:
+		field, fieldErr := decodeRecordField(v, destType)
+		if fieldErr == nil {
+			fieldErr = err
+		}
+		return &DecodeError{Field: field, Err: fieldErr}
+	case []any, []map[string]any:
+		if destType.Kind() != reflect.Slice && destType.Kind() != reflect.Array {
+			return err
+		}
+		records := reflect.ValueOf(v)
+		for i := 0; i < records.Len(); i++ {
+			if field, fieldErr := decodeRecordField(records.Index(i).Interface(), destType.Elem()); fieldErr != nil {
+				return &DecodeError{Index: i, Field: field, Err: fieldErr}
+			}
+		}
+	}
+
+	return err
+}
+
+// decodeRecordField decodes a normalized record into a new value of the given type, returning
+// nil if it succeeds. Otherwise, it decodes the fields of the record one by one to return the
+// column of the first one that fails along with its error, in the order of the columns.
+func decodeRecordField(record any, t reflect.Type) (string, error) {
+	decode := func(value any) error {
+		jsonData, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(jsonData, reflect.New(t).Interface())
+	}
+
+	err := decode(record)
+	if err == nil {
+		return "", nil
+	}
+
+	fields, ok := record.(map[string]any)
+	if !ok {
+		return "", err
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if fieldErr := decode(map[string]any{name: fields[name]}); fieldErr != nil {
+			return fieldColumn(t, name), fieldErr
+		}
+	}
+
+	return "", err
+}
+
+// fieldColumn returns the NocoDB column of the field of a struct type with the given JSON name,
+// which is the name itself if the type is not a struct or has no such field.
+func fieldColumn(t reflect.Type, jsonName string) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return jsonName
+	}
+	if field, ok := structFields(t).byJSONName[jsonName]; ok {
+		return field.column
+	}
+	return jsonName
+}
+
+// structToMap converts a struct into a map[string]any using the struct's nocodb or JSON tags.
 // This is useful when you need to convert a strongly typed struct into a map for API operations.
 func structToMap(data any) (map[string]any, error) {
 	jsonData, err := json.Marshal(data)
@@ -32,11 +124,13 @@ func structToMap(data any) (map[string]any, error) {
 	if err := json.Unmarshal(jsonData, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal into map: %w", err)
 	}
+	dropEmptySystemFields(result)
+	dropUnsetOptionals(result, reflect.ValueOf(data))
 
-	return result, nil
+	return toColumnNames(result, reflect.TypeOf(data))
 }
 
-// structsToMaps converts a slice of structs into a slice of maps using JSON tags.
+// structsToMaps converts a slice of structs into a slice of maps using the nocodb or JSON tags.
 // This is useful when you need to convert a slice of strongly typed structs into a slice of maps for API operations.
 func structsToMaps(data any) ([]map[string]any, error) {
 	jsonData, err := json.Marshal(data)
@@ -49,5 +143,123 @@ func structsToMaps(data any) ([]map[string]any, error) {
 		return nil, fmt.Errorf("failed to unmarshal into maps: %w", err)
 	}
 
+	elemType := reflect.TypeOf(data)
+	if elemType != nil && (elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array) {
+		elemType = elemType.Elem()
+	}
+
+	values := reflect.ValueOf(data)
+	for i, record := range result {
+		dropEmptySystemFields(record)
+		if values.Kind() == reflect.Slice || values.Kind() == reflect.Array {
+			dropUnsetOptionals(record, values.Index(i))
+		}
+		if result[i], err = toColumnNames(record, elemType); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
+
+// toRecordIDs converts the provided value into a slice of record IDs.
+//
+// The value can be a single ID (any integer type or a string), a map or struct containing
+// an "Id" field, or a slice of any of those. Zero IDs (0 or "") are skipped.
+func toRecordIDs(value any) ([]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	isSlice := rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+	if !isSlice {
+		id, err := toRecordID(value)
+		if err != nil || id == nil {
+			return nil, err
+		}
+		return []any{id}, nil
+	}
+
+	ids := make([]any, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		id, err := toRecordID(rv.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		if id != nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// toRecordID converts a single value into a record ID, returning nil for zero IDs.
+func toRecordID(value any) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return nil, fmt.Errorf("%w: %v is not an integer", ErrInvalidRecordID, v)
+		}
+		return toRecordID(int64(v))
+	case json.Number:
+		id, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v is not an integer", ErrInvalidRecordID, v)
+		}
+		return toRecordID(id)
+	case RecordID:
+		return v.value(), nil
+	case map[string]any:
+		id, ok := v["Id"]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing Id field", ErrInvalidRecordID)
+		}
+		return toRecordID(id)
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() == 0 {
+			return nil, nil
+		}
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() == 0 {
+			return nil, nil
+		}
+		return rv.Uint(), nil
+	case reflect.Struct, reflect.Map, reflect.Pointer:
+		dataMap, err := structToMap(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRecordID, err)
+		}
+		return toRecordID(dataMap)
+	}
+
+	return nil, fmt.Errorf("%w: unsupported type %T", ErrInvalidRecordID, value)
+}
+
+// normalizeRecords converts the values of the records into the types produced by JSON decoding
+// (e.g. all numbers become float64), so they can be compared with records returned by the API.
+func normalizeRecords(records []map[string]any) ([]map[string]any, error) {
+	return structsToMaps(records)
+}
+
+// copyMap returns a shallow copy of the given map.
+func copyMap(data map[string]any) map[string]any {
+	copied := make(map[string]any, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	return copied
+}
@@ -1,6 +1,9 @@
 package nocodbgo
 
 import (
+	"encoding/json"
+	"errors"
+	"slices"
 	"testing"
 )
 
@@ -167,6 +170,38 @@ func TestReadResponseDecodeInto(t *testing.T) {
 	}
 }
 
+func TestDecodeIntoReportsFailingRecord(t *testing.T) {
+	type User struct {
+		Name     string    `json:"Name"`
+		Age      int       `json:"Age"`
+		JoinedAt Timestamp `json:"JoinedAt" nocodb:"Joined At"`
+	}
+
+	response := ListResponse{List: []map[string]any{
+		{"Name": "Ana", "Age": float64(30), "Joined At": "2024-01-02 03:04:05+00:00"},
+		{"Name": "Bob", "Age": float64(25), "Joined At": "2024-02-03 04:05:06+00:00"},
+		{"Name": "Cid", "Age": float64(40), "Joined At": "yesterday"},
+		{"Name": "Dan", "Age": "unknown"},
+	}}
+
+	var users []User
+	err := response.DecodeInto(&users)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("DecodeInto() error = %v, want a DecodeError", err)
+	}
+	if decodeErr.Index != 2 || decodeErr.Field != "Joined At" {
+		t.Errorf("DecodeInto() error = %v, want record 2 and field Joined At", err)
+	}
+
+	var user User
+	err = ReadResponse{Data: response.List[3]}.DecodeInto(&user)
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &decodeErr) || decodeErr.Field != "Age" || !errors.As(err, &typeErr) {
+		t.Errorf("DecodeInto() error = %v, want a DecodeError of field Age", err)
+	}
+}
+
 func TestStructToMap(t *testing.T) {
 	type User struct {
 		ID    int    `json:"Id"`
@@ -292,3 +327,249 @@ func TestStructsToMaps(t *testing.T) {
 		})
 	}
 }
+
+func TestToRecordIDs(t *testing.T) {
+	type Record struct {
+		ID   string `json:"Id"`
+		Name string `json:"Name"`
+	}
+
+	tests := []struct {
+		name    string
+		value   any
+		want    []any
+		wantErr bool
+	}{
+		{
+			name:  "single int",
+			value: 5,
+			want:  []any{int64(5)},
+		},
+		{
+			name:  "zero int",
+			value: 0,
+			want:  nil,
+		},
+		{
+			name:  "slice of ints",
+			value: []int{1, 0, 2},
+			want:  []any{int64(1), int64(2)},
+		},
+		{
+			name:  "slice of strings",
+			value: []string{"a1", "b2"},
+			want:  []any{"a1", "b2"},
+		},
+		{
+			name:  "slice of maps",
+			value: []map[string]any{{"Id": float64(3)}, {"Id": "uuid"}},
+			want:  []any{int64(3), "uuid"},
+		},
+		{
+			name:  "slice of structs",
+			value: []Record{{ID: "x1", Name: "John"}},
+			want:  []any{"x1"},
+		},
+		{
+			name:    "map without Id",
+			value:   map[string]any{"Name": "John"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			value:   []bool{true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toRecordIDs(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toRecordIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("toRecordIDs() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("toRecordIDs()[%d] = %v (%T), want %v (%T)", i, got[i], got[i], tt.want[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeIntoMultiSelect(t *testing.T) {
+	type Product struct {
+		Name string   `json:"Name"`
+		Tags []string `json:"Tags"`
+	}
+
+	records := []map[string]any{
+		{"Name": "Chair", "Tags": "Wood,Outdoor"},
+		{"Name": "Table", "Tags": []any{"Wood", "Indoor"}},
+		{"Name": "Lamp", "Tags": ""},
+		{"Name": "Rug", "Tags": nil},
+	}
+
+	var products []Product
+	if err := decodeInto(records, &products); err != nil {
+		t.Fatalf("decodeInto() error = %v", err)
+	}
+
+	want := [][]string{{"Wood", "Outdoor"}, {"Wood", "Indoor"}, {}, nil}
+	for i, product := range products {
+		if !slices.Equal(product.Tags, want[i]) || (want[i] != nil && product.Tags == nil) {
+			t.Errorf("products[%d].Tags = %#v, want %#v", i, product.Tags, want[i])
+		}
+	}
+}
+
+func TestDecodeIntoCheckbox(t *testing.T) {
+	type Task struct {
+		Done     bool  `json:"Done"`
+		Archived *bool `json:"Archived"`
+	}
+
+	tests := []struct {
+		value any
+		want  bool
+	}{
+		{value: true, want: true},
+		{value: false, want: false},
+		{value: float64(1), want: true},
+		{value: float64(0), want: false},
+		{value: "true", want: true},
+		{value: "false", want: false},
+		{value: "1", want: true},
+	}
+
+	for _, tt := range tests {
+		var task Task
+		if err := decodeInto(map[string]any{"Done": tt.value, "Archived": tt.value}, &task); err != nil {
+			t.Fatalf("decodeInto(%#v) error = %v", tt.value, err)
+		}
+		if task.Done != tt.want || task.Archived == nil || *task.Archived != tt.want {
+			t.Errorf("decodeInto(%#v) = %+v, want %v", tt.value, task, tt.want)
+		}
+	}
+
+	var task Task
+	if err := decodeInto(map[string]any{"Done": "maybe"}, &task); err == nil {
+		t.Error("decodeInto() with invalid checkbox error = nil, want error")
+	}
+}
+
+func TestRecordValuesEqual(t *testing.T) {
+	tests := []struct {
+		current any
+		desired any
+		want    bool
+	}{
+		{current: float64(1), desired: true, want: true},
+		{current: float64(0), desired: false, want: true},
+		{current: nil, desired: false, want: true},
+		{current: "true", desired: false, want: false},
+		{current: "Foo", desired: "Foo", want: true},
+		{current: float64(1), desired: float64(2), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := recordValuesEqual(tt.current, tt.desired); got != tt.want {
+			t.Errorf("recordValuesEqual(%#v, %#v) = %v, want %v", tt.current, tt.desired, got, tt.want)
+		}
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	type Settings struct {
+		Theme  string `json:"theme"`
+		Alerts bool   `json:"alerts"`
+	}
+	type Profile struct {
+		Name     string    `json:"Name"`
+		Settings Settings  `json:"Settings" nocodb:",json"`
+		Backup   *Settings `json:"Backup" nocodb:",json"`
+	}
+
+	record, err := structToMap(Profile{Name: "John", Settings: Settings{Theme: "dark", Alerts: true}})
+	if err != nil {
+		t.Fatalf("structToMap() error = %v", err)
+	}
+	if record["Settings"] != `{"alerts":true,"theme":"dark"}` || record["Backup"] != nil {
+		t.Errorf("structToMap() = %#v", record)
+	}
+
+	records, err := structsToMaps([]Profile{{Settings: Settings{Theme: "light"}}})
+	if err != nil {
+		t.Fatalf("structsToMaps() error = %v", err)
+	}
+	if records[0]["Settings"] != `{"alerts":false,"theme":"light"}` {
+		t.Errorf("structsToMaps() = %#v", records)
+	}
+
+	data := []map[string]any{
+		{"Name": "John", "Settings": `{"theme":"dark","alerts":true}`, "Backup": ""},
+		{"Name": "Jane", "Settings": map[string]any{"theme": "light"}, "Backup": `{"theme":"dark"}`},
+	}
+
+	var profiles []Profile
+	if err := decodeInto(data, &profiles); err != nil {
+		t.Fatalf("decodeInto() error = %v", err)
+	}
+	if profiles[0].Settings != (Settings{Theme: "dark", Alerts: true}) || profiles[0].Backup != nil {
+		t.Errorf("profiles[0] = %+v", profiles[0])
+	}
+	if profiles[1].Settings.Theme != "light" || profiles[1].Backup == nil || profiles[1].Backup.Theme != "dark" {
+		t.Errorf("profiles[1] = %+v", profiles[1])
+	}
+
+	if err := decodeInto(map[string]any{"Settings": "{not json"}, &Profile{}); err == nil {
+		t.Error("decodeInto() with invalid JSON error = nil, want error")
+	}
+}
+
+func TestNocoDBTag(t *testing.T) {
+	type Preferences struct {
+		Language string `json:"language"`
+	}
+	type Contact struct {
+		ID          int         `json:"id" nocodb:"Id"`
+		Email       string      `json:"email" nocodb:"Email Address"`
+		Name        string      `json:"name"`
+		Preferences Preferences `json:"preferences" nocodb:"Prefs,json"`
+	}
+
+	contact := Contact{ID: 3, Email: "john@example.com", Name: "John", Preferences: Preferences{Language: "en"}}
+	record, err := structToMap(contact)
+	if err != nil {
+		t.Fatalf("structToMap() error = %v", err)
+	}
+	want := map[string]any{"Id": float64(3), "Email Address": "john@example.com", "name": "John", "Prefs": `{"language":"en"}`}
+	if len(record) != len(want) {
+		t.Errorf("structToMap() = %#v, want %#v", record, want)
+	}
+	for key, value := range want {
+		if record[key] != value {
+			t.Errorf("structToMap()[%q] = %#v, want %#v", key, record[key], value)
+		}
+	}
+
+	ids, err := toRecordIDs([]Contact{contact})
+	if err != nil || len(ids) != 1 || ids[0] != int64(3) {
+		t.Errorf("toRecordIDs() = %v, %v, want [3]", ids, err)
+	}
+
+	var decoded Contact
+	data := map[string]any{"Id": 3, "Email Address": "john@example.com", "email": "other@example.com", "name": "John", "Prefs": `{"language":"en"}`}
+	if err := decodeInto(data, &decoded); err != nil {
+		t.Fatalf("decodeInto() error = %v", err)
+	}
+	if decoded != contact {
+		t.Errorf("decodeInto() = %+v, want %+v", decoded, contact)
+	}
+}
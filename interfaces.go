@@ -0,0 +1,150 @@
+package nocodbgo
+
+import "context"
+
+// ListRecordsQuery is a query that lists the records of a table, returned by RecordLister.
+//
+// It has the essential methods of the query built by Table.ListRecords. Other filters can be
+// written with Where using the NocoDB filter syntax (e.g. "(Age,gt,18)").
+type ListRecordsQuery interface {
+	WithContext(ctx context.Context) ListRecordsQuery
+	Where(filter string) ListRecordsQuery
+	WhereIsEqualTo(column string, value string) ListRecordsQuery
+	WhereIsIn(column string, values ...string) ListRecordsQuery
+	SortAscBy(column string) ListRecordsQuery
+	SortDescBy(column string) ListRecordsQuery
+	Limit(limit int) ListRecordsQuery
+	Offset(offset int) ListRecordsQuery
+	Page(page int, pageSize int) ListRecordsQuery
+	ReturnFields(fields ...string) ListRecordsQuery
+	WithViewId(viewId string) ListRecordsQuery
+	Execute() (ListResponse, error)
+	ExecuteAll() (ListResponse, error)
+	Iterate() *RecordIterator
+}
+
+// CountRecordsQuery is a query that counts the records of a table, returned by RecordCounter.
+type CountRecordsQuery interface {
+	WithContext(ctx context.Context) CountRecordsQuery
+	Where(filter string) CountRecordsQuery
+	WhereIsEqualTo(column string, value string) CountRecordsQuery
+	WhereIsIn(column string, values ...string) CountRecordsQuery
+	WithViewId(viewId string) CountRecordsQuery
+	Execute() (int, error)
+}
+
+// ReadRecordQuery is a query that reads a single record of a table, returned by RecordReader.
+type ReadRecordQuery interface {
+	WithContext(ctx context.Context) ReadRecordQuery
+	ReturnFields(fields ...string) ReadRecordQuery
+	Execute() (ReadResponse, error)
+}
+
+// CreateRecordQuery is an operation that creates a record, returned by RecordCreator.
+type CreateRecordQuery interface {
+	WithContext(ctx context.Context) CreateRecordQuery
+	Execute() (RecordID, error)
+}
+
+// CreateRecordsQuery is an operation that creates several records, returned by RecordCreator.
+type CreateRecordsQuery interface {
+	WithContext(ctx context.Context) CreateRecordsQuery
+	Execute() ([]RecordID, error)
+}
+
+// MutationQuery is an operation that changes records or links and only returns an error, like
+// the updates and deletes of records and the changes of links.
+type MutationQuery interface {
+	WithContext(ctx context.Context) MutationQuery
+	Execute() error
+}
+
+// ListLinksQuery is a query that lists the records linked to a record, returned by LinkManager.
+type ListLinksQuery interface {
+	WithContext(ctx context.Context) ListLinksQuery
+	Where(filter string) ListLinksQuery
+	WhereIsEqualTo(column string, value string) ListLinksQuery
+	SortAscBy(column string) ListLinksQuery
+	SortDescBy(column string) ListLinksQuery
+	Limit(limit int) ListLinksQuery
+	Offset(offset int) ListLinksQuery
+	ReturnFields(fields ...string) ListLinksQuery
+	Execute() (ListResponse, error)
+	ExecuteAll() (ListResponse, error)
+	Iterate() *RecordIterator
+}
+
+// HasLinkQuery is a query that checks whether two records are linked, returned by LinkManager.
+type HasLinkQuery interface {
+	WithContext(ctx context.Context) HasLinkQuery
+	Execute() (bool, error)
+}
+
+// RecordLister is implemented by types that can list the records of a table.
+type RecordLister interface {
+	ListRecords() ListRecordsQuery
+}
+
+// RecordCounter is implemented by types that can count the records of a table.
+type RecordCounter interface {
+	CountRecords() CountRecordsQuery
+}
+
+// RecordReader is implemented by types that can read a single record of a table.
+type RecordReader interface {
+	ReadRecord(recordID any) ReadRecordQuery
+}
+
+// RecordCreator is implemented by types that can create records in a table.
+type RecordCreator interface {
+	CreateRecord(data any) CreateRecordQuery
+	CreateRecords(data any) CreateRecordsQuery
+}
+
+// RecordUpdater is implemented by types that can update records in a table.
+type RecordUpdater interface {
+	UpdateRecord(data any) MutationQuery
+	UpdateRecords(data any) MutationQuery
+}
+
+// RecordDeleter is implemented by types that can delete records from a table.
+type RecordDeleter interface {
+	DeleteRecord(recordID any) MutationQuery
+	DeleteRecords(recordIDs any) MutationQuery
+}
+
+// LinkManager is implemented by types that can list, create and delete links between records.
+type LinkManager interface {
+	ListLinks(localLinkFieldID string, localRecordID any) ListLinksQuery
+	HasLink(localLinkFieldID string, localRecordID any, targetRecord any) HasLinkQuery
+	CreateLink(localLinkFieldID string, localRecordID any, targetRecord any) MutationQuery
+	CreateLinks(localLinkFieldID string, localRecordID any, targetRecords any) MutationQuery
+	DeleteLink(localLinkFieldID string, localRecordID any, targetRecord any) MutationQuery
+	DeleteLinks(localLinkFieldID string, localRecordID any, targetRecords any) MutationQuery
+	ReplaceLinks(localLinkFieldID string, localRecordID any, targetRecordIDs any) MutationQuery
+}
+
+// TableAPI groups the operations on the records and links of a table behind interfaces, so they
+// can be replaced in tests with mocks generated by tools like gomock or mockery, or with
+// hand-written fakes. The implementation backed by a table is returned by Table.API.
+//
+// Accept this interface (or one of the smaller ones it's composed of) in your own code instead
+// of *Table. Fakes can embed the interface and implement only the methods used by the code under
+// test, and implement Iterate with IterateRecords.
+//
+// Example:
+//
+//	type UserStore struct {
+//		users nocodbgo.RecordLister
+//	}
+//
+//	store := UserStore{users: client.Table("users-table-id").API()}
+type TableAPI interface {
+	RecordLister
+	RecordCounter
+	RecordReader
+	RecordCreator
+	RecordUpdater
+	RecordDeleter
+	LinkManager
+}
@@ -0,0 +1,323 @@
+package nocodbgo
+
+import "context"
+
+// tableAPI implements TableAPI with the builders of a table
+type tableAPI struct {
+	table *Table
+}
+
+// Ensure tableAPI implements TableAPI
+var _ TableAPI = tableAPI{}
+
+// API returns the operations of the table as a TableAPI, to use the table where the interfaces of
+// TableAPI are accepted.
+//
+// Example:
+//
+//	var users nocodbgo.TableAPI = client.Table("users-table-id").API()
+//	result, err := users.ListRecords().WhereIsEqualTo("Status", "active").Execute()
+func (t *Table) API() TableAPI {
+	return tableAPI{table: t}
+}
+
+// ListRecords implements RecordLister.
+func (a tableAPI) ListRecords() ListRecordsQuery {
+	return listRecordsQuery{b: a.table.ListRecords()}
+}
+
+// CountRecords implements RecordCounter.
+func (a tableAPI) CountRecords() CountRecordsQuery {
+	return countRecordsQuery{b: a.table.CountRecords()}
+}
+
+// ReadRecord implements RecordReader.
+func (a tableAPI) ReadRecord(recordID any) ReadRecordQuery {
+	return readRecordQuery{b: a.table.ReadRecord(recordID)}
+}
+
+// CreateRecord implements RecordCreator.
+func (a tableAPI) CreateRecord(data any) CreateRecordQuery {
+	return createRecordQuery{b: a.table.CreateRecord(data)}
+}
+
+// CreateRecords implements RecordCreator.
+func (a tableAPI) CreateRecords(data any) CreateRecordsQuery {
+	return createRecordsQuery{b: a.table.CreateRecords(data)}
+}
+
+// UpdateRecord implements RecordUpdater.
+func (a tableAPI) UpdateRecord(data any) MutationQuery {
+	b := a.table.UpdateRecord(data)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// UpdateRecords implements RecordUpdater.
+func (a tableAPI) UpdateRecords(data any) MutationQuery {
+	b := a.table.UpdateRecords(data)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// DeleteRecord implements RecordDeleter.
+func (a tableAPI) DeleteRecord(recordID any) MutationQuery {
+	b := a.table.DeleteRecord(recordID)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// DeleteRecords implements RecordDeleter.
+func (a tableAPI) DeleteRecords(recordIDs any) MutationQuery {
+	b := a.table.DeleteRecords(recordIDs)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// ListLinks implements LinkManager.
+func (a tableAPI) ListLinks(localLinkFieldID string, localRecordID any) ListLinksQuery {
+	return listLinksQuery{b: a.table.ListLinks(localLinkFieldID, localRecordID)}
+}
+
+// HasLink implements LinkManager.
+func (a tableAPI) HasLink(localLinkFieldID string, localRecordID any, targetRecord any) HasLinkQuery {
+	return hasLinkQuery{b: a.table.HasLink(localLinkFieldID, localRecordID, targetRecord)}
+}
+
+// CreateLink implements LinkManager.
+func (a tableAPI) CreateLink(localLinkFieldID string, localRecordID any, targetRecord any) MutationQuery {
+	b := a.table.CreateLink(localLinkFieldID, localRecordID, targetRecord)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// CreateLinks implements LinkManager.
+func (a tableAPI) CreateLinks(localLinkFieldID string, localRecordID any, targetRecords any) MutationQuery {
+	b := a.table.CreateLinks(localLinkFieldID, localRecordID, targetRecords)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// DeleteLink implements LinkManager.
+func (a tableAPI) DeleteLink(localLinkFieldID string, localRecordID any, targetRecord any) MutationQuery {
+	b := a.table.DeleteLink(localLinkFieldID, localRecordID, targetRecord)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// DeleteLinks implements LinkManager.
+func (a tableAPI) DeleteLinks(localLinkFieldID string, localRecordID any, targetRecords any) MutationQuery {
+	b := a.table.DeleteLinks(localLinkFieldID, localRecordID, targetRecords)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// ReplaceLinks implements LinkManager.
+func (a tableAPI) ReplaceLinks(localLinkFieldID string, localRecordID any, targetRecordIDs any) MutationQuery {
+	b := a.table.ReplaceLinks(localLinkFieldID, localRecordID, targetRecordIDs)
+	return mutationQuery{withContext: func(ctx context.Context) { b.WithContext(ctx) }, execute: b.Execute}
+}
+
+// listRecordsQuery implements ListRecordsQuery with a listRecordsBuilder
+type listRecordsQuery struct {
+	b *listRecordsBuilder
+}
+
+func (q listRecordsQuery) WithContext(ctx context.Context) ListRecordsQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q listRecordsQuery) Where(filter string) ListRecordsQuery {
+	q.b.Where(filter)
+	return q
+}
+
+func (q listRecordsQuery) WhereIsEqualTo(column string, value string) ListRecordsQuery {
+	q.b.WhereIsEqualTo(column, value)
+	return q
+}
+
+func (q listRecordsQuery) WhereIsIn(column string, values ...string) ListRecordsQuery {
+	q.b.WhereIsIn(column, values...)
+	return q
+}
+
+func (q listRecordsQuery) SortAscBy(column string) ListRecordsQuery {
+	q.b.SortAscBy(column)
+	return q
+}
+
+func (q listRecordsQuery) SortDescBy(column string) ListRecordsQuery {
+	q.b.SortDescBy(column)
+	return q
+}
+
+func (q listRecordsQuery) Limit(limit int) ListRecordsQuery {
+	q.b.Limit(limit)
+	return q
+}
+
+func (q listRecordsQuery) Offset(offset int) ListRecordsQuery {
+	q.b.Offset(offset)
+	return q
+}
+
+func (q listRecordsQuery) Page(page int, pageSize int) ListRecordsQuery {
+	q.b.Page(page, pageSize)
+	return q
+}
+
+func (q listRecordsQuery) ReturnFields(fields ...string) ListRecordsQuery {
+	q.b.ReturnFields(fields...)
+	return q
+}
+
+func (q listRecordsQuery) WithViewId(viewId string) ListRecordsQuery {
+	q.b.WithViewId(viewId)
+	return q
+}
+
+func (q listRecordsQuery) Execute() (ListResponse, error)    { return q.b.Execute() }
+func (q listRecordsQuery) ExecuteAll() (ListResponse, error) { return q.b.ExecuteAll() }
+func (q listRecordsQuery) Iterate() *RecordIterator          { return q.b.Iterate() }
+
+// countRecordsQuery implements CountRecordsQuery with a countRecordsBuilder
+type countRecordsQuery struct {
+	b *countRecordsBuilder
+}
+
+func (q countRecordsQuery) WithContext(ctx context.Context) CountRecordsQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q countRecordsQuery) Where(filter string) CountRecordsQuery {
+	q.b.Where(filter)
+	return q
+}
+
+func (q countRecordsQuery) WhereIsEqualTo(column string, value string) CountRecordsQuery {
+	q.b.WhereIsEqualTo(column, value)
+	return q
+}
+
+func (q countRecordsQuery) WhereIsIn(column string, values ...string) CountRecordsQuery {
+	q.b.WhereIsIn(column, values...)
+	return q
+}
+
+func (q countRecordsQuery) WithViewId(viewId string) CountRecordsQuery {
+	q.b.WithViewId(viewId)
+	return q
+}
+
+func (q countRecordsQuery) Execute() (int, error) { return q.b.Execute() }
+
+// readRecordQuery implements ReadRecordQuery with a readRecordBuilder
+type readRecordQuery struct {
+	b *readRecordBuilder
+}
+
+func (q readRecordQuery) WithContext(ctx context.Context) ReadRecordQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q readRecordQuery) ReturnFields(fields ...string) ReadRecordQuery {
+	q.b.ReturnFields(fields...)
+	return q
+}
+
+func (q readRecordQuery) Execute() (ReadResponse, error) { return q.b.Execute() }
+
+// createRecordQuery implements CreateRecordQuery with a createRecordBuilder
+type createRecordQuery struct {
+	b *createRecordBuilder
+}
+
+func (q createRecordQuery) WithContext(ctx context.Context) CreateRecordQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q createRecordQuery) Execute() (RecordID, error) { return q.b.Execute() }
+
+// createRecordsQuery implements CreateRecordsQuery with a createRecordsBuilder
+type createRecordsQuery struct {
+	b *createRecordsBuilder
+}
+
+func (q createRecordsQuery) WithContext(ctx context.Context) CreateRecordsQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q createRecordsQuery) Execute() ([]RecordID, error) { return q.b.Execute() }
+
+// mutationQuery implements MutationQuery with the methods of any builder of an operation that
+// only returns an error
+type mutationQuery struct {
+	withContext func(ctx context.Context)
+	execute     func() error
+}
+
+func (q mutationQuery) WithContext(ctx context.Context) MutationQuery {
+	q.withContext(ctx)
+	return q
+}
+
+func (q mutationQuery) Execute() error { return q.execute() }
+
+// listLinksQuery implements ListLinksQuery with a listLinksBuilder
+type listLinksQuery struct {
+	b *listLinksBuilder
+}
+
+func (q listLinksQuery) WithContext(ctx context.Context) ListLinksQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q listLinksQuery) Where(filter string) ListLinksQuery {
+	q.b.Where(filter)
+	return q
+}
+
+func (q listLinksQuery) WhereIsEqualTo(column string, value string) ListLinksQuery {
+	q.b.WhereIsEqualTo(column, value)
+	return q
+}
+
+func (q listLinksQuery) SortAscBy(column string) ListLinksQuery {
+	q.b.SortAscBy(column)
+	return q
+}
+
+func (q listLinksQuery) SortDescBy(column string) ListLinksQuery {
+	q.b.SortDescBy(column)
+	return q
+}
+
+func (q listLinksQuery) Limit(limit int) ListLinksQuery {
+	q.b.Limit(limit)
+	return q
+}
+
+func (q listLinksQuery) Offset(offset int) ListLinksQuery {
+	q.b.Offset(offset)
+	return q
+}
+
+func (q listLinksQuery) ReturnFields(fields ...string) ListLinksQuery {
+	q.b.ReturnFields(fields...)
+	return q
+}
+
+func (q listLinksQuery) Execute() (ListResponse, error)    { return q.b.Execute() }
+func (q listLinksQuery) ExecuteAll() (ListResponse, error) { return q.b.ExecuteAll() }
+func (q listLinksQuery) Iterate() *RecordIterator          { return q.b.Iterate() }
+
+// hasLinkQuery implements HasLinkQuery with a hasLinkBuilder
+type hasLinkQuery struct {
+	b *hasLinkBuilder
+}
+
+func (q hasLinkQuery) WithContext(ctx context.Context) HasLinkQuery {
+	q.b.WithContext(ctx)
+	return q
+}
+
+func (q hasLinkQuery) Execute() (bool, error) { return q.b.Execute() }
@@ -0,0 +1,113 @@
+package nocodbgo_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo"
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+// activeUserNames is code under test that depends on the interfaces instead of *Table
+func activeUserNames(ctx context.Context, users nocodbgo.RecordLister) ([]string, error) {
+	it := users.ListRecords().WithContext(ctx).WhereIsEqualTo("Status", "active").SortAscBy("Name").Iterate()
+
+	names := []string{}
+	for it.Next() {
+		names = append(names, it.Record()["Name"].(string))
+	}
+	return names, it.Err()
+}
+
+// fakeUsers is a hand-written fake of TableAPI, which only implements the methods the code under
+// test uses and panics in the others through the nil embedded interface
+type fakeUsers struct {
+	nocodbgo.TableAPI
+	records []map[string]any
+	filters []string
+}
+
+func (f *fakeUsers) ListRecords() nocodbgo.ListRecordsQuery {
+	return &fakeListQuery{users: f}
+}
+
+// fakeListQuery is a hand-written fake of ListRecordsQuery
+type fakeListQuery struct {
+	nocodbgo.ListRecordsQuery
+	users *fakeUsers
+}
+
+func (q *fakeListQuery) WithContext(ctx context.Context) nocodbgo.ListRecordsQuery { return q }
+func (q *fakeListQuery) SortAscBy(column string) nocodbgo.ListRecordsQuery         { return q }
+
+func (q *fakeListQuery) WhereIsEqualTo(column string, value string) nocodbgo.ListRecordsQuery {
+	q.users.filters = append(q.users.filters, column+"="+value)
+	return q
+}
+
+func (q *fakeListQuery) Iterate() *nocodbgo.RecordIterator {
+	return nocodbgo.IterateRecords(q.users.records)
+}
+
+func TestTableAPIFake(t *testing.T) {
+	users := &fakeUsers{records: []map[string]any{{"Name": "Ana"}, {"Name": "Bob"}}}
+
+	names, err := activeUserNames(context.Background(), users)
+	if err != nil {
+		t.Fatalf("activeUserNames() error = %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"Ana", "Bob"}) {
+		t.Errorf("activeUserNames() = %v, want [Ana Bob]", names)
+	}
+	if !reflect.DeepEqual(users.filters, []string{"Status=active"}) {
+		t.Errorf("filters = %v, want [Status=active]", users.filters)
+	}
+}
+
+func TestTableAPI(t *testing.T) {
+	fake := nocodbgotest.New()
+	client, err := nocodbgo.NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	var users nocodbgo.TableAPI = client.Table("users").API()
+	ctx := context.Background()
+
+	ids, err := users.CreateRecords([]map[string]any{
+		{"Name": "Cid", "Status": "active"},
+		{"Name": "Ana", "Status": "active"},
+		{"Name": "Bob", "Status": "blocked"},
+	}).WithContext(ctx).Execute()
+	if err != nil || len(ids) != 3 {
+		t.Fatalf("CreateRecords() = %v, %v, want 3 IDs", ids, err)
+	}
+
+	names, err := activeUserNames(ctx, users)
+	if err != nil {
+		t.Fatalf("activeUserNames() error = %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"Ana", "Cid"}) {
+		t.Errorf("activeUserNames() = %v, want [Ana Cid]", names)
+	}
+
+	if err := users.UpdateRecord(map[string]any{"Id": ids[2], "Status": "active"}).WithContext(ctx).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	count, err := users.CountRecords().WhereIsEqualTo("Status", "active").Execute()
+	if err != nil || count != 3 {
+		t.Errorf("CountRecords() = %d, %v, want 3", count, err)
+	}
+
+	if err := users.DeleteRecord(ids[0]).Execute(); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+	if _, err := users.ReadRecord(ids[0]).Execute(); err == nil {
+		t.Error("ReadRecord() of a deleted record error = nil, want an error")
+	}
+}
@@ -0,0 +1,188 @@
+package nocodbgo
+
+import (
+	"context"
+)
+
+const (
+	// defaultIteratorPageSize is the number of records fetched per request when iterating
+	// over records and no explicit limit has been set
+	defaultIteratorPageSize = 100
+)
+
+// pageFetcher fetches a single page of records using the given limit and offset
+type pageFetcher func(ctx context.Context, limit int, offset int) (ListResponse, error)
+
+// RecordIterator iterates over all the records of a query, transparently fetching the
+// next page from the API when the current one has been consumed.
+//
+// Example:
+//
+//	it := table.ListRecords().WhereIsGreaterThan("Age", "18").Iterate()
+//	for it.Next() {
+//		fmt.Println(it.Record())
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle error
+//	}
+type RecordIterator struct {
+	ctx      context.Context
+	fetch    pageFetcher
+	pageSize int
+	offset   int
+	page     []map[string]any
+	current  map[string]any
+	lastPage bool
+	err      error
+
+	// total is the total number of records reported by the last page, 0 if unknown
+	total int
+	// onDrift is called when the total changes between pages, stopping the iteration if it
+	// returns an error, nil if the changes are not checked
+	onDrift func(warning PaginationDriftWarning) error
+}
+
+// newRecordIterator creates a new RecordIterator that fetches pages of pageSize records
+// starting at the given offset.
+func newRecordIterator(ctx context.Context, fetch pageFetcher, pageSize int, offset int) *RecordIterator {
+	if pageSize < 1 {
+		pageSize = defaultIteratorPageSize
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return &RecordIterator{
+		ctx:      ctx,
+		fetch:    fetch,
+		pageSize: pageSize,
+		offset:   offset,
+	}
+}
+
+// IterateRecords returns an iterator over the given records, to implement Iterate in fakes of
+// ListRecordsQuery and ListLinksQuery.
+//
+// Example:
+//
+//	func (q fakeQuery) Iterate() *nocodbgo.RecordIterator {
+//		return nocodbgo.IterateRecords(q.records)
+//	}
+func IterateRecords(records []map[string]any) *RecordIterator {
+	fetch := func(ctx context.Context, limit int, offset int) (ListResponse, error) {
+		return ListResponse{List: records, PageInfo: PageInfo{IsLastPage: true}}, nil
+	}
+	return newRecordIterator(context.Background(), fetch, len(records), 0)
+}
+
+// Next advances the iterator to the next record, fetching the next page if needed.
+//
+// It returns false when there are no more records or when an error occurs, in which
+// case the error can be retrieved with Err. If the context of the query is canceled, it
+// stops before fetching the next page and Err returns the error of the context.
+func (it *RecordIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	// A canceled context stops the iteration even if the current page has records left, so
+	// callers processing slow records don't keep going after the cancellation
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		it.current = nil
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.lastPage {
+			it.current = nil
+			return false
+		}
+
+		response, err := it.fetch(it.ctx, it.pageSize, it.offset)
+		if err != nil {
+			// Report the cancellation itself instead of the failed request it caused
+			if ctxErr := it.ctx.Err(); ctxErr != nil {
+				err = ctxErr
+			}
+			it.err = err
+			it.current = nil
+			return false
+		}
+
+		if err := it.checkDrift(response.PageInfo.TotalRows); err != nil {
+			it.err = err
+			it.current = nil
+			return false
+		}
+
+		it.page = response.List
+		it.offset += len(response.List)
+		it.lastPage = response.PageInfo.IsLastPage || len(response.List) < it.pageSize
+	}
+
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// checkDrift compares the total number of records of a page with the one of the previous page,
+// calling onDrift if it changed. Totals of 0 are ignored, since some API versions don't return it.
+func (it *RecordIterator) checkDrift(total int) error {
+	if it.onDrift == nil || total <= 0 {
+		return nil
+	}
+
+	previous := it.total
+	it.total = total
+	if previous <= 0 || previous == total {
+		return nil
+	}
+
+	return it.onDrift(PaginationDriftWarning{Offset: it.offset, PreviousTotal: previous, CurrentTotal: total})
+}
+
+// Record returns the current record of the iterator.
+func (it *RecordIterator) Record() map[string]any {
+	return it.current
+}
+
+// DecodeInto converts the current record into the provided struct.
+// It takes a pointer to a struct as destination and populates it with the data.
+// Returns an error if the conversion fails.
+func (it *RecordIterator) DecodeInto(dest any) error {
+	return decodeInto(it.current, dest)
+}
+
+// Err returns the error, if any, that was encountered during the iteration.
+func (it *RecordIterator) Err() error {
+	return it.err
+}
+
+// collectAll consumes the iterator and returns all the records in a single ListResponse.
+func collectAll(it *RecordIterator) (ListResponse, error) {
+	records := []map[string]any{}
+	for it.Next() {
+		records = append(records, it.Record())
+	}
+
+	if err := it.Err(); err != nil {
+		return ListResponse{}, err
+	}
+
+	return ListResponse{
+		List: records,
+		PageInfo: PageInfo{
+			TotalRows:   len(records),
+			Page:        1,
+			PageSize:    len(records),
+			IsFirstPage: true,
+			IsLastPage:  true,
+		},
+	}, nil
+}
@@ -0,0 +1,124 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// newSliceFetcher returns a pageFetcher that serves pages from the given records
+func newSliceFetcher(records []map[string]any, calls *int) pageFetcher {
+	return func(ctx context.Context, limit int, offset int) (ListResponse, error) {
+		*calls++
+		if offset >= len(records) {
+			return ListResponse{List: []map[string]any{}, PageInfo: PageInfo{IsLastPage: true}}, nil
+		}
+
+		end := offset + limit
+		if end > len(records) {
+			end = len(records)
+		}
+
+		return ListResponse{
+			List:     records[offset:end],
+			PageInfo: PageInfo{IsLastPage: end == len(records)},
+		}, nil
+	}
+}
+
+func TestRecordIterator(t *testing.T) {
+	records := []map[string]any{}
+	for i := 1; i <= 7; i++ {
+		records = append(records, map[string]any{"Id": float64(i)})
+	}
+
+	t.Run("IteratesAllPages", func(t *testing.T) {
+		calls := 0
+		it := newRecordIterator(context.Background(), newSliceFetcher(records, &calls), 3, 0)
+
+		var ids []int
+		for it.Next() {
+			ids = append(ids, int(it.Record()["Id"].(float64)))
+		}
+
+		if err := it.Err(); err != nil {
+			t.Fatalf("Err() = %v, want nil", err)
+		}
+		if len(ids) != 7 || ids[0] != 1 || ids[6] != 7 {
+			t.Errorf("Iterated ids = %v, want 1..7", ids)
+		}
+		if calls != 3 {
+			t.Errorf("Fetch calls = %d, want 3", calls)
+		}
+	})
+
+	t.Run("StartsAtOffset", func(t *testing.T) {
+		calls := 0
+		response, err := collectAll(newRecordIterator(context.Background(), newSliceFetcher(records, &calls), 0, 5))
+		if err != nil {
+			t.Fatalf("collectAll() error = %v", err)
+		}
+		if len(response.List) != 2 {
+			t.Errorf("collectAll() len = %d, want 2", len(response.List))
+		}
+		if response.PageInfo.TotalRows != 2 || !response.PageInfo.IsLastPage {
+			t.Errorf("collectAll() PageInfo = %+v", response.PageInfo)
+		}
+	})
+
+	t.Run("StopsOnError", func(t *testing.T) {
+		wantErr := errors.New("boom")
+		fetch := func(ctx context.Context, limit int, offset int) (ListResponse, error) {
+			return ListResponse{}, wantErr
+		}
+
+		it := newRecordIterator(context.Background(), fetch, 10, 0)
+		if it.Next() {
+			t.Error("Next() = true, want false")
+		}
+		if !errors.Is(it.Err(), wantErr) {
+			t.Errorf("Err() = %v, want %v", it.Err(), wantErr)
+		}
+	})
+
+	t.Run("StopsWhenContextCanceled", func(t *testing.T) {
+		calls := 0
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		it := newRecordIterator(ctx, newSliceFetcher(records, &calls), 3, 0)
+
+		count := 0
+		for it.Next() {
+			count++
+			if count == 2 {
+				cancel()
+			}
+		}
+
+		if !errors.Is(it.Err(), context.Canceled) {
+			t.Errorf("Err() = %v, want %v", it.Err(), context.Canceled)
+		}
+		if count != 2 || calls != 1 {
+			t.Errorf("Iterated %d records with %d fetch calls, want 2 records and 1 call", count, calls)
+		}
+	})
+
+	t.Run("ReportsContextErrorOfFailedFetch", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+		fetch := func(ctx context.Context, limit int, offset int) (ListResponse, error) {
+			cancel()
+			return ListResponse{}, fmt.Errorf("failed to list records: %w", errors.New("connection reset"))
+		}
+
+		it := newRecordIterator(ctx, fetch, 10, 0)
+		if it.Next() {
+			t.Error("Next() = true, want false")
+		}
+		if it.Err() != context.Canceled {
+			t.Errorf("Err() = %v, want %v", it.Err(), context.Canceled)
+		}
+	})
+}
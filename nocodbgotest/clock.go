@@ -0,0 +1,93 @@
+package nocodbgotest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a clock that only moves when told to, to test the features of the client that depend
+// on time without waiting. It implements the nocodbgo.Clock interface and is safe for concurrent use.
+//
+// Example:
+//
+//	clock := nocodbgotest.NewClock(time.Now())
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL(fake.BaseURL()).
+//		WithAPIToken("test-token").
+//		WithHTTPClient(fake.HTTPClient()).
+//		WithClock(clock).
+//		Create()
+//
+//	// Fire the timers of the next minute without waiting
+//	clock.Advance(time.Minute)
+type Clock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []clockTimer
+}
+
+// clockTimer is a pending timer of a Clock
+type clockTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewClock creates a new Clock set to the given time.
+func NewClock(now time.Time) *Clock {
+	c := &Clock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the current time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the time of the clock once it has been advanced by the
+// given duration. Durations that are zero or negative fire immediately.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+
+	c.timers = append(c.timers, clockTimer{at: c.now.Add(d), ch: ch})
+	c.cond.Broadcast()
+	return ch
+}
+
+// Advance moves the clock forward by the given duration, firing the timers that expire.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+			continue
+		}
+		timer.ch <- c.now
+	}
+	c.timers = pending
+}
+
+// WaitForTimers blocks until there are at least n pending timers, to advance the clock once the
+// code under test is waiting on it.
+func (c *Clock) WaitForTimers(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
@@ -0,0 +1,518 @@
+// Package nocodbgotest provides an in-memory fake of the NocoDB v2 data API for testing code
+// that uses the nocodbgo client without a live NocoDB instance.
+//
+// The fake implements the records endpoints (create, read, list, count, update and delete), the
+// links endpoints and the meta endpoint that reads a table, including filters, sorting, field
+// selection and pagination. It's plugged
+// into a regular client through its HTTP client, or served over the network with NewServer:
+//
+//	fake := nocodbgotest.New()
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL(fake.BaseURL()).
+//		WithAPIToken("test-token").
+//		WithHTTPClient(fake.HTTPClient()).
+//		Create()
+package nocodbgotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// fakeBaseURL is the base URL used by clients that talk to the fake through its HTTP client
+	fakeBaseURL = "http://nocodb.fake"
+
+	// defaultPageSize is the page size used by list endpoints when no limit is provided
+	defaultPageSize = 25
+
+	// maxPageSize is the maximum page size allowed by list endpoints
+	maxPageSize = 1000
+
+	// timestampLayout is the layout used by NocoDB for the CreatedAt and UpdatedAt system fields
+	timestampLayout = "2006-01-02 15:04:05-07:00"
+)
+
+// Fake is an in-memory implementation of the NocoDB v2 data API.
+//
+// Tables are created on first use, every record gets an auto-incremented "Id" and the
+// "CreatedAt" and "UpdatedAt" system fields. It's safe for concurrent use.
+type Fake struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+	links  map[linkKey]*fakeLink
+	mux    *http.ServeMux
+	now    func() time.Time
+}
+
+// fakeTable holds the records of a single table
+type fakeTable struct {
+	lastID  int64
+	records []map[string]any
+}
+
+// New creates a new empty Fake.
+func New() *Fake {
+	f := &Fake{
+		tables: map[string]*fakeTable{},
+		links:  map[linkKey]*fakeLink{},
+		mux:    http.NewServeMux(),
+		now:    time.Now,
+	}
+
+	f.mux.HandleFunc("GET /api/v2/tables/{tableId}/records", f.handleListRecords)
+	f.mux.HandleFunc("POST /api/v2/tables/{tableId}/records", f.handleCreateRecords)
+	f.mux.HandleFunc("PATCH /api/v2/tables/{tableId}/records", f.handleUpdateRecords)
+	f.mux.HandleFunc("DELETE /api/v2/tables/{tableId}/records", f.handleDeleteRecords)
+	f.mux.HandleFunc("GET /api/v2/tables/{tableId}/records/{recordId}", f.handleReadRecord)
+	f.mux.HandleFunc("GET /api/v2/tables/{tableId}/records/count", f.handleCountRecords)
+	f.mux.HandleFunc("GET /api/v2/meta/tables/{tableId}", f.handleReadTable)
+	f.mux.HandleFunc("GET /api/v2/tables/{tableId}/links/{linkFieldId}/records/{recordId}", f.handleListLinks)
+	f.mux.HandleFunc("POST /api/v2/tables/{tableId}/links/{linkFieldId}/records/{recordId}", f.handleCreateLinks)
+	f.mux.HandleFunc("DELETE /api/v2/tables/{tableId}/links/{linkFieldId}/records/{recordId}", f.handleDeleteLinks)
+
+	return f
+}
+
+// BaseURL returns the base URL to use together with HTTPClient when creating a client.
+func (f *Fake) BaseURL() string {
+	return fakeBaseURL
+}
+
+// HTTPClient returns an HTTP client that serves every request from the fake without using the network.
+func (f *Fake) HTTPClient() *http.Client {
+	return &http.Client{Transport: roundTripper{handler: f}}
+}
+
+// SetClock makes the fake use the clock for the CreatedAt and UpdatedAt system fields of the records.
+func (f *Fake) SetClock(clock *Clock) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = clock.Now
+}
+
+// Seed inserts the given records into the table and returns their IDs.
+func (f *Fake) Seed(tableID string, records ...map[string]any) []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]int64, len(records))
+	for i, record := range records {
+		ids[i] = f.insert(tableID, record)
+	}
+
+	return ids
+}
+
+// Records returns a copy of all the records stored in the table, in insertion order.
+func (f *Fake) Records(tableID string) []map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records := f.table(tableID).records
+	copies := make([]map[string]any, len(records))
+	for i, record := range records {
+		copies[i] = copyRecord(record)
+	}
+
+	return copies
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (f *Fake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("xc-token") == "" {
+		writeError(w, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	f.mux.ServeHTTP(w, r)
+}
+
+// table returns the table with the given ID, creating it if needed. The caller must hold the lock.
+func (f *Fake) table(tableID string) *fakeTable {
+	t, ok := f.tables[tableID]
+	if !ok {
+		t = &fakeTable{}
+		f.tables[tableID] = t
+	}
+	return t
+}
+
+// insert stores a copy of the record with its system fields and returns its ID. The caller must hold the lock.
+func (f *Fake) insert(tableID string, record map[string]any) int64 {
+	t := f.table(tableID)
+	t.lastID++
+
+	stored := normalizeRecord(record)
+	now := f.now().UTC().Format(timestampLayout)
+	stored["Id"] = float64(t.lastID)
+	stored["CreatedAt"] = now
+	stored["UpdatedAt"] = now
+	t.records = append(t.records, stored)
+
+	return t.lastID
+}
+
+// find returns the index of the record with the given ID or -1 if it doesn't exist. The caller must hold the lock.
+func (t *fakeTable) find(recordID string) int {
+	for i, record := range t.records {
+		if fmt.Sprint(record["Id"]) == recordID {
+			return i
+		}
+	}
+	return -1
+}
+
+// handleListRecords serves GET /api/v2/tables/{tableId}/records
+func (f *Fake) handleListRecords(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records, err := f.query(f.table(r.PathValue("tableId")).records, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, paginate(records, r))
+}
+
+// handleReadRecord serves GET /api/v2/tables/{tableId}/records/{recordId}
+func (f *Fake) handleReadRecord(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := f.table(r.PathValue("tableId"))
+	index := t.find(r.PathValue("recordId"))
+	if index < 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Record '%s' not found", r.PathValue("recordId")))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, selectFields(t.records[index], r.URL.Query().Get("fields")))
+}
+
+// handleCountRecords serves GET /api/v2/tables/{tableId}/records/count
+func (f *Fake) handleCountRecords(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records, err := f.query(f.table(r.PathValue("tableId")).records, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"count": len(records)})
+}
+
+// handleReadTable serves GET /api/v2/meta/tables/{tableId}
+//
+// The schema only has the columns every table of the fake has, the "Id" primary key and the
+// CreatedAt and UpdatedAt system fields.
+func (f *Fake) handleReadTable(w http.ResponseWriter, r *http.Request) {
+	tableID := r.PathValue("tableId")
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":         tableID,
+		"title":      tableID,
+		"table_name": tableID,
+		"columns": []map[string]any{
+			{"id": "Id", "title": "Id", "column_name": "id", "uidt": "ID", "pk": true, "ai": true},
+			{"id": "CreatedAt", "title": "CreatedAt", "column_name": "created_at", "uidt": "CreatedTime", "system": true},
+			{"id": "UpdatedAt", "title": "UpdatedAt", "column_name": "updated_at", "uidt": "LastModifiedTime", "system": true},
+		},
+	})
+}
+
+// handleCreateRecords serves POST /api/v2/tables/{tableId}/records
+func (f *Fake) handleCreateRecords(w http.ResponseWriter, r *http.Request) {
+	records, isList, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]map[string]any, len(records))
+	for i, record := range records {
+		delete(record, "Id")
+		ids[i] = map[string]any{"Id": f.insert(r.PathValue("tableId"), record)}
+	}
+
+	writeIDs(w, ids, isList)
+}
+
+// handleUpdateRecords serves PATCH /api/v2/tables/{tableId}/records
+func (f *Fake) handleUpdateRecords(w http.ResponseWriter, r *http.Request) {
+	records, isList, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := f.table(r.PathValue("tableId"))
+	ids := make([]map[string]any, len(records))
+	for i, record := range records {
+		index := t.find(fmt.Sprint(record["Id"]))
+		if index < 0 {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Record '%v' not found", record["Id"]))
+			return
+		}
+
+		for k, v := range normalizeRecord(record) {
+			t.records[index][k] = v
+		}
+		t.records[index]["UpdatedAt"] = f.now().UTC().Format(timestampLayout)
+		ids[i] = map[string]any{"Id": t.records[index]["Id"]}
+	}
+
+	writeIDs(w, ids, isList)
+}
+
+// handleDeleteRecords serves DELETE /api/v2/tables/{tableId}/records
+func (f *Fake) handleDeleteRecords(w http.ResponseWriter, r *http.Request) {
+	records, isList, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := f.table(r.PathValue("tableId"))
+	ids := make([]map[string]any, len(records))
+	for i, record := range records {
+		index := t.find(fmt.Sprint(record["Id"]))
+		if index < 0 {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Record '%v' not found", record["Id"]))
+			return
+		}
+
+		ids[i] = map[string]any{"Id": t.records[index]["Id"]}
+		t.records = append(t.records[:index], t.records[index+1:]...)
+	}
+
+	writeIDs(w, ids, isList)
+}
+
+// query applies the "where", "sort", "shuffle" and "fields" query parameters of the request to the
+// given records and returns the resulting copies.
+func (f *Fake) query(records []map[string]any, r *http.Request) ([]map[string]any, error) {
+	params := r.URL.Query()
+
+	filter, err := parseWhere(params.Get("where"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid where parameter: %w", err)
+	}
+
+	result := []map[string]any{}
+	for _, record := range records {
+		ok, err := filter.match(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid where parameter: %w", err)
+		}
+		if ok {
+			result = append(result, record)
+		}
+	}
+
+	if sortParam := params.Get("sort"); sortParam != "" {
+		columns := strings.Split(sortParam, ",")
+		sort.SliceStable(result, func(i, j int) bool {
+			for _, column := range columns {
+				desc := strings.HasPrefix(column, "-")
+				column = strings.TrimPrefix(column, "-")
+
+				c := compareValues(result[i][column], result[j][column])
+				if c == 0 {
+					continue
+				}
+				if desc {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		})
+	}
+
+	if params.Get("shuffle") == "1" {
+		rand.Shuffle(len(result), func(i, j int) {
+			result[i], result[j] = result[j], result[i]
+		})
+	}
+
+	for i, record := range result {
+		result[i] = selectFields(record, params.Get("fields"))
+	}
+
+	return result, nil
+}
+
+// listResponse is the body returned by list endpoints
+type listResponse struct {
+	List     []map[string]any `json:"list"`
+	PageInfo pageInfo         `json:"pageInfo"`
+}
+
+// pageInfo is the pagination information returned by list endpoints
+type pageInfo struct {
+	TotalRows   int  `json:"totalRows"`
+	Page        int  `json:"page"`
+	PageSize    int  `json:"pageSize"`
+	IsFirstPage bool `json:"isFirstPage"`
+	IsLastPage  bool `json:"isLastPage"`
+}
+
+// paginate applies the "limit" and "offset" query parameters of the request to the records.
+func paginate(records []map[string]any, r *http.Request) listResponse {
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit < 1 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	start := min(offset, len(records))
+	end := min(offset+limit, len(records))
+
+	return listResponse{
+		List: records[start:end],
+		PageInfo: pageInfo{
+			TotalRows:   len(records),
+			Page:        offset/limit + 1,
+			PageSize:    limit,
+			IsFirstPage: offset == 0,
+			IsLastPage:  end >= len(records),
+		},
+	}
+}
+
+// selectFields returns a copy of the record containing only the comma separated fields, or all of them if empty.
+func selectFields(record map[string]any, fields string) map[string]any {
+	if fields == "" {
+		return copyRecord(record)
+	}
+
+	selected := map[string]any{}
+	for _, field := range strings.Split(fields, ",") {
+		if value, ok := record[field]; ok {
+			selected[field] = value
+		}
+	}
+
+	return selected
+}
+
+// copyRecord returns a shallow copy of the record.
+func copyRecord(record map[string]any) map[string]any {
+	copied := make(map[string]any, len(record))
+	for k, v := range record {
+		copied[k] = v
+	}
+	return copied
+}
+
+// normalizeRecord returns a copy of the record with the values converted to the types produced
+// by JSON decoding, so stored values behave like the ones returned by the real API.
+func normalizeRecord(record map[string]any) map[string]any {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return copyRecord(record)
+	}
+
+	var normalized map[string]any
+	if err := json.Unmarshal(data, &normalized); err != nil || normalized == nil {
+		return copyRecord(record)
+	}
+
+	return normalized
+}
+
+// decodeBody decodes a request body that can be a single record or a list of records.
+//
+// It returns the records and whether the body was a list.
+func decodeBody(r *http.Request) ([]map[string]any, bool, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		return nil, false, fmt.Errorf("invalid request body: %w", err)
+	}
+
+	trimmed := strings.TrimSpace(string(raw))
+	if strings.HasPrefix(trimmed, "[") {
+		var records []map[string]any
+		if err := json.Unmarshal(raw, &records); err != nil {
+			return nil, false, fmt.Errorf("invalid request body: %w", err)
+		}
+		return records, true, nil
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, false, fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return []map[string]any{record}, false, nil
+}
+
+// writeIDs writes the IDs of the affected records as a list or as a single object.
+func writeIDs(w http.ResponseWriter, ids []map[string]any, isList bool) {
+	if isList || len(ids) != 1 {
+		writeJSON(w, http.StatusOK, ids)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ids[0])
+}
+
+// writeJSON writes the value as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes an error response with the same shape as the NocoDB API errors.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]any{"msg": msg})
+}
+
+// roundTripper is an http.RoundTripper that serves requests with an http.Handler in memory
+type roundTripper struct {
+	handler http.Handler
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (rt roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	recorder := httptest.NewRecorder()
+	rt.handler.ServeHTTP(recorder, req)
+
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package nocodbgotest_test
+
+import (
+	"testing"
+
+	"github.com/eduardolat/nocodbgo"
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func newTestClient(t *testing.T, fake *nocodbgotest.Fake) *nocodbgo.Client {
+	t.Helper()
+
+	client, err := nocodbgo.NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return client
+}
+
+func TestFakeRecords(t *testing.T) {
+	fake := nocodbgotest.New()
+	table := newTestClient(t, fake).Table("users")
+
+	ids, err := table.CreateRecords([]map[string]any{
+		{"Name": "John", "Age": 30},
+		{"Name": "Jane", "Age": 25},
+		{"Name": "Bob", "Age": 40},
+	}).Execute()
+	if err != nil {
+		t.Fatalf("CreateRecords() error = %v", err)
+	}
+	if len(ids) != 3 || ids[0] != nocodbgo.IntID(1) || ids[2] != nocodbgo.IntID(3) {
+		t.Fatalf("CreateRecords() ids = %v, want [1 2 3]", ids)
+	}
+
+	read, err := table.ReadRecord(2).ReturnFields("Name").Execute()
+	if err != nil {
+		t.Fatalf("ReadRecord() error = %v", err)
+	}
+	if read.Data["Name"] != "Jane" || len(read.Data) != 1 {
+		t.Errorf("ReadRecord() data = %v, want only Name=Jane", read.Data)
+	}
+
+	list, err := table.ListRecords().
+		WhereIsGreaterThan("Age", "26").
+		SortDescBy("Age").
+		Limit(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if len(list.List) != 1 || list.List[0]["Name"] != "Bob" {
+		t.Errorf("ListRecords() list = %v, want Bob", list.List)
+	}
+	if list.PageInfo.TotalRows != 2 || list.PageInfo.IsLastPage {
+		t.Errorf("ListRecords() pageInfo = %+v", list.PageInfo)
+	}
+
+	if err := table.UpdateRecord(map[string]any{"Id": 1, "Age": 31}).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	if err := table.DeleteRecord(3).Execute(); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+
+	records := fake.Records("users")
+	if len(records) != 2 {
+		t.Fatalf("Records() len = %d, want 2", len(records))
+	}
+	if records[0]["Age"] != float64(31) {
+		t.Errorf("Records()[0][Age] = %v, want 31", records[0]["Age"])
+	}
+
+	if _, err := table.ReadRecord(3).Execute(); err == nil {
+		t.Error("ReadRecord() of a deleted record error = nil, want error")
+	}
+}
+
+func TestFakeTableSchema(t *testing.T) {
+	fake := nocodbgotest.New()
+
+	schema, err := newTestClient(t, fake).Table("users").ReadSchema().Execute()
+	if err != nil {
+		t.Fatalf("ReadSchema() error = %v", err)
+	}
+
+	primaryKey, ok := schema.PrimaryKey()
+	if !ok || primaryKey.Title != "Id" {
+		t.Errorf("PrimaryKey() = %+v, %v, want the Id column", primaryKey, ok)
+	}
+}
@@ -0,0 +1,466 @@
+package nocodbgotest
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// filterNode is a node of a parsed "where" expression that can be evaluated against a record
+type filterNode interface {
+	match(record map[string]any) (bool, error)
+}
+
+// andNode matches when all of its children match
+type andNode []filterNode
+
+func (n andNode) match(record map[string]any) (bool, error) {
+	for _, child := range n {
+		ok, err := child.match(record)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// orNode matches when any of its children matches
+type orNode []filterNode
+
+func (n orNode) match(record map[string]any) (bool, error) {
+	for _, child := range n {
+		ok, err := child.match(record)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// notNode matches when its child does not match
+type notNode struct {
+	child filterNode
+}
+
+func (n notNode) match(record map[string]any) (bool, error) {
+	ok, err := n.child.match(record)
+	return !ok, err
+}
+
+// conditionNode is a single "(field,operator,value)" comparison
+type conditionNode struct {
+	field    string
+	operator string
+	value    string
+}
+
+// parseWhere parses a NocoDB "where" expression such as "(Age,gt,18)~and((Name,eq,John)~or(Name,eq,Jane))".
+//
+// An empty expression matches every record.
+func parseWhere(where string) (filterNode, error) {
+	if where == "" {
+		return andNode{}, nil
+	}
+
+	p := &whereParser{input: where}
+	node, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos:], p.pos)
+	}
+
+	return node, nil
+}
+
+// whereParser is a recursive descent parser for "where" expressions
+type whereParser struct {
+	input string
+	pos   int
+}
+
+// consume advances the parser past the given token if the remaining input starts with it.
+func (p *whereParser) consume(token string) bool {
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// parseExpression parses terms joined by "~and" and "~or", where "~and" has higher precedence.
+func (p *whereParser) parseExpression() (filterNode, error) {
+	var ors orNode
+	var ands andNode
+
+	for {
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		ands = append(ands, term)
+
+		switch {
+		case p.consume("~and"):
+		case p.consume("~or"):
+			ors = append(ors, ands)
+			ands = nil
+		default:
+			ors = append(ors, ands)
+			return ors, nil
+		}
+	}
+}
+
+// parseTerm parses a negated term, a parenthesized group or a single condition.
+func (p *whereParser) parseTerm() (filterNode, error) {
+	if p.consume("~not") {
+		child, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child: child}, nil
+	}
+
+	if !p.consume("(") {
+		return nil, fmt.Errorf("expected \"(\" at position %d", p.pos)
+	}
+
+	rest := p.input[p.pos:]
+	if strings.HasPrefix(rest, "(") || strings.HasPrefix(rest, "~not") {
+		node, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, fmt.Errorf("expected \")\" at position %d", p.pos)
+		}
+		return node, nil
+	}
+
+	start := p.pos
+	depth := 0
+	for ; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth < 0 {
+			break
+		}
+	}
+
+	if p.pos >= len(p.input) {
+		return nil, fmt.Errorf("unclosed condition at position %d", start)
+	}
+
+	raw := p.input[start:p.pos]
+	p.pos++
+
+	parts := strings.SplitN(raw, ",", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, fmt.Errorf("invalid condition %q", raw)
+	}
+
+	condition := conditionNode{field: parts[0], operator: parts[1]}
+	if len(parts) == 3 {
+		condition.value = parts[2]
+	}
+
+	return condition, nil
+}
+
+// match evaluates the condition against the given record.
+func (c conditionNode) match(record map[string]any) (bool, error) {
+	value := record[c.field]
+
+	switch c.operator {
+	case "eq":
+		result, ok, err := compareWith(value, c.value)
+		return ok && result == 0, err
+	case "neq", "not":
+		result, ok, err := compareWith(value, c.value)
+		return !ok || result != 0, err
+	case "gt":
+		result, ok, err := compareWith(value, c.value)
+		return ok && result > 0, err
+	case "ge", "gte":
+		result, ok, err := compareWith(value, c.value)
+		return ok && result >= 0, err
+	case "lt":
+		result, ok, err := compareWith(value, c.value)
+		return ok && result < 0, err
+	case "le", "lte":
+		result, ok, err := compareWith(value, c.value)
+		return ok && result <= 0, err
+	case "is":
+		return matchIs(value, c.value)
+	case "isnot":
+		ok, err := matchIs(value, c.value)
+		return !ok, err
+	case "blank":
+		return isBlank(value), nil
+	case "notblank":
+		return !isBlank(value), nil
+	case "checked":
+		return isTruthy(value), nil
+	case "notchecked":
+		return !isTruthy(value), nil
+	case "like":
+		return matchLike(value, c.value), nil
+	case "nlike":
+		return !matchLike(value, c.value), nil
+	case "in":
+		for _, item := range strings.Split(c.value, ",") {
+			if result, ok, _ := compareWith(value, item); ok && result == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "btw", "nbtw":
+		bounds := strings.Split(c.value, ",")
+		if len(bounds) != 2 {
+			return false, fmt.Errorf("operator %q requires two values", c.operator)
+		}
+		low, lowOk, err := compareWith(value, bounds[0])
+		if err != nil {
+			return false, err
+		}
+		high, highOk, err := compareWith(value, bounds[1])
+		if err != nil {
+			return false, err
+		}
+		between := lowOk && highOk && low >= 0 && high <= 0
+		return between == (c.operator == "btw"), nil
+	case "allof", "anyof", "nallof", "nanyof":
+		options := toOptions(value)
+		expected := strings.Split(c.value, ",")
+		found := 0
+		for _, item := range expected {
+			if _, ok := options[item]; ok {
+				found++
+			}
+		}
+		switch c.operator {
+		case "allof":
+			return found == len(expected), nil
+		case "anyof":
+			return found > 0, nil
+		case "nallof":
+			return found != len(expected), nil
+		default:
+			return found == 0, nil
+		}
+	}
+
+	return false, fmt.Errorf("unsupported operator %q", c.operator)
+}
+
+// matchIs evaluates the "is" operator with its sub-operations.
+func matchIs(value any, subOperation string) (bool, error) {
+	switch subOperation {
+	case "null":
+		return value == nil, nil
+	case "notnull":
+		return value != nil, nil
+	case "empty":
+		return value == "", nil
+	case "notempty":
+		return value != "", nil
+	case "blank":
+		return isBlank(value), nil
+	case "notblank":
+		return !isBlank(value), nil
+	case "true", "checked":
+		return isTruthy(value), nil
+	case "false", "notchecked":
+		return !isTruthy(value), nil
+	}
+
+	return false, fmt.Errorf("unsupported sub-operation %q for operator \"is\"", subOperation)
+}
+
+// matchLike evaluates a LIKE pattern where "%" matches any sequence of characters and "_" any
+// single character, and a backslash escapes the next character. Patterns without wildcards match
+// values containing them, like NocoDB does.
+func matchLike(value any, pattern string) bool {
+	if value == nil {
+		return false
+	}
+
+	if !strings.ContainsAny(pattern, "%_") {
+		pattern = "%" + pattern + "%"
+	}
+
+	var expr strings.Builder
+	expr.WriteString("(?is)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			expr.WriteString(".*")
+		case r == '_':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	return regexp.MustCompile(expr.String()).MatchString(fmt.Sprint(value))
+}
+
+// isBlank reports whether the value is null, an empty string or an empty list.
+func isBlank(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// isTruthy reports whether the value represents a checked checkbox.
+func isTruthy(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		b, err := strconv.ParseBool(v)
+		return err == nil && b
+	}
+	return false
+}
+
+// toOptions converts a multi-select value (a comma separated string or a list) into a set of options.
+func toOptions(value any) map[string]struct{} {
+	options := map[string]struct{}{}
+
+	switch v := value.(type) {
+	case string:
+		for _, option := range strings.Split(v, ",") {
+			if option != "" {
+				options[option] = struct{}{}
+			}
+		}
+	case []any:
+		for _, option := range v {
+			options[fmt.Sprint(option)] = struct{}{}
+		}
+	}
+
+	return options
+}
+
+// dateSubOperations are the comparison sub-operations for Date/DateTime columns supported by the fake
+var dateSubOperations = []string{"exactDate,"}
+
+// compareWith compares a record value against a filter argument.
+//
+// It returns the comparison result, whether both values were comparable and an error if the
+// argument uses an unsupported date sub-operation.
+func compareWith(value any, arg string) (int, bool, error) {
+	for _, subOperation := range dateSubOperations {
+		arg = strings.TrimPrefix(arg, subOperation)
+	}
+
+	if value == nil {
+		return 0, false, nil
+	}
+
+	switch v := value.(type) {
+	case float64:
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return 0, false, nil
+		}
+		return cmp.Compare(v, n), true, nil
+	case bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return 0, false, nil
+		}
+		if v == b {
+			return 0, true, nil
+		}
+		if v {
+			return 1, true, nil
+		}
+		return -1, true, nil
+	}
+
+	return compareStrings(fmt.Sprint(value), arg), true, nil
+}
+
+// compareValues compares two record values, used for sorting. Null values are sorted first.
+func compareValues(a any, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	af, aIsNumber := a.(float64)
+	bf, bIsNumber := b.(float64)
+	if aIsNumber && bIsNumber {
+		return cmp.Compare(af, bf)
+	}
+
+	return compareStrings(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// compareStrings compares two strings as dates or numbers when both can be parsed as such, and
+// lexicographically otherwise.
+func compareStrings(a string, b string) int {
+	if at, ok := parseTime(a); ok {
+		if bt, ok := parseTime(b); ok {
+			return at.Compare(bt)
+		}
+	}
+
+	af, aErr := strconv.ParseFloat(a, 64)
+	bf, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return cmp.Compare(af, bf)
+	}
+
+	return strings.Compare(a, b)
+}
+
+// timeLayouts are the date and time formats recognized when comparing values
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseTime parses a date or date-time value using the layouts used by NocoDB.
+func parseTime(value string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,67 @@
+package nocodbgotest
+
+import (
+	"testing"
+)
+
+func TestParseWhere(t *testing.T) {
+	record := map[string]any{
+		"Id":        float64(1),
+		"Name":      "John Doe",
+		"Age":       float64(30),
+		"Active":    true,
+		"Tags":      "a,b,c",
+		"Notes":     nil,
+		"UpdatedAt": "2024-03-27 10:06:46+00:00",
+	}
+
+	tests := []struct {
+		where   string
+		want    bool
+		wantErr bool
+	}{
+		{where: "", want: true},
+		{where: "(Name,eq,John Doe)", want: true},
+		{where: "(Name,neq,John Doe)", want: false},
+		{where: "(Age,gt,18)", want: true},
+		{where: "(Age,ge,30)~and(Age,le,30)", want: true},
+		{where: "(Age,lt,18)~or(Name,like,John%)", want: true},
+		{where: "(Age,lt,18)~or(Name,like,%Smith)", want: false},
+		{where: "(Name,like,doe)", want: true},
+		{where: "(Name,nlike,Jane%)", want: true},
+		{where: `(Name,like,%John\_Doe%)`, want: false},
+		{where: `(Name,like,%Jo\hn%)`, want: true},
+		{where: "(Age,in,10,20,30)", want: true},
+		{where: "(Age,btw,20,40)", want: true},
+		{where: "(Age,nbtw,20,40)", want: false},
+		{where: "(Notes,is,null)", want: true},
+		{where: "(Notes,isnot,null)", want: false},
+		{where: "(Active,is,true)", want: true},
+		{where: "(Tags,allof,a,c)", want: true},
+		{where: "(Tags,anyof,x,b)", want: true},
+		{where: "(Tags,nanyof,x,y)", want: true},
+		{where: "(UpdatedAt,gt,exactDate,2024-01-01)", want: true},
+		{where: "~not(Age,gt,18)", want: false},
+		{where: "(Age,gt,40)~or((Name,eq,John Doe)~and(Age,eq,30))", want: true},
+		{where: "(Age,gt,18)~and((Name,eq,Jane)~or(Name,eq,Bob))", want: false},
+		{where: "(Age,foo,18)", wantErr: true},
+		{where: "(Age,gt,18", wantErr: true},
+		{where: "Age,gt,18", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.where, func(t *testing.T) {
+			node, err := parseWhere(tt.where)
+			if err == nil {
+				var got bool
+				got, err = node.match(record)
+				if err == nil && got != tt.want {
+					t.Errorf("match() = %v, want %v", got, tt.want)
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
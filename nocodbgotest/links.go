@@ -0,0 +1,154 @@
+package nocodbgotest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// linkKey identifies a link field of a table
+type linkKey struct {
+	tableID     string
+	linkFieldID string
+}
+
+// fakeLink holds the links of a link field, indexed by local record ID
+type fakeLink struct {
+	targetTableID string
+	targets       map[string][]string
+}
+
+// DefineLink declares a link field on a table whose linked records live in the target table.
+//
+// Link fields must be defined before using the links endpoints with them.
+func (f *Fake) DefineLink(tableID string, linkFieldID string, targetTableID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := linkKey{tableID: tableID, linkFieldID: linkFieldID}
+	if _, ok := f.links[key]; !ok {
+		f.links[key] = &fakeLink{targetTableID: targetTableID, targets: map[string][]string{}}
+	}
+}
+
+// LinkedIDs returns the IDs of the target records linked to the record through the link field.
+func (f *Fake) LinkedIDs(tableID string, linkFieldID string, recordID int64) []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	link, ok := f.links[linkKey{tableID: tableID, linkFieldID: linkFieldID}]
+	if !ok {
+		return nil
+	}
+
+	ids := []int64{}
+	for _, targetID := range link.targets[fmt.Sprint(recordID)] {
+		var id int64
+		if _, err := fmt.Sscan(targetID, &id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// link returns the link field targeted by the request after checking that both the link field and
+// the local record exist, writing an error response otherwise. The caller must hold the lock.
+func (f *Fake) link(w http.ResponseWriter, r *http.Request) (*fakeLink, string, bool) {
+	link, ok := f.links[linkKey{tableID: r.PathValue("tableId"), linkFieldID: r.PathValue("linkFieldId")}]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Field '%s' not found", r.PathValue("linkFieldId")))
+		return nil, "", false
+	}
+
+	recordID := r.PathValue("recordId")
+	if f.table(r.PathValue("tableId")).find(recordID) < 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Record '%s' not found", recordID))
+		return nil, "", false
+	}
+
+	return link, recordID, true
+}
+
+// handleListLinks serves GET /api/v2/tables/{tableId}/links/{linkFieldId}/records/{recordId}
+func (f *Fake) handleListLinks(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	link, recordID, ok := f.link(w, r)
+	if !ok {
+		return
+	}
+
+	linked := []map[string]any{}
+	for _, record := range f.table(link.targetTableID).records {
+		if slices.Contains(link.targets[recordID], fmt.Sprint(record["Id"])) {
+			linked = append(linked, record)
+		}
+	}
+
+	records, err := f.query(linked, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, paginate(records, r))
+}
+
+// handleCreateLinks serves POST /api/v2/tables/{tableId}/links/{linkFieldId}/records/{recordId}
+func (f *Fake) handleCreateLinks(w http.ResponseWriter, r *http.Request) {
+	records, _, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	link, recordID, ok := f.link(w, r)
+	if !ok {
+		return
+	}
+
+	target := f.table(link.targetTableID)
+	for _, record := range records {
+		targetID := fmt.Sprint(record["Id"])
+		if target.find(targetID) < 0 {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Record '%s' not found", targetID))
+			return
+		}
+		if !slices.Contains(link.targets[recordID], targetID) {
+			link.targets[recordID] = append(link.targets[recordID], targetID)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, true)
+}
+
+// handleDeleteLinks serves DELETE /api/v2/tables/{tableId}/links/{linkFieldId}/records/{recordId}
+func (f *Fake) handleDeleteLinks(w http.ResponseWriter, r *http.Request) {
+	records, _, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	link, recordID, ok := f.link(w, r)
+	if !ok {
+		return
+	}
+
+	for _, record := range records {
+		targetID := fmt.Sprint(record["Id"])
+		link.targets[recordID] = slices.DeleteFunc(link.targets[recordID], func(id string) bool {
+			return id == targetID
+		})
+	}
+
+	writeJSON(w, http.StatusOK, true)
+}
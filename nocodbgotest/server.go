@@ -0,0 +1,44 @@
+package nocodbgotest
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// Server is a Fake served by a local HTTP server, useful for integration tests that need a real
+// network round trip or for code that builds its own HTTP client.
+//
+// It must be closed with Close when no longer needed.
+type Server struct {
+	*Fake
+	*httptest.Server
+}
+
+// NewServer creates a new Fake and starts serving it on a local HTTP server.
+//
+// Example:
+//
+//	server := nocodbgotest.NewServer()
+//	defer server.Close()
+//
+//	client, err := nocodbgo.NewClient().
+//		WithBaseURL(server.BaseURL()).
+//		WithAPIToken("test-token").
+//		Create()
+func NewServer() *Server {
+	fake := New()
+	return &Server{
+		Fake:   fake,
+		Server: httptest.NewServer(fake),
+	}
+}
+
+// BaseURL returns the base URL of the local HTTP server.
+func (s *Server) BaseURL() string {
+	return s.Server.URL
+}
+
+// HTTPClient returns an HTTP client configured to talk to the local HTTP server.
+func (s *Server) HTTPClient() *http.Client {
+	return s.Server.Client()
+}
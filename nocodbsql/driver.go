@@ -0,0 +1,431 @@
+// Package nocodbsql provides an experimental, read-only database/sql driver for NocoDB, so
+// reporting tools that only speak database/sql can read NocoDB tables.
+//
+// The driver translates simple SELECT statements into list records requests of the nocodbgo SDK.
+// The table of the FROM clause is the table ID, and the supported clauses are:
+//
+//   - The list of columns or *
+//   - WHERE with comparisons joined by AND: =, !=, <>, <, <=, >, >=, [NOT] LIKE, IS [NOT] NULL,
+//     IN and [NOT] BETWEEN
+//   - ORDER BY with ASC and DESC
+//   - LIMIT and OFFSET
+//
+// Values can be literals or ? placeholders. Anything else, including joins, aggregates and OR
+// conditions, returns ErrUnsupportedQuery, and every statement that isn't a query returns
+// ErrReadOnly.
+//
+// Example:
+//
+//	import _ "github.com/eduardolat/nocodbgo/nocodbsql"
+//
+//	db, err := sql.Open("nocodb", "https://app.nocodb.com?token=your-api-token")
+//	// Handle error
+//	rows, err := db.Query("SELECT Name, Age FROM m_xxxxxxxxxxxxxx WHERE Age > ? ORDER BY Age DESC LIMIT 10", 18)
+//
+// With a client already configured, use NewConnector and sql.OpenDB instead.
+package nocodbsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+var (
+	// ErrUnsupportedQuery is returned when a statement can't be translated into a list records request
+	ErrUnsupportedQuery = errors.New("unsupported query")
+
+	// ErrReadOnly is returned when executing a statement that modifies data or starting a transaction
+	ErrReadOnly = errors.New("the nocodb driver is read-only")
+)
+
+func init() {
+	sql.Register("nocodb", &Driver{})
+}
+
+// Driver is the database/sql driver registered as "nocodb".
+//
+// The data source name is the base URL of the NocoDB server with the API token in the "token"
+// query parameter. The optional "api" and "base" query parameters set the version of the data API
+// and the base of the tables, like the WithAPIVersion and WithDefaultBaseID client options.
+//
+// Example:
+//
+//	db, err := sql.Open("nocodb", "https://app.nocodb.com?token=your-api-token&api=v3&base=p_xxxxxxxxxxxxxx")
+type Driver struct{}
+
+// Open implements the driver.Driver interface for Driver.
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	connector, err := d.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(context.Background())
+}
+
+// OpenConnector implements the driver.DriverContext interface for Driver.
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse data source name: %w", err)
+	}
+
+	query := parsed.Query()
+	token := query.Get("token")
+	apiVersion := query.Get("api")
+	baseID := query.Get("base")
+	query.Del("token")
+	query.Del("api")
+	query.Del("base")
+	parsed.RawQuery = query.Encode()
+
+	client, err := nocodbgo.NewClient().
+		WithBaseURL(parsed.String()).
+		WithAPIToken(token).
+		WithAPIVersion(nocodbgo.APIVersion(apiVersion)).
+		WithDefaultBaseID(baseID).
+		Create()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return NewConnector(client), nil
+}
+
+// connector opens connections that share a client
+type connector struct {
+	client *nocodbgo.Client
+}
+
+// NewConnector returns a connector that reads the tables through the given client, to be used
+// with sql.OpenDB.
+//
+// Example:
+//
+//	db := sql.OpenDB(nocodbsql.NewConnector(client))
+func NewConnector(client *nocodbgo.Client) driver.Connector {
+	return &connector{client: client}
+}
+
+// Connect implements the driver.Connector interface for connector.
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{client: c.client}, nil
+}
+
+// Driver implements the driver.Connector interface for connector.
+func (c *connector) Driver() driver.Driver {
+	return &Driver{}
+}
+
+// conn is a connection of the driver. It holds no state besides the client, the requests are
+// made by the queries.
+type conn struct {
+	client *nocodbgo.Client
+}
+
+// Prepare implements the driver.Conn interface for conn.
+func (c *conn) Prepare(statement string) (driver.Stmt, error) {
+	query, err := parseSelect(statement)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{conn: c, query: query}, nil
+}
+
+// Close implements the driver.Conn interface for conn.
+func (c *conn) Close() error {
+	return nil
+}
+
+// Begin implements the driver.Conn interface for conn.
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, ErrReadOnly
+}
+
+// ExecContext implements the driver.ExecerContext interface for conn.
+func (c *conn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return nil, ErrReadOnly
+}
+
+// QueryContext implements the driver.QueryerContext interface for conn.
+func (c *conn) QueryContext(ctx context.Context, statement string, args []driver.NamedValue) (driver.Rows, error) {
+	query, err := parseSelect(statement)
+	if err != nil {
+		return nil, err
+	}
+	return c.query(ctx, query, args)
+}
+
+// query translates the parsed statement into a list records request.
+func (c *conn) query(ctx context.Context, query *selectQuery, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) != query.params {
+		return nil, fmt.Errorf("expected %d arguments, got %d", query.params, len(args))
+	}
+
+	resolve := func(value operand) any {
+		if value.param >= 0 {
+			return args[value.param].Value
+		}
+		return value.literal
+	}
+
+	list := c.client.Table(query.table).ListRecords().WithContext(ctx)
+	if len(query.columns) > 0 {
+		list = list.ReturnFields(query.columns...)
+	}
+
+	for _, filter := range query.filters {
+		values := make([]string, len(filter.values))
+		for i, value := range filter.values {
+			formatted, err := formatValue(resolve(value))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s %s: %w", ErrUnsupportedQuery, filter.column, filter.operator, err)
+			}
+			values[i] = formatted
+		}
+
+		switch filter.operator {
+		case "=":
+			list = list.WhereIsEqualTo(filter.column, values[0])
+		case "!=":
+			list = list.WhereIsNotEqualTo(filter.column, values[0])
+		case "<":
+			list = list.WhereIsLessThan(filter.column, values[0])
+		case "<=":
+			list = list.WhereIsLessThanOrEqual(filter.column, values[0])
+		case ">":
+			list = list.WhereIsGreaterThan(filter.column, values[0])
+		case ">=":
+			list = list.WhereIsGreaterThanOrEqual(filter.column, values[0])
+		case "like":
+			list = list.WhereIsLike(filter.column, values[0])
+		case "not like":
+			list = list.WhereIsNotLike(filter.column, values[0])
+		case "is null":
+			list = list.WhereIsNull(filter.column)
+		case "is not null":
+			list = list.WhereIsNotNull(filter.column)
+		case "in":
+			list = list.WhereIsIn(filter.column, values...)
+		case "between":
+			list = list.WhereIsBetween(filter.column, values[0], values[1])
+		case "not between":
+			list = list.WhereIsNotBetween(filter.column, values[0], values[1])
+		default:
+			return nil, fmt.Errorf("%w: operator %s", ErrUnsupportedQuery, filter.operator)
+		}
+	}
+
+	for _, sort := range query.sorts {
+		if sort.descending {
+			list = list.SortDescBy(sort.column)
+		} else {
+			list = list.SortAscBy(sort.column)
+		}
+	}
+
+	limit := -1
+	if query.limit != nil {
+		n, err := intValue(resolve(*query.limit))
+		if err != nil {
+			return nil, fmt.Errorf("%w: LIMIT: %w", ErrUnsupportedQuery, err)
+		}
+		if n == 0 {
+			return &rows{columns: query.columns}, nil
+		}
+		limit = n
+		list = list.Limit(n)
+	}
+	if query.offset != nil {
+		n, err := intValue(resolve(*query.offset))
+		if err != nil {
+			return nil, fmt.Errorf("%w: OFFSET: %w", ErrUnsupportedQuery, err)
+		}
+		list = list.Offset(n)
+	}
+
+	it := list.Iterate()
+
+	// The columns of SELECT * are only known once the first record arrives
+	columns := query.columns
+	var first map[string]any
+	if it.Next() {
+		first = it.Record()
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 && first != nil {
+		for column := range first {
+			columns = append(columns, column)
+		}
+		slices.Sort(columns)
+	}
+
+	return &rows{columns: columns, it: it, first: first, remaining: limit}, nil
+}
+
+// stmt is a prepared statement of the driver
+type stmt struct {
+	conn  *conn
+	query *selectQuery
+}
+
+// Close implements the driver.Stmt interface for stmt.
+func (s *stmt) Close() error {
+	return nil
+}
+
+// NumInput implements the driver.Stmt interface for stmt.
+func (s *stmt) NumInput() int {
+	return s.query.params
+}
+
+// Exec implements the driver.Stmt interface for stmt.
+func (s *stmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, ErrReadOnly
+}
+
+// Query implements the driver.Stmt interface for stmt.
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return s.conn.query(context.Background(), s.query, named)
+}
+
+// QueryContext implements the driver.StmtQueryContext interface for stmt.
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.query(ctx, s.query, args)
+}
+
+// rows iterates over the records of a query, fetching them page by page
+type rows struct {
+	columns   []string
+	it        *nocodbgo.RecordIterator
+	first     map[string]any // First record, already read to know the columns
+	remaining int            // Records left until the LIMIT, -1 without LIMIT
+}
+
+// Columns implements the driver.Rows interface for rows.
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+// Close implements the driver.Rows interface for rows.
+func (r *rows) Close() error {
+	r.it = nil
+	r.first = nil
+	return nil
+}
+
+// Next implements the driver.Rows interface for rows.
+func (r *rows) Next(dest []driver.Value) error {
+	if r.it == nil || r.remaining == 0 {
+		return io.EOF
+	}
+
+	record := r.first
+	r.first = nil
+	if record == nil {
+		if !r.it.Next() {
+			if err := r.it.Err(); err != nil {
+				return err
+			}
+			return io.EOF
+		}
+		record = r.it.Record()
+	}
+
+	for i, column := range r.columns {
+		value, err := driverValue(record[column])
+		if err != nil {
+			return fmt.Errorf("failed to convert column %s: %w", column, err)
+		}
+		dest[i] = value
+	}
+
+	if r.remaining > 0 {
+		r.remaining--
+	}
+	return nil
+}
+
+// formatValue formats a value of the statement for a filter. Times are formatted in UTC, and
+// values with the characters that delimit the NocoDB filter syntax are rejected, because NocoDB
+// has no way to escape them and they would change the filter.
+func formatValue(value any) (string, error) {
+	formatted, err := formatScalar(value)
+	if err != nil {
+		return "", err
+	}
+	if strings.ContainsAny(formatted, "(),~") {
+		return "", fmt.Errorf("value %q contains characters of the filter syntax", formatted)
+	}
+	return formatted, nil
+}
+
+// formatScalar formats a value of the statement as a string.
+func formatScalar(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Time:
+		return v.UTC().Format(time.DateTime), nil
+	case nil:
+		return "", fmt.Errorf("NULL values must be compared with IS NULL")
+	}
+	return "", fmt.Errorf("unsupported value type %T", value)
+}
+
+// intValue converts a LIMIT or OFFSET value to an integer.
+func intValue(value any) (int, error) {
+	switch v := value.(type) {
+	case int64:
+		return int(v), nil
+	case string:
+		return strconv.Atoi(v)
+	}
+	return 0, fmt.Errorf("expected an integer, got %v", value)
+}
+
+// driverValue converts a value of a record to a driver.Value. Integral numbers are returned as
+// int64, and objects and lists as their JSON encoding.
+func driverValue(value any) (driver.Value, error) {
+	switch v := value.(type) {
+	case nil, string, bool:
+		return v, nil
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v), nil
+		}
+		return v, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
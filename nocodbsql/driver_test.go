@@ -0,0 +1,134 @@
+package nocodbsql
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eduardolat/nocodbgo"
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestParseSelect(t *testing.T) {
+	query, err := parseSelect(`SELECT Name, "Full Age" FROM users WHERE Age >= ? AND Name LIKE 'A%' AND Team IS NOT NULL AND Id IN (1, 2) ORDER BY Age DESC, Name LIMIT 10 OFFSET ?;`)
+	if err != nil {
+		t.Fatalf("parseSelect() error = %v", err)
+	}
+
+	want := &selectQuery{
+		table:   "users",
+		columns: []string{"Name", "Full Age"},
+		filters: []condition{
+			{column: "Age", operator: ">=", values: []operand{{param: 0}}},
+			{column: "Name", operator: "like", values: []operand{{literal: "A%", param: -1}}},
+			{column: "Team", operator: "is not null"},
+			{column: "Id", operator: "in", values: []operand{{literal: int64(1), param: -1}, {literal: int64(2), param: -1}}},
+		},
+		sorts:  []sortColumn{{column: "Age", descending: true}, {column: "Name"}},
+		limit:  &operand{literal: int64(10), param: -1},
+		offset: &operand{param: 1},
+		params: 2,
+	}
+	if !reflect.DeepEqual(query, want) {
+		t.Errorf("parseSelect() =\n%+v\nwant\n%+v", query, want)
+	}
+
+	for _, statement := range []string{
+		"DELETE FROM users",
+		"SELECT COUNT(*) FROM users",
+		"SELECT * FROM users WHERE Age > 1 OR Age < 0",
+		"SELECT * FROM users JOIN teams",
+		"SELECT * FROM users WHERE Name = 'unterminated",
+	} {
+		if _, err := parseSelect(statement); !errors.Is(err, ErrUnsupportedQuery) {
+			t.Errorf("parseSelect(%q) error = %v, want %v", statement, err, ErrUnsupportedQuery)
+		}
+	}
+}
+
+func TestDriver(t *testing.T) {
+	server := nocodbgotest.NewServer()
+	defer server.Close()
+	server.Seed("users",
+		map[string]any{"Name": "Ana", "Age": 30},
+		map[string]any{"Name": "Bob", "Age": 17},
+		map[string]any{"Name": "Cid", "Age": 40, "Tags": []any{"admin"}},
+	)
+
+	client, err := nocodbgo.NewClient().WithBaseURL(server.BaseURL()).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	db := sql.OpenDB(NewConnector(client))
+	defer db.Close()
+
+	rows, err := db.Query("SELECT Name, Age FROM users WHERE Age > ? ORDER BY Age DESC LIMIT 1", 18)
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		var age int
+		if err := rows.Scan(&name, &age); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil || !reflect.DeepEqual(names, []string{"Cid"}) {
+		t.Errorf("Query() names = %v, %v, want [Cid]", names, err)
+	}
+
+	rows, err = db.Query("SELECT * FROM users WHERE Name = 'Cid'")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	defer rows.Close()
+	columns, _ := rows.Columns()
+	if !reflect.DeepEqual(columns, []string{"Age", "CreatedAt", "Id", "Name", "Tags", "UpdatedAt"}) {
+		t.Errorf("Columns() = %v", columns)
+	}
+	if !rows.Next() {
+		t.Fatalf("Next() = false, err = %v", rows.Err())
+	}
+	var age, id int
+	var name, tags string
+	var createdAt, updatedAt any
+	if err := rows.Scan(&age, &createdAt, &id, &name, &tags, &updatedAt); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if name != "Cid" || age != 40 || tags != `["admin"]` {
+		t.Errorf("Scan() = %v %v %v %v", age, id, name, tags)
+	}
+
+	// Values that would change the filter are rejected instead of pasted into it
+	if _, err := db.Query("SELECT * FROM users WHERE Name = ?", "x)~or(Id,gt,0"); !errors.Is(err, ErrUnsupportedQuery) {
+		t.Errorf("Query() with a filter in the value error = %v, want %v", err, ErrUnsupportedQuery)
+	}
+	if _, err := db.Query("SELECT * FROM users WHERE Name IN (?, 'Bob')", "Ana,Cid"); !errors.Is(err, ErrUnsupportedQuery) {
+		t.Errorf("Query() with a comma in the value error = %v, want %v", err, ErrUnsupportedQuery)
+	}
+
+	if _, err := db.Exec("DELETE FROM users"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Exec() error = %v, want %v", err, ErrReadOnly)
+	}
+	if _, err := db.Begin(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Begin() error = %v, want %v", err, ErrReadOnly)
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	madrid := time.FixedZone("CEST", 2*60*60)
+	value, err := formatValue(time.Date(2024, 6, 1, 12, 30, 0, 0, madrid))
+	if err != nil || value != "2024-06-01 10:30:00" {
+		t.Errorf("formatValue() = %q, %v, want the time in UTC", value, err)
+	}
+
+	for _, value := range []string{"(", ")", "a~b", "a,b"} {
+		if _, err := formatValue(value); err == nil {
+			t.Errorf("formatValue(%q) error = nil, want an error", value)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package nocodbsql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// selectQuery is a parsed SELECT statement
+type selectQuery struct {
+	table   string
+	columns []string // Empty for SELECT *
+	filters []condition
+	sorts   []sortColumn
+	limit   *operand
+	offset  *operand
+	params  int // Number of ? placeholders
+}
+
+// condition is a comparison of the WHERE clause
+type condition struct {
+	column   string
+	operator string // One of =, !=, <, <=, >, >=, like, not like, is null, is not null, in, not in, between, not between
+	values   []operand
+}
+
+// sortColumn is a column of the ORDER BY clause
+type sortColumn struct {
+	column     string
+	descending bool
+}
+
+// operand is a literal value or a ? placeholder of the statement
+type operand struct {
+	literal any // string, int64, float64, bool or nil
+	param   int // Index of the placeholder, -1 for literals
+}
+
+// tokenKind is the kind of a token of a statement
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenQuotedIdent
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+// token is a lexical token of a statement
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// tokenize splits a statement into tokens.
+func tokenize(statement string) ([]token, error) {
+	var tokens []token
+	runes := []rune(statement)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'':
+			var value strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated string literal")
+				}
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						value.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value.String()})
+		case r == '"' || r == '`':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated quoted identifier")
+			}
+			tokens = append(tokens, token{kind: tokenQuotedIdent, value: string(runes[i+1 : end])})
+			i = end + 1
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			end := i + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(runes[i:end])})
+			i = end
+		case unicode.IsLetter(r) || r == '_':
+			end := i + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, value: string(runes[i:end])})
+			i = end
+		default:
+			symbol := string(r)
+			if i+1 < len(runes) {
+				switch two := string(runes[i : i+2]); two {
+				case "!=", "<>", "<=", ">=":
+					symbol = two
+				}
+			}
+			if !strings.Contains("*,()=<>!?;", string(r)) {
+				return nil, fmt.Errorf("unexpected character %q", r)
+			}
+			tokens = append(tokens, token{kind: tokenSymbol, value: symbol})
+			i += len([]rune(symbol))
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+// parser parses the tokens of a statement
+type parser struct {
+	tokens []token
+	pos    int
+	params int
+}
+
+// parseSelect parses a SELECT statement supported by the driver.
+func parseSelect(statement string) (*selectQuery, error) {
+	tokens, err := tokenize(statement)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedQuery, err)
+	}
+
+	p := &parser{tokens: tokens}
+	query, err := p.parseSelect()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedQuery, err)
+	}
+	query.params = p.params
+
+	return query, nil
+}
+
+// peek returns the current token.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next returns the current token and advances to the next one.
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// isKeyword reports if the current token is the given keyword.
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && strings.EqualFold(t.value, keyword)
+}
+
+// acceptKeyword advances past the current token if it's the given keyword.
+func (p *parser) acceptKeyword(keyword string) bool {
+	if p.isKeyword(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expectKeyword advances past the given keyword, or returns an error if it's not the current token.
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.acceptKeyword(keyword) {
+		return fmt.Errorf("expected %s, got %q", keyword, p.peek().value)
+	}
+	return nil
+}
+
+// acceptSymbol advances past the current token if it's the given symbol.
+func (p *parser) acceptSymbol(symbol string) bool {
+	if t := p.peek(); t.kind == tokenSymbol && t.value == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// parseIdent parses a bare or quoted identifier.
+func (p *parser) parseIdent() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdent && t.kind != tokenQuotedIdent {
+		return "", fmt.Errorf("expected an identifier, got %q", t.value)
+	}
+	return t.value, nil
+}
+
+// parseSelect parses the whole statement.
+func (p *parser) parseSelect() (*selectQuery, error) {
+	query := &selectQuery{}
+
+	if err := p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+
+	if !p.acceptSymbol("*") {
+		for {
+			column, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			query.columns = append(query.columns, column)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+	}
+
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+	table, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+	query.table = table
+
+	if p.acceptKeyword("WHERE") {
+		for {
+			filter, err := p.parseCondition()
+			if err != nil {
+				return nil, err
+			}
+			query.filters = append(query.filters, filter)
+			if p.isKeyword("OR") {
+				return nil, fmt.Errorf("OR conditions are not supported")
+			}
+			if !p.acceptKeyword("AND") {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("ORDER") {
+		if err := p.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			column, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			sort := sortColumn{column: column}
+			if p.acceptKeyword("DESC") {
+				sort.descending = true
+			} else {
+				p.acceptKeyword("ASC")
+			}
+			query.sorts = append(query.sorts, sort)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("LIMIT") {
+		limit, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		query.limit = &limit
+	}
+
+	if p.acceptKeyword("OFFSET") {
+		offset, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		query.offset = &offset
+	}
+
+	p.acceptSymbol(";")
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q", t.value)
+	}
+
+	return query, nil
+}
+
+// parseCondition parses a comparison of the WHERE clause.
+func (p *parser) parseCondition() (condition, error) {
+	column, err := p.parseIdent()
+	if err != nil {
+		return condition{}, err
+	}
+	filter := condition{column: column}
+
+	if p.acceptKeyword("IS") {
+		filter.operator = "is null"
+		if p.acceptKeyword("NOT") {
+			filter.operator = "is not null"
+		}
+		return filter, p.expectKeyword("NULL")
+	}
+
+	negated := p.acceptKeyword("NOT")
+	switch {
+	case p.acceptKeyword("LIKE"):
+		filter.operator = "like"
+		value, err := p.parseOperand()
+		if err != nil {
+			return condition{}, err
+		}
+		filter.values = []operand{value}
+	case p.acceptKeyword("IN"):
+		filter.operator = "in"
+		if !p.acceptSymbol("(") {
+			return condition{}, fmt.Errorf("expected ( after IN")
+		}
+		for {
+			value, err := p.parseOperand()
+			if err != nil {
+				return condition{}, err
+			}
+			filter.values = append(filter.values, value)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+		if !p.acceptSymbol(")") {
+			return condition{}, fmt.Errorf("expected ) after the IN values")
+		}
+	case p.acceptKeyword("BETWEEN"):
+		filter.operator = "between"
+		min, err := p.parseOperand()
+		if err != nil {
+			return condition{}, err
+		}
+		if err := p.expectKeyword("AND"); err != nil {
+			return condition{}, err
+		}
+		max, err := p.parseOperand()
+		if err != nil {
+			return condition{}, err
+		}
+		filter.values = []operand{min, max}
+	default:
+		if negated {
+			return condition{}, fmt.Errorf("expected LIKE, IN or BETWEEN after NOT")
+		}
+		t := p.next()
+		switch t.value {
+		case "=", "!=", "<>", "<", "<=", ">", ">=":
+		default:
+			return condition{}, fmt.Errorf("unsupported operator %q", t.value)
+		}
+		if t.kind != tokenSymbol {
+			return condition{}, fmt.Errorf("unsupported operator %q", t.value)
+		}
+		filter.operator = t.value
+		if filter.operator == "<>" {
+			filter.operator = "!="
+		}
+		value, err := p.parseOperand()
+		if err != nil {
+			return condition{}, err
+		}
+		filter.values = []operand{value}
+	}
+
+	if negated {
+		filter.operator = "not " + filter.operator
+	}
+	return filter, nil
+}
+
+// parseOperand parses a literal value or a ? placeholder.
+func (p *parser) parseOperand() (operand, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokenSymbol && t.value == "?":
+		p.params++
+		return operand{param: p.params - 1}, nil
+	case t.kind == tokenString:
+		return operand{literal: t.value, param: -1}, nil
+	case t.kind == tokenNumber:
+		if n, err := strconv.ParseInt(t.value, 10, 64); err == nil {
+			return operand{literal: n, param: -1}, nil
+		}
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return operand{}, fmt.Errorf("invalid number %q", t.value)
+		}
+		return operand{literal: f, param: -1}, nil
+	case t.kind == tokenIdent && strings.EqualFold(t.value, "TRUE"):
+		return operand{literal: true, param: -1}, nil
+	case t.kind == tokenIdent && strings.EqualFold(t.value, "FALSE"):
+		return operand{literal: false, param: -1}, nil
+	case t.kind == tokenIdent && strings.EqualFold(t.value, "NULL"):
+		return operand{literal: nil, param: -1}, nil
+	}
+	return operand{}, fmt.Errorf("expected a value, got %q", t.value)
+}
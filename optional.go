@@ -0,0 +1,134 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Optional is a field value that can be unset, set to null or set to a value.
+//
+// When a struct is used to create or update records, unset Optional fields are left out of the
+// request, so the columns they map to are left untouched. This makes it possible to update only
+// some fields of a record with a struct, including setting them to their zero value.
+//
+// When decoding records, Optional fields are set if the column is present in the record, so
+// they also tell which columns were returned.
+//
+// Example:
+//
+//	type UserUpdate struct {
+//		ID    int                       `json:"Id"`
+//		Name  nocodbgo.Optional[string] `json:"Name"`
+//		Age   nocodbgo.Optional[int]    `json:"Age"`
+//		Notes nocodbgo.Optional[string] `json:"Notes"`
+//	}
+//
+//	// Sets Age to 0 and clears Notes, leaving Name untouched
+//	err := table.UpdateRecord(UserUpdate{
+//		ID:    1,
+//		Age:   nocodbgo.Set(0),
+//		Notes: nocodbgo.Null[string](),
+//	}).Execute()
+type Optional[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Set returns an Optional set to the given value.
+func Set[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Null returns an Optional set to null.
+func Null[T any]() Optional[T] {
+	return Optional[T]{set: true, null: true}
+}
+
+// Get returns the value and whether the Optional is set to a value (not unset nor null).
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+// IsSet reports whether the Optional is set, either to a value or to null.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull reports whether the Optional is set to null.
+func (o Optional[T]) IsNull() bool {
+	return o.set && o.null
+}
+
+// MarshalJSON implements the json.Marshaler interface for Optional.
+// Unset and null Optionals are encoded as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set || o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Optional.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Null[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal optional value: %w", err)
+	}
+
+	*o = Set(value)
+	return nil
+}
+
+// isOptionalSet reports whether the Optional is set, it is used to detect Optional fields
+// without knowing their type parameter.
+func (o Optional[T]) isOptionalSet() bool {
+	return o.set
+}
+
+// optionalValueType returns the type of the value of the Optional.
+func (o Optional[T]) optionalValueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// optionalField is implemented by all the Optional types
+type optionalField interface {
+	isOptionalSet() bool
+	optionalValueType() reflect.Type
+}
+
+// optionalFieldType is the reflect.Type of the optionalField interface
+var optionalFieldType = reflect.TypeFor[optionalField]()
+
+// dropUnsetOptionals removes from a record built from a struct the Optional fields that are not
+// set. The record must still be keyed by the JSON names of the fields.
+func dropUnsetOptionals(record map[string]any, value reflect.Value) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return
+	}
+
+	for name, field := range structFields(value.Type()).byJSONName {
+		if !field.typ.Implements(optionalFieldType) {
+			continue
+		}
+		fieldValue, err := value.FieldByIndexErr(field.index)
+		if err != nil {
+			continue
+		}
+		if !fieldValue.Interface().(optionalField).isOptionalSet() {
+			delete(record, name)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package nocodbgo
+
+import (
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	type UserUpdate struct {
+		ID     int              `json:"Id"`
+		Name   Optional[string] `json:"Name"`
+		Age    Optional[int]    `json:"Age" nocodb:"Age In Years"`
+		Notes  Optional[string] `json:"Notes"`
+		Active Optional[bool]   `json:"Active"`
+	}
+
+	record, err := structToMap(UserUpdate{ID: 1, Age: Set(0), Notes: Null[string]()})
+	if err != nil {
+		t.Fatalf("structToMap() error = %v", err)
+	}
+	if len(record) != 3 || record["Id"] != float64(1) || record["Age In Years"] != float64(0) {
+		t.Errorf("structToMap() = %#v, want Id, Age In Years and Notes", record)
+	}
+	if notes, ok := record["Notes"]; !ok || notes != nil {
+		t.Errorf("structToMap()[Notes] = %#v, %v, want null", notes, ok)
+	}
+
+	records, err := structsToMaps([]*UserUpdate{{ID: 1, Name: Set("")}, {ID: 2}})
+	if err != nil {
+		t.Fatalf("structsToMaps() error = %v", err)
+	}
+	if len(records[0]) != 2 || records[0]["Name"] != "" || len(records[1]) != 1 {
+		t.Errorf("structsToMaps() = %#v", records)
+	}
+
+	var decoded UserUpdate
+	if err := decodeInto(map[string]any{"Id": 1, "Age In Years": 30, "Notes": nil, "Active": float64(1)}, &decoded); err != nil {
+		t.Fatalf("decodeInto() error = %v", err)
+	}
+	if age, ok := decoded.Age.Get(); !ok || age != 30 {
+		t.Errorf("Age.Get() = %v, %v, want 30, true", age, ok)
+	}
+	if decoded.Name.IsSet() || !decoded.Notes.IsNull() {
+		t.Errorf("Name.IsSet() = %v, Notes.IsNull() = %v, want false, true", decoded.Name.IsSet(), decoded.Notes.IsNull())
+	}
+	if active, ok := decoded.Active.Get(); !ok || !active {
+		t.Errorf("Active.Get() = %v, %v, want true, true", active, ok)
+	}
+}
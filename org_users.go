@@ -0,0 +1,185 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// OrgRole is the role of a user in the organization of a self-hosted NocoDB server
+type OrgRole string
+
+const (
+	// OrgRoleSuperAdmin is the role of the super admins of the server, it can't be assigned
+	OrgRoleSuperAdmin OrgRole = "super"
+	// OrgRoleCreator allows the user to create bases
+	OrgRoleCreator OrgRole = "org-level-creator"
+	// OrgRoleViewer only allows the user to access the bases they are invited to
+	OrgRoleViewer OrgRole = "org-level-viewer"
+)
+
+// OrgUser describes a user of a self-hosted NocoDB server
+type OrgUser struct {
+	// ID is the unique identifier of the user
+	ID string `json:"id"`
+	// Email is the email of the user
+	Email string `json:"email"`
+	// DisplayName is the display name of the user, empty if the user didn't set one
+	DisplayName string `json:"display_name"`
+	// Roles is the role of the user in the organization (e.g. "org-level-creator")
+	Roles OrgRole `json:"roles"`
+	// Blocked indicates if the user was deactivated and can't sign in
+	Blocked bool `json:"blocked"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for OrgUser.
+// It handles the blocked flag being returned either as a boolean, as a 0/1 integer or as null.
+func (u *OrgUser) UnmarshalJSON(data []byte) error {
+	type Alias OrgUser
+	var raw struct {
+		Alias
+		Blocked any `json:"blocked"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal org user: %w", err)
+	}
+
+	*u = OrgUser(raw.Alias)
+	u.Blocked = metaBool(raw.Blocked)
+
+	return nil
+}
+
+// listOrgUsersBuilder is used to build a query that lists the users of the server with a fluent API
+type listOrgUsersBuilder struct {
+	client *Client
+	search string
+
+	contextProvider[*listOrgUsersBuilder]
+	paginationProvider[*listOrgUsersBuilder]
+}
+
+// ListOrgUsers lists the users of a self-hosted NocoDB server. It requires the API token of a
+// super admin.
+//
+// Example:
+//
+//	users, err := client.ListOrgUsers().Search("@example.com").Limit(100).Execute()
+//
+// Documentation:
+//   - https://docs.nocodb.com/account-settings/user-management
+func (c *Client) ListOrgUsers() *listOrgUsersBuilder {
+	b := &listOrgUsersBuilder{
+		client: c,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.paginationProvider = newPaginationProvider(b)
+
+	return b
+}
+
+// Search only lists the users whose email contains the given text.
+func (b *listOrgUsersBuilder) Search(text string) *listOrgUsersBuilder {
+	b.search = text
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listOrgUsersBuilder) Execute() ([]OrgUser, error) {
+	if b.paginationProvider.chainErr != nil {
+		return nil, fmt.Errorf("error in the chain of methods: %w", b.paginationProvider.chainErr)
+	}
+
+	query := b.paginationProvider.apply(url.Values{})
+	if b.search != "" {
+		query.Set("query", b.search)
+	}
+
+	respBody, err := b.client.request(b.contextProvider.ctx, http.MethodGet, "/api/v1/users", nil, query)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list org users: %w", err), "ListOrgUsers", "", RecordID{})
+	}
+
+	var response struct {
+		List []OrgUser `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal org users response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// updateOrgUserBuilder is used to build an update of a user of the server with a fluent API
+type updateOrgUserBuilder struct {
+	client    *Client
+	userID    string
+	operation string
+	body      map[string]any
+
+	contextProvider[*updateOrgUserBuilder]
+}
+
+// newUpdateOrgUserBuilder creates the builder of an update of a user with the given body.
+func newUpdateOrgUserBuilder(c *Client, userID string, operation string, body map[string]any) *updateOrgUserBuilder {
+	b := &updateOrgUserBuilder{
+		client:    c,
+		userID:    userID,
+		operation: operation,
+		body:      body,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// UpdateOrgUserRole changes the role of a user of a self-hosted NocoDB server, to allow or forbid
+// them to create bases. It requires the API token of a super admin.
+//
+// Parameters:
+//   - userID: The identifier of the user (e.g. "us_xxxxxxxxxxxxxx").
+//   - role:   The new role, OrgRoleCreator or OrgRoleViewer.
+//
+// Example:
+//
+//	err := client.UpdateOrgUserRole("us_xxxxxxxxxxxxxx", nocodbgo.OrgRoleCreator).Execute()
+//
+// Documentation:
+//   - https://docs.nocodb.com/account-settings/user-management
+func (c *Client) UpdateOrgUserRole(userID string, role OrgRole) *updateOrgUserBuilder {
+	return newUpdateOrgUserBuilder(c, userID, "UpdateOrgUserRole", map[string]any{"roles": role})
+}
+
+// DeactivateOrgUser blocks a user of a self-hosted NocoDB server, so they can no longer sign in
+// or use their API tokens. The user and their access to the bases are kept, unlike deleting them.
+// It requires the API token of a super admin.
+//
+// Parameters:
+//   - userID: The identifier of the user (e.g. "us_xxxxxxxxxxxxxx").
+//
+// Documentation:
+//   - https://docs.nocodb.com/account-settings/user-management
+func (c *Client) DeactivateOrgUser(userID string) *updateOrgUserBuilder {
+	return newUpdateOrgUserBuilder(c, userID, "DeactivateOrgUser", map[string]any{"blocked": true})
+}
+
+// Execute finalizes and executes the operation.
+func (b *updateOrgUserBuilder) Execute() error {
+	if b.userID == "" {
+		return ErrUserIDRequired
+	}
+	if role, ok := b.body["roles"].(OrgRole); ok && role != OrgRoleCreator && role != OrgRoleViewer {
+		return fmt.Errorf("%w: %q can't be assigned", ErrInvalidRole, role)
+	}
+
+	path := fmt.Sprintf("/api/v1/users/%s", b.userID)
+	if _, err := b.client.request(b.contextProvider.ctx, http.MethodPatch, path, b.body, nil); err != nil {
+		return withOperation(fmt.Errorf("failed to update org user: %w", err), b.operation, "", RecordID{})
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestOrgUsers(t *testing.T) {
+	var query string
+	updates := map[string]map[string]any{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/users", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"list":[
+			{"id":"us_1","email":"ana@example.com","display_name":"Ana","roles":"super","blocked":null},
+			{"id":"us_2","email":"bob@example.com","roles":"org-level-viewer","blocked":1}
+		],"pageInfo":{"totalRows":2}}`))
+	})
+	mux.HandleFunc("PATCH /api/v1/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		updates[r.PathValue("id")] = body
+		_, _ = w.Write([]byte(`{"msg":"The user has been updated successfully"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	users, err := client.ListOrgUsers().Search("@example.com").Limit(50).Execute()
+	if err != nil {
+		t.Fatalf("ListOrgUsers() error = %v", err)
+	}
+	want := []OrgUser{
+		{ID: "us_1", Email: "ana@example.com", DisplayName: "Ana", Roles: OrgRoleSuperAdmin},
+		{ID: "us_2", Email: "bob@example.com", Roles: OrgRoleViewer, Blocked: true},
+	}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("ListOrgUsers() = %+v, want %+v", users, want)
+	}
+	if query != "limit=50&query=%40example.com" {
+		t.Errorf("ListOrgUsers() query = %q", query)
+	}
+	if _, err := client.ListOrgUsers().Limit(-1).Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("ListOrgUsers() error = %v, want %v", err, ErrInvalidQuery)
+	}
+
+	if err := client.UpdateOrgUserRole("us_2", OrgRoleCreator).Execute(); err != nil {
+		t.Fatalf("UpdateOrgUserRole() error = %v", err)
+	}
+	if err := client.DeactivateOrgUser("us_1").Execute(); err != nil {
+		t.Fatalf("DeactivateOrgUser() error = %v", err)
+	}
+	wantUpdates := map[string]map[string]any{
+		"us_2": {"roles": "org-level-creator"},
+		"us_1": {"blocked": true},
+	}
+	if !reflect.DeepEqual(updates, wantUpdates) {
+		t.Errorf("updates = %v, want %v", updates, wantUpdates)
+	}
+
+	if err := client.UpdateOrgUserRole("us_2", OrgRoleSuperAdmin).Execute(); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("UpdateOrgUserRole() error = %v, want %v", err, ErrInvalidRole)
+	}
+	if err := client.DeactivateOrgUser("").Execute(); !errors.Is(err, ErrUserIDRequired) {
+		t.Errorf("DeactivateOrgUser() error = %v, want %v", err, ErrUserIDRequired)
+	}
+}
@@ -17,6 +17,14 @@ func newContextProvider[T any](builder T) contextProvider[T] {
 	}
 }
 
+// clone returns a copy of the contextProvider bound to the given builder.
+func (c *contextProvider[T]) clone(builder T) contextProvider[T] {
+	return contextProvider[T]{
+		builder: builder,
+		ctx:     c.ctx,
+	}
+}
+
 // WithContext sets the context for the operation.
 //
 // This allows for request cancellation and timeout control.
@@ -2,6 +2,7 @@ package nocodbgo
 
 import (
 	"net/url"
+	"slices"
 	"strings"
 )
 
@@ -13,8 +14,10 @@ import (
 // Documentation:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 type fieldProvider[T any] struct {
-	builder   T
-	rawFields []string
+	builder             T
+	rawFields           []string
+	includeSystemFields bool
+	excludeSystemFields bool
 }
 
 // newFieldProvider creates a new fieldProvider instance with the given builder and apply function.
@@ -26,19 +29,69 @@ func newFieldProvider[T any](builder T) fieldProvider[T] {
 	}
 }
 
+// clone returns a copy of the fieldProvider bound to the given builder.
+func (f *fieldProvider[T]) clone(builder T) fieldProvider[T] {
+	return fieldProvider[T]{
+		builder:             builder,
+		rawFields:           slices.Clone(f.rawFields),
+		includeSystemFields: f.includeSystemFields,
+		excludeSystemFields: f.excludeSystemFields,
+	}
+}
+
 // apply takes the url.Values and adds the "fields" query parameter to it with all the fields
 // that have been added to the fieldProvider instance.
 //
 // It returns a new copy of the provided url.Values with the "fields" query parameter added.
 func (f *fieldProvider[T]) apply(query url.Values) url.Values {
-	if query == nil || len(f.rawFields) < 1 {
+	fields := f.fields()
+	if query == nil || len(fields) < 1 {
 		return query
 	}
 
-	query.Set("fields", strings.Join(f.rawFields, ","))
+	query.Set("fields", strings.Join(fields, ","))
 	return query
 }
 
+// fields returns the fields to request, taking into account the system fields options.
+func (f *fieldProvider[T]) fields() []string {
+	if len(f.rawFields) < 1 {
+		return f.rawFields
+	}
+
+	fields := make([]string, 0, len(f.rawFields)+len(systemFieldNames))
+	for _, field := range f.rawFields {
+		if f.excludeSystemFields && isSystemField(field) && field != SystemFieldID {
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	if f.includeSystemFields {
+		for _, field := range systemFieldNames {
+			if !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	return fields
+}
+
+// stripSystemFields removes the system fields from the records when they have been excluded.
+// The "Id" field is always kept.
+func (f *fieldProvider[T]) stripSystemFields(records ...map[string]any) {
+	if !f.excludeSystemFields {
+		return
+	}
+
+	for _, record := range records {
+		for _, field := range systemFieldNames[1:] {
+			delete(record, field)
+		}
+	}
+}
+
 // ReturnFields specifies which fields to include in the response.
 //
 // If not called, all fields will be returned.
@@ -54,3 +107,31 @@ func (f *fieldProvider[T]) ReturnFields(fields ...string) T {
 	f.rawFields = fields
 	return f.builder
 }
+
+// IncludeSystemFields makes the response include the system fields (Id, CreatedAt, UpdatedAt,
+// CreatedBy and UpdatedBy) even when only some fields are requested with ReturnFields.
+//
+// This is useful when decoding into structs that embed SystemFields.
+//
+// Example:
+//
+//	// Return the "Name" field along with the system fields
+//	query = query.ReturnFields("Name").IncludeSystemFields()
+func (f *fieldProvider[T]) IncludeSystemFields() T {
+	f.includeSystemFields = true
+	f.excludeSystemFields = false
+	return f.builder
+}
+
+// ExcludeSystemFields removes the system fields (CreatedAt, UpdatedAt, CreatedBy and UpdatedBy)
+// from the response. The "Id" field is always kept since it identifies the records.
+//
+// Example:
+//
+//	// Return all the fields except the system fields
+//	query = query.ExcludeSystemFields()
+func (f *fieldProvider[T]) ExcludeSystemFields() T {
+	f.excludeSystemFields = true
+	f.includeSystemFields = false
+	return f.builder
+}
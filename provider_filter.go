@@ -1,8 +1,10 @@
 package nocodbgo
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 )
 
@@ -17,6 +19,7 @@ import (
 type filterProvider[T any] struct {
 	builder    T
 	rawFilters []string
+	chainErr   error // Stores any error in the chain of methods
 }
 
 // newFilterProvider creates a new filterProvider instance with the given builder and apply function.
@@ -28,6 +31,15 @@ func newFilterProvider[T any](builder T) filterProvider[T] {
 	}
 }
 
+// clone returns a copy of the filterProvider bound to the given builder.
+func (f *filterProvider[T]) clone(builder T) filterProvider[T] {
+	return filterProvider[T]{
+		builder:    builder,
+		rawFilters: slices.Clone(f.rawFilters),
+		chainErr:   f.chainErr,
+	}
+}
+
 // apply takes the url.Values and adds the "where" query parameter to it with all the filters
 // that have been added to the filterProvider instance.
 //
@@ -41,6 +53,27 @@ func (f *filterProvider[T]) apply(query url.Values) url.Values {
 	return query
 }
 
+// addFilter adds a comparison filter on the given column, recording an error in the chain of
+// methods instead if the column name is empty or contains a comma, which separates the parts of
+// the comparison.
+func (f *filterProvider[T]) addFilter(column string, filter string) T {
+	if strings.TrimSpace(column) == "" {
+		return f.addErr(fmt.Errorf("%w: missing column name in filter %s", ErrInvalidQuery, filter))
+	}
+	if strings.Contains(column, ",") {
+		return f.addErr(fmt.Errorf("%w: column name %q in filter contains a comma", ErrInvalidQuery, column))
+	}
+
+	f.rawFilters = append(f.rawFilters, filter)
+	return f.builder
+}
+
+// addErr records an error in the chain of methods, which is returned when the operation is executed.
+func (f *filterProvider[T]) addErr(err error) T {
+	f.chainErr = errors.Join(f.chainErr, err)
+	return f.builder
+}
+
 // Where adds a custom filter expression to the "where" query parameter of the request.
 // This allows for more complex filtering logic than the predefined filter methods.
 //
@@ -72,8 +105,7 @@ func (f *filterProvider[T]) Where(filter string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsEqualTo(column string, value string) T {
 	filter := fmt.Sprintf("(%s,eq,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotEqualTo adds a filter to the "where" query parameter of the request that matches
@@ -89,8 +121,7 @@ func (f *filterProvider[T]) WhereIsEqualTo(column string, value string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNotEqualTo(column string, value string) T {
 	filter := fmt.Sprintf("(%s,neq,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsGreaterThan adds a filter to the "where" query parameter of the request that matches
@@ -106,8 +137,7 @@ func (f *filterProvider[T]) WhereIsNotEqualTo(column string, value string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsGreaterThan(column string, value string) T {
 	filter := fmt.Sprintf("(%s,gt,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsGreaterThanOrEqual adds a filter to the "where" query parameter of the request that matches
@@ -123,8 +153,7 @@ func (f *filterProvider[T]) WhereIsGreaterThan(column string, value string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsGreaterThanOrEqual(column string, value string) T {
 	filter := fmt.Sprintf("(%s,ge,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsLessThan adds a filter to the "where" query parameter of the request that matches
@@ -140,8 +169,7 @@ func (f *filterProvider[T]) WhereIsGreaterThanOrEqual(column string, value strin
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsLessThan(column string, value string) T {
 	filter := fmt.Sprintf("(%s,lt,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsLessThanOrEqual adds a filter to the "where" query parameter of the request that matches
@@ -157,8 +185,7 @@ func (f *filterProvider[T]) WhereIsLessThan(column string, value string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsLessThanOrEqual(column string, value string) T {
 	filter := fmt.Sprintf("(%s,le,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNull adds a filter to the "where" query parameter of the request that matches
@@ -174,8 +201,7 @@ func (f *filterProvider[T]) WhereIsLessThanOrEqual(column string, value string)
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNull(column string) T {
 	filter := fmt.Sprintf("(%s,is,null)", column)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotNull adds a filter to the "where" query parameter of the request that matches
@@ -191,8 +217,7 @@ func (f *filterProvider[T]) WhereIsNull(column string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNotNull(column string) T {
 	filter := fmt.Sprintf("(%s,isnot,null)", column)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsTrue adds a filter to the "where" query parameter of the request that matches
@@ -208,8 +233,7 @@ func (f *filterProvider[T]) WhereIsNotNull(column string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsTrue(column string) T {
 	filter := fmt.Sprintf("(%s,is,true)", column)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotTrue adds a filter to the "where" query parameter of the request that matches
@@ -225,8 +249,7 @@ func (f *filterProvider[T]) WhereIsTrue(column string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNotTrue(column string) T {
 	filter := fmt.Sprintf("(%s,isnot,true)", column)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsFalse adds a filter to the "where" query parameter of the request that matches
@@ -242,8 +265,7 @@ func (f *filterProvider[T]) WhereIsNotTrue(column string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsFalse(column string) T {
 	filter := fmt.Sprintf("(%s,is,false)", column)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotFalse adds a filter to the "where" query parameter of the request that matches
@@ -259,18 +281,24 @@ func (f *filterProvider[T]) WhereIsFalse(column string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNotFalse(column string) T {
 	filter := fmt.Sprintf("(%s,isnot,false)", column)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsIn adds a filter to the "where" query parameter of the request that matches
 // records where the specified column's value is in the provided list of values.
 //
+// Large lists are split into several "in" comparisons of up to 100 values combined with OR,
+// values containing commas are compared one by one with "eq" since they can't be listed, and an
+// empty value matches the records where the column is null or empty. Repeated values are sent once.
+//
 // Example:
 //
 //	// Where MyField is in the list of values
 //	query = query.WhereIsIn("MyField", "55", "66", "77")
 //
+//	// Where ExternalID is any of hundreds of IDs
+//	query = query.WhereIsIn("ExternalID", externalIDs...)
+//
 // Documentation:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
@@ -279,9 +307,51 @@ func (f *filterProvider[T]) WhereIsIn(column string, values ...string) T {
 		return f.builder
 	}
 
-	filter := fmt.Sprintf("(%s,in,%s)", column, strings.Join(values, ","))
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, inFilter(column, values))
+}
+
+// maxInFilterValues is the maximum number of values of a single "in" comparison
+const maxInFilterValues = 100
+
+// inFilter returns the filter of WhereIsIn, combining with OR the "in" comparisons of the listed
+// values in chunks, the "eq" comparisons of the values with commas and a "blank" comparison for
+// the empty value.
+func inFilter(column string, values []string) string {
+	listed := []string{}
+	comparisons := []string{}
+	blank := false
+	seen := map[string]bool{}
+
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+
+		switch {
+		case value == "":
+			blank = true
+		case strings.Contains(value, ","):
+			comparisons = append(comparisons, fmt.Sprintf("(%s,eq,%s)", column, value))
+		default:
+			listed = append(listed, value)
+		}
+	}
+
+	chunks := []string{}
+	for start := 0; start < len(listed); start += maxInFilterValues {
+		end := min(start+maxInFilterValues, len(listed))
+		chunks = append(chunks, fmt.Sprintf("(%s,in,%s)", column, strings.Join(listed[start:end], ",")))
+	}
+	comparisons = append(chunks, comparisons...)
+	if blank {
+		comparisons = append(comparisons, fmt.Sprintf("(%s,blank)", column))
+	}
+
+	if len(comparisons) == 1 {
+		return comparisons[0]
+	}
+	return "(" + strings.Join(comparisons, "~or") + ")"
 }
 
 // WhereIsBetween adds a filter to the "where" query parameter of the request that matches
@@ -296,9 +366,12 @@ func (f *filterProvider[T]) WhereIsIn(column string, values ...string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsBetween(column string, min, max string) T {
+	if min == "" || max == "" {
+		return f.addErr(fmt.Errorf("%w: filter on %q requires both a min and a max value", ErrInvalidQuery, column))
+	}
+
 	filter := fmt.Sprintf("(%s,btw,%s,%s)", column, min, max)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotBetween adds a filter to the "where" query parameter of the request that matches
@@ -313,9 +386,12 @@ func (f *filterProvider[T]) WhereIsBetween(column string, min, max string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNotBetween(column string, min, max string) T {
+	if min == "" || max == "" {
+		return f.addErr(fmt.Errorf("%w: filter on %q requires both a min and a max value", ErrInvalidQuery, column))
+	}
+
 	filter := fmt.Sprintf("(%s,nbtw,%s,%s)", column, min, max)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsLike adds a filter to the "where" query parameter of the request that matches
@@ -334,8 +410,62 @@ func (f *filterProvider[T]) WhereIsNotBetween(column string, min, max string) T
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsLike(column string, value string) T {
 	filter := fmt.Sprintf("(%s,like,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
+}
+
+// likeEscaper escapes the wildcards of the like operator, and the escape character itself, so they
+// match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// WhereContains adds a filter to the "where" query parameter of the request that matches
+// records where the specified column's value contains the given text.
+//
+// Unlike WhereIsLike, the "%" and "_" wildcards in the text are escaped with a backslash, the
+// default escape character of the like operator in PostgreSQL and MySQL, so user input matches
+// literally.
+//
+// SQLite, the default database of NocoDB, has no default escape character, so on bases stored in
+// SQLite a text with "%", "_" or "\" matches a literal backslash instead and returns the wrong
+// records. Texts without those characters work on every database.
+//
+// Example:
+//
+//	// Where MyField contains "50%", like "Save 50% today" but not "Save 500 today"
+//	query = query.WhereContains("MyField", "50%")
+//
+// Documentation:
+//   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
+//   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
+func (f *filterProvider[T]) WhereContains(column string, text string) T {
+	return f.WhereIsLike(column, "%"+likeEscaper.Replace(text)+"%")
+}
+
+// WhereStartsWith adds a filter to the "where" query parameter of the request that matches
+// records where the specified column's value starts with the given text.
+//
+// The "%" and "_" wildcards in the text are escaped like in WhereContains, which doesn't work on
+// bases stored in SQLite.
+//
+// Example:
+//
+//	// Where MyField starts with "SKU_", like "SKU_001" but not "SKU-001"
+//	query = query.WhereStartsWith("MyField", "SKU_")
+func (f *filterProvider[T]) WhereStartsWith(column string, text string) T {
+	return f.WhereIsLike(column, likeEscaper.Replace(text)+"%")
+}
+
+// WhereEndsWith adds a filter to the "where" query parameter of the request that matches
+// records where the specified column's value ends with the given text.
+//
+// The "%" and "_" wildcards in the text are escaped like in WhereContains, which doesn't work on
+// bases stored in SQLite.
+//
+// Example:
+//
+//	// Where MyField ends with "@example.com"
+//	query = query.WhereEndsWith("MyField", "@example.com")
+func (f *filterProvider[T]) WhereEndsWith(column string, text string) T {
+	return f.WhereIsLike(column, "%"+likeEscaper.Replace(text))
 }
 
 // WhereIsNotLike adds a filter to the "where" query parameter of the request that matches
@@ -354,14 +484,19 @@ func (f *filterProvider[T]) WhereIsLike(column string, value string) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
 func (f *filterProvider[T]) WhereIsNotLike(column string, value string) T {
 	filter := fmt.Sprintf("(%s,nlike,%s)", column, value)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
+// withinValueSubOperations are the sub-operations of date filters that require a value, like the
+// number of days or the exact date
+var withinValueSubOperations = []string{"pastNumberOfDays", "nextNumberOfDays", "daysAgo", "daysFromNow", "exactDate"}
+
 // WhereIsWithin adds a filter to the "where" query parameter of the request that matches
 // records where the specified column's value is within a specific time range.
 //
-// The subOperation parameter specifies the time range (e.g., "today", "yesterday", "thisWeek").
+// The subOperation parameter specifies the time range (e.g., "pastWeek", "nextMonth"). The
+// sub-operations anchored to a value, like "pastNumberOfDays", "nextNumberOfDays" or "exactDate",
+// take the value as an extra argument, which is required for them.
 //
 // This is only available for Date/DateTime columns and you can use the following subOperations:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-sub-operators
@@ -369,15 +504,30 @@ func (f *filterProvider[T]) WhereIsNotLike(column string, value string) T {
 // Example:
 //
 //	// Where MyField is within the last week
-//	query = query.WhereIsWithin("MyField", "oneWeekAgo")
+//	query = query.WhereIsWithin("MyField", "pastWeek")
+//
+//	// Where MyField is within the last 10 days
+//	query = query.WhereIsWithin("MyField", "pastNumberOfDays", "10")
 //
 // Documentation:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
-func (f *filterProvider[T]) WhereIsWithin(column string, subOperation string) T {
-	filter := fmt.Sprintf("(%s,within,%s)", column, subOperation)
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+func (f *filterProvider[T]) WhereIsWithin(column string, subOperation string, value ...string) T {
+	if subOperation == "" {
+		return f.addErr(fmt.Errorf("%w: filter on %q requires a sub-operation", ErrInvalidQuery, column))
+	}
+	if len(value) > 1 {
+		return f.addErr(fmt.Errorf("%w: filter on %q takes a single value for %q, got %d", ErrInvalidQuery, column, subOperation, len(value)))
+	}
+	if len(value) == 0 || value[0] == "" {
+		if slices.Contains(withinValueSubOperations, subOperation) {
+			return f.addErr(fmt.Errorf("%w: filter on %q requires a value for %q", ErrInvalidQuery, column, subOperation))
+		}
+		return f.addFilter(column, fmt.Sprintf("(%s,within,%s)", column, subOperation))
+	}
+
+	filter := fmt.Sprintf("(%s,within,%s,%s)", column, subOperation, value[0])
+	return f.addFilter(column, filter)
 }
 
 // WhereIsAllOf adds a filter to the "where" query parameter of the request that matches
@@ -398,8 +548,7 @@ func (f *filterProvider[T]) WhereIsAllOf(column string, values ...string) T {
 	}
 
 	filter := fmt.Sprintf("(%s,allof,%s)", column, strings.Join(values, ","))
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsAnyOf adds a filter to the "where" query parameter of the request that matches
@@ -420,8 +569,7 @@ func (f *filterProvider[T]) WhereIsAnyOf(column string, values ...string) T {
 	}
 
 	filter := fmt.Sprintf("(%s,anyof,%s)", column, strings.Join(values, ","))
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotAllOf adds a filter to the "where" query parameter of the request that matches
@@ -442,8 +590,7 @@ func (f *filterProvider[T]) WhereIsNotAllOf(column string, values ...string) T {
 	}
 
 	filter := fmt.Sprintf("(%s,nallof,%s)", column, strings.Join(values, ","))
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
 }
 
 // WhereIsNotAnyOf adds a filter to the "where" query parameter of the request that matches
@@ -464,6 +611,37 @@ func (f *filterProvider[T]) WhereIsNotAnyOf(column string, values ...string) T {
 	}
 
 	filter := fmt.Sprintf("(%s,nanyof,%s)", column, strings.Join(values, ","))
-	f.rawFilters = append(f.rawFilters, filter)
-	return f.builder
+	return f.addFilter(column, filter)
+}
+
+// WhereIsAtLocation adds a filter to the "where" query parameter of the request that matches
+// records where the specified GeoData column is at the given location.
+//
+// Example:
+//
+//	// Where Location is at the given coordinates
+//	query = query.WhereIsAtLocation("Location", nocodbgo.GeoPoint{Lat: 52.52, Lng: 13.405})
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/custom-types/geodata
+//   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
+func (f *filterProvider[T]) WhereIsAtLocation(column string, location GeoPoint) T {
+	filter := fmt.Sprintf("(%s,eq,%s)", column, location)
+	return f.addFilter(column, filter)
+}
+
+// WhereIsNotAtLocation adds a filter to the "where" query parameter of the request that matches
+// records where the specified GeoData column is not at the given location.
+//
+// Example:
+//
+//	// Where Location is not at the given coordinates
+//	query = query.WhereIsNotAtLocation("Location", nocodbgo.GeoPoint{Lat: 52.52, Lng: 13.405})
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/custom-types/geodata
+//   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#comparison-operators
+func (f *filterProvider[T]) WhereIsNotAtLocation(column string, location GeoPoint) T {
+	filter := fmt.Sprintf("(%s,neq,%s)", column, location)
+	return f.addFilter(column, filter)
 }
@@ -0,0 +1,114 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFilterProvider(t *testing.T) {
+	client, err := NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = fmt.Sprint(i + 1)
+	}
+
+	tests := []struct {
+		name    string
+		builder *listRecordsBuilder
+		want    string
+	}{
+		{
+			name:    "within",
+			builder: table.ListRecords().WhereIsWithin("CreatedAt", "pastWeek"),
+			want:    "(CreatedAt,within,pastWeek)",
+		},
+		{
+			name:    "within with value",
+			builder: table.ListRecords().WhereIsWithin("CreatedAt", "pastNumberOfDays", "10"),
+			want:    "(CreatedAt,within,pastNumberOfDays,10)",
+		},
+		{
+			name:    "within exact date",
+			builder: table.ListRecords().WhereIsWithin("DueDate", "exactDate", "2024-03-05"),
+			want:    "(DueDate,within,exactDate,2024-03-05)",
+		},
+		{
+			name:    "contains",
+			builder: table.ListRecords().WhereContains("Title", "50%_off"),
+			want:    `(Title,like,%50\%\_off%)`,
+		},
+		{
+			name:    "starts with",
+			builder: table.ListRecords().WhereStartsWith("Path", `C:\Temp`),
+			want:    `(Path,like,C:\\Temp%)`,
+		},
+		{
+			name:    "ends with",
+			builder: table.ListRecords().WhereEndsWith("Email", "@example.com"),
+			want:    "(Email,like,%@example.com)",
+		},
+		{
+			name:    "in",
+			builder: table.ListRecords().WhereIsIn("Status", "active", "trial", "active"),
+			want:    "(Status,in,active,trial)",
+		},
+		{
+			name:    "in with commas and empty values",
+			builder: table.ListRecords().WhereIsIn("Company", "Acme", "Foo, Inc.", ""),
+			want:    "((Company,in,Acme)~or(Company,eq,Foo, Inc.)~or(Company,blank))",
+		},
+		{
+			name:    "in with many values",
+			builder: table.ListRecords().WhereIsIn("Id", ids...),
+			want:    "((Id,in," + strings.Join(ids[:100], ",") + ")~or(Id,in," + strings.Join(ids[100:], ",") + "))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := tt.builder.Query()
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if got := rendered.Query.Get("where"); got != tt.want {
+				t.Errorf("where = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhereIsInLargeValueSets(t *testing.T) {
+	client, fake := newFakeClient(t)
+	records := []map[string]any{{"Company": "Foo, Inc."}, {"Company": ""}}
+	for i := 0; i < 300; i++ {
+		records = append(records, map[string]any{"Company": fmt.Sprintf("Company %d", i)})
+	}
+	fake.Seed("companies", records...)
+
+	values := []string{"Foo, Inc.", ""}
+	for i := 0; i < 300; i += 2 {
+		values = append(values, fmt.Sprintf("Company %d", i))
+	}
+	result, err := client.Table("companies").ListRecords().WhereIsIn("Company", values...).ExecuteAll()
+	if err != nil {
+		t.Fatalf("ExecuteAll() error = %v", err)
+	}
+
+	got := []string{}
+	for _, record := range result.List {
+		got = append(got, record["Company"].(string))
+	}
+	slices.Sort(got)
+	want := slices.Clone(values)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("ExecuteAll() = %v, want %v", got, want)
+	}
+}
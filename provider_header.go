@@ -0,0 +1,56 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"slices"
+)
+
+// headerProvider provides a reusable set of methods for building requests with custom HTTP headers.
+//
+// It is designed to be embedded in builder types to provide consistent header capabilities.
+type headerProvider[T any] struct {
+	builder   T
+	rawHeader http.Header
+}
+
+// newHeaderProvider creates a new headerProvider instance with the given builder.
+func newHeaderProvider[T any](builder T) headerProvider[T] {
+	return headerProvider[T]{
+		builder:   builder,
+		rawHeader: http.Header{},
+	}
+}
+
+// clone returns a copy of the headerProvider bound to the given builder.
+func (h *headerProvider[T]) clone(builder T) headerProvider[T] {
+	return headerProvider[T]{
+		builder:   builder,
+		rawHeader: h.rawHeader.Clone(),
+	}
+}
+
+// withHeaders sets all the given headers, used to forward the headers of a builder to the builders
+// it delegates the requests to.
+func (h *headerProvider[T]) withHeaders(header http.Header) T {
+	for key, values := range header {
+		h.rawHeader[key] = slices.Clone(values)
+	}
+	return h.builder
+}
+
+// WithHeader sets an HTTP header sent only with the requests of this operation, for example tracing
+// baggage or tenant hints for a proxy that shouldn't be set for the whole client.
+//
+// Calling it several times with the same key replaces the previous value. The authentication header
+// of the client can't be replaced.
+//
+// Example:
+//
+//	// Send the tenant of the request to the proxy in front of NocoDB
+//	query = query.WithHeader("X-Tenant-ID", tenantID)
+func (h *headerProvider[T]) WithHeader(key string, value string) T {
+	if key != "" {
+		h.rawHeader.Set(key, value)
+	}
+	return h.builder
+}
@@ -1,6 +1,8 @@
 package nocodbgo
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"strconv"
 )
@@ -16,6 +18,7 @@ type paginationProvider[T any] struct {
 	builder   T
 	rawLimit  int
 	rawOffset int
+	chainErr  error // Stores any error in the chain of methods
 }
 
 // newPaginationProvider creates a new paginationProvider instance with the given builder and apply function.
@@ -26,6 +29,16 @@ func newPaginationProvider[T any](builder T) paginationProvider[T] {
 	}
 }
 
+// clone returns a copy of the paginationProvider bound to the given builder.
+func (p *paginationProvider[T]) clone(builder T) paginationProvider[T] {
+	return paginationProvider[T]{
+		builder:   builder,
+		rawLimit:  p.rawLimit,
+		rawOffset: p.rawOffset,
+		chainErr:  p.chainErr,
+	}
+}
+
 // apply takes the url.Values and adds the "limit" and "offset" query parameters to it with the values
 // that have been added to the paginationProvider instance.
 //
@@ -45,12 +58,21 @@ func (p *paginationProvider[T]) apply(query url.Values) url.Values {
 	return query
 }
 
+// addErr records an error in the chain of methods, which is returned when the operation is executed.
+func (p *paginationProvider[T]) addErr(err error) T {
+	p.chainErr = errors.Join(p.chainErr, err)
+	return p.builder
+}
+
 // Limit sets the limit for the number of records to return from the query.
 //
 // Documentation:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 func (p *paginationProvider[T]) Limit(limit int) T {
-	if limit < 1 {
+	if limit < 0 {
+		return p.addErr(fmt.Errorf("%w: negative limit %d", ErrInvalidQuery, limit))
+	}
+	if limit == 0 {
 		return p.builder
 	}
 
@@ -64,7 +86,7 @@ func (p *paginationProvider[T]) Limit(limit int) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 func (p *paginationProvider[T]) Offset(offset int) T {
 	if offset < 0 {
-		return p.builder
+		return p.addErr(fmt.Errorf("%w: negative offset %d", ErrInvalidQuery, offset))
 	}
 
 	p.rawOffset = offset
@@ -79,7 +101,7 @@ func (p *paginationProvider[T]) Offset(offset int) T {
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 func (p *paginationProvider[T]) Page(page int, pageSize int) T {
 	if page < 1 || pageSize < 1 {
-		return p.builder
+		return p.addErr(fmt.Errorf("%w: invalid page %d with page size %d", ErrInvalidQuery, page, pageSize))
 	}
 
 	p.rawLimit = pageSize
@@ -0,0 +1,105 @@
+package nocodbgo
+
+import "fmt"
+
+// PaginationDriftWarning reports that the total number of records matching a query changed while
+// its pages were being fetched, because records were created or deleted concurrently. With offset
+// pagination some records may then be skipped or returned twice.
+//
+// It implements the error interface, and is the error of the iteration when FailOnPaginationDrift
+// is used.
+type PaginationDriftWarning struct {
+	// Offset is the offset of the page where the change was detected
+	Offset int
+	// PreviousTotal is the total number of records reported by the previous page
+	PreviousTotal int
+	// CurrentTotal is the total number of records reported by the page at Offset
+	CurrentTotal int
+}
+
+// Error implements the error interface for PaginationDriftWarning.
+func (w PaginationDriftWarning) Error() string {
+	return fmt.Sprintf("pagination drift: the total number of records changed from %d to %d at offset %d", w.PreviousTotal, w.CurrentTotal, w.Offset)
+}
+
+// paginationDriftProvider provides a reusable set of methods for builders that fetch several pages,
+// to detect the changes of the total number of records between pages.
+//
+// It is designed to be embedded in builder types to provide consistent drift detection capabilities.
+type paginationDriftProvider[T any] struct {
+	builder     T
+	onDrift     func(warning PaginationDriftWarning)
+	failOnDrift bool
+}
+
+// newPaginationDriftProvider creates a new paginationDriftProvider instance with the given builder.
+func newPaginationDriftProvider[T any](builder T) paginationDriftProvider[T] {
+	return paginationDriftProvider[T]{
+		builder: builder,
+	}
+}
+
+// clone returns a copy of the paginationDriftProvider bound to the given builder.
+func (p *paginationDriftProvider[T]) clone(builder T) paginationDriftProvider[T] {
+	return paginationDriftProvider[T]{
+		builder:     builder,
+		onDrift:     p.onDrift,
+		failOnDrift: p.failOnDrift,
+	}
+}
+
+// apply sets the drift detection of the iterator, if any has been configured.
+//
+// It returns the same iterator for chaining.
+func (p *paginationDriftProvider[T]) apply(it *RecordIterator) *RecordIterator {
+	if p.onDrift == nil && !p.failOnDrift {
+		return it
+	}
+
+	onDrift, failOnDrift := p.onDrift, p.failOnDrift
+	it.onDrift = func(warning PaginationDriftWarning) error {
+		if onDrift != nil {
+			onDrift(warning)
+		}
+		if failOnDrift {
+			return warning
+		}
+		return nil
+	}
+	return it
+}
+
+// OnPaginationDrift sets a callback that is called when the total number of matching records
+// changes between the pages fetched by ExecuteAll or Iterate, which means records were created
+// or deleted meanwhile and the results may have missing or repeated records. The iteration goes
+// on after the callback returns.
+//
+// The change can only be detected with the API versions that return the total on every page.
+//
+// Example:
+//
+//	all, err := table.ListRecords().
+//		OnPaginationDrift(func(warning nocodbgo.PaginationDriftWarning) {
+//			log.Printf("the results may be inconsistent: %v", warning)
+//		}).
+//		ExecuteAll()
+func (p *paginationDriftProvider[T]) OnPaginationDrift(onDrift func(warning PaginationDriftWarning)) T {
+	p.onDrift = onDrift
+	return p.builder
+}
+
+// FailOnPaginationDrift stops ExecuteAll and Iterate with a PaginationDriftWarning as the error
+// when the total number of matching records changes between pages, for the consumers that need
+// a consistent result set and would rather retry.
+//
+// Example:
+//
+//	all, err := table.ListRecords().FailOnPaginationDrift().ExecuteAll()
+//	var warning nocodbgo.PaginationDriftWarning
+//	if errors.As(err, &warning) {
+//		// Retry later
+//	}
+func (p *paginationDriftProvider[T]) FailOnPaginationDrift() T {
+	p.failOnDrift = true
+	return p.builder
+}
@@ -0,0 +1,72 @@
+package nocodbgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPaginationDrift(t *testing.T) {
+	t.Run("calls the callback and goes on", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		for i := 0; i < 4; i++ {
+			fake.Seed("users", map[string]any{"Index": i})
+		}
+
+		var warnings []PaginationDriftWarning
+		it := client.Table("users").ListRecords().
+			Limit(2).
+			OnPaginationDrift(func(warning PaginationDriftWarning) {
+				warnings = append(warnings, warning)
+			}).
+			Iterate()
+
+		count := 0
+		for it.Next() {
+			if count == 0 {
+				fake.Seed("users", map[string]any{"Index": 4})
+			}
+			count++
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Err() = %v, want nil", err)
+		}
+
+		if count != 5 {
+			t.Errorf("iterated %d records, want 5", count)
+		}
+		want := PaginationDriftWarning{Offset: 2, PreviousTotal: 4, CurrentTotal: 5}
+		if len(warnings) != 1 || warnings[0] != want {
+			t.Errorf("warnings = %+v, want [%+v]", warnings, want)
+		}
+	})
+
+	t.Run("fails with the warning", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		for i := 0; i < 4; i++ {
+			fake.Seed("users", map[string]any{"Index": i})
+		}
+
+		it := client.Table("users").ListRecords().Limit(2).FailOnPaginationDrift().Iterate()
+		it.Next()
+		fake.Seed("users", map[string]any{"Index": 4})
+		for it.Next() {
+		}
+
+		var warning PaginationDriftWarning
+		if !errors.As(it.Err(), &warning) || warning.CurrentTotal != 5 {
+			t.Errorf("Err() = %v, want a PaginationDriftWarning", it.Err())
+		}
+	})
+
+	t.Run("ignores stable results", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		for i := 0; i < 5; i++ {
+			fake.Seed("users", map[string]any{"Index": i})
+		}
+
+		all, err := client.Table("users").ListRecords().Limit(2).FailOnPaginationDrift().ExecuteAll()
+		if err != nil || len(all.List) != 5 {
+			t.Errorf("ExecuteAll() = %d records, %v, want 5 records", len(all.List), err)
+		}
+	})
+}
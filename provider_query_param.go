@@ -0,0 +1,71 @@
+package nocodbgo
+
+import (
+	"net/url"
+	"slices"
+)
+
+// queryParamProvider provides a reusable set of methods for building query with support for arbitrary
+// query parameters, for the parameters of the NocoDB API that have no dedicated method.
+//
+// It is designed to be embedded in builder types to provide consistent query parameter capabilities.
+type queryParamProvider[T any] struct {
+	builder   T
+	rawParams url.Values
+}
+
+// newQueryParamProvider creates a new queryParamProvider instance with the given builder.
+func newQueryParamProvider[T any](builder T) queryParamProvider[T] {
+	return queryParamProvider[T]{
+		builder:   builder,
+		rawParams: url.Values{},
+	}
+}
+
+// clone returns a copy of the queryParamProvider bound to the given builder.
+func (q *queryParamProvider[T]) clone(builder T) queryParamProvider[T] {
+	params := url.Values{}
+	for key, values := range q.rawParams {
+		params[key] = slices.Clone(values)
+	}
+
+	return queryParamProvider[T]{
+		builder:   builder,
+		rawParams: params,
+	}
+}
+
+// apply takes the url.Values and sets on it all the query parameters that have been added to the
+// queryParamProvider instance, replacing the values set by the other providers for the same keys.
+//
+// It returns a new copy of the provided url.Values with the query parameters added.
+func (q *queryParamProvider[T]) apply(query url.Values) url.Values {
+	if query == nil {
+		return query
+	}
+
+	for key, values := range q.rawParams {
+		query[key] = slices.Clone(values)
+	}
+	return query
+}
+
+// WithQueryParam sets a query parameter of the request, for the parameters of the NocoDB API that
+// have no dedicated method yet.
+//
+// The value replaces the one set by any other method for the same parameter. Calling it several
+// times with the same key replaces the previous value.
+//
+// Example:
+//
+//	// Use a parameter not yet supported by the SDK
+//	query = query.WithQueryParam("nestedPage", "2")
+//
+// Documentation:
+//   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
+func (q *queryParamProvider[T]) WithQueryParam(key string, value string) T {
+	if key != "" {
+		q.rawParams.Set(key, value)
+	}
+	return q.builder
+}
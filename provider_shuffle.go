@@ -23,6 +23,14 @@ func newShuffleProvider[T any](builder T) shuffleProvider[T] {
 	}
 }
 
+// clone returns a copy of the shuffleProvider bound to the given builder.
+func (s *shuffleProvider[T]) clone(builder T) shuffleProvider[T] {
+	return shuffleProvider[T]{
+		builder:    builder,
+		rawShuffle: s.rawShuffle,
+	}
+}
+
 // apply takes the url.Values and adds the "shuffle" query parameter to it with the value
 // that has been added to the shuffleProvider instance.
 //
@@ -1,7 +1,10 @@
 package nocodbgo
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 )
 
@@ -15,6 +18,7 @@ import (
 type sortProvider[T any] struct {
 	builder  T
 	rawSorts []string
+	chainErr error // Stores any error in the chain of methods
 }
 
 // newSortProvider creates a new sortProvider instance with the given builder and apply function.
@@ -26,6 +30,15 @@ func newSortProvider[T any](builder T) sortProvider[T] {
 	}
 }
 
+// clone returns a copy of the sortProvider bound to the given builder.
+func (s *sortProvider[T]) clone(builder T) sortProvider[T] {
+	return sortProvider[T]{
+		builder:  builder,
+		rawSorts: slices.Clone(s.rawSorts),
+		chainErr: s.chainErr,
+	}
+}
+
 // apply takes the url.Values and adds the "sort" query parameter to it with all the sorts
 // that have been added to the sortProvider instance.
 //
@@ -52,8 +65,7 @@ func (s *sortProvider[T]) apply(query url.Values) url.Values {
 // Documentation:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 func (s *sortProvider[T]) SortAscBy(column string) T {
-	s.rawSorts = append(s.rawSorts, column)
-	return s.builder
+	return s.addSort(column, column)
 }
 
 // SortDescBy adds a descending sort on the specified column.
@@ -69,6 +81,21 @@ func (s *sortProvider[T]) SortAscBy(column string) T {
 // Documentation:
 //   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
 func (s *sortProvider[T]) SortDescBy(column string) T {
-	s.rawSorts = append(s.rawSorts, "-"+column)
+	return s.addSort(column, "-"+column)
+}
+
+// addSort adds a sort on the given column, recording an error in the chain of methods instead
+// if the column name is empty or contains a comma, which separates the sorts.
+func (s *sortProvider[T]) addSort(column string, sort string) T {
+	if strings.TrimSpace(column) == "" {
+		s.chainErr = errors.Join(s.chainErr, fmt.Errorf("%w: missing column name in sort", ErrInvalidQuery))
+		return s.builder
+	}
+	if strings.Contains(column, ",") {
+		s.chainErr = errors.Join(s.chainErr, fmt.Errorf("%w: column name %q in sort contains a comma", ErrInvalidQuery, column))
+		return s.builder
+	}
+
+	s.rawSorts = append(s.rawSorts, sort)
 	return s.builder
 }
@@ -21,6 +21,14 @@ func newViewIDProvider[T any](builder T) viewIDProvider[T] {
 	}
 }
 
+// clone returns a copy of the viewIDProvider bound to the given builder.
+func (v *viewIDProvider[T]) clone(builder T) viewIDProvider[T] {
+	return viewIDProvider[T]{
+		builder:   builder,
+		rawViewID: v.rawViewID,
+	}
+}
+
 // apply takes the url.Values and adds the "viewId" query parameter to it with the value
 // that has been set on the viewIDProvider instance.
 //
@@ -0,0 +1,111 @@
+package nocodbgo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// queryRegistry stores the named query specs of a client.
+//
+// It is safe for concurrent use.
+type queryRegistry struct {
+	mu    sync.RWMutex
+	specs map[string]QuerySpec
+}
+
+// newQueryRegistry creates an empty query registry.
+func newQueryRegistry() *queryRegistry {
+	return &queryRegistry{specs: map[string]QuerySpec{}}
+}
+
+// RegisterQuery saves a query spec under a name, so it can be executed later against any table
+// with WithSavedQuery. Registering a name again replaces its spec. It returns ErrQueryNameRequired
+// if the name is empty.
+//
+// Example:
+//
+//	err := client.RegisterQuery("active-users", nocodbgo.QuerySpec{
+//		Filters: []string{"(Status,eq,active)"},
+//		Sorts:   []string{"-CreatedAt"},
+//	})
+//
+//	result, err := client.Table("users").ListRecords().WithSavedQuery("active-users").Execute()
+//
+// Parameters:
+//   - name: The name of the query
+//   - spec: The query spec to save
+func (c *Client) RegisterQuery(name string, spec QuerySpec) error {
+	if name == "" {
+		return ErrQueryNameRequired
+	}
+
+	spec.Filters = slices.Clone(spec.Filters)
+	spec.Sorts = slices.Clone(spec.Sorts)
+	spec.Fields = slices.Clone(spec.Fields)
+	if spec.QueryParams != nil {
+		params := make(map[string]string, len(spec.QueryParams))
+		for key, value := range spec.QueryParams {
+			params[key] = value
+		}
+		spec.QueryParams = params
+	}
+
+	c.queries.mu.Lock()
+	defer c.queries.mu.Unlock()
+	c.queries.specs[name] = spec
+
+	return nil
+}
+
+// UnregisterQuery removes the query saved under a name, if any.
+func (c *Client) UnregisterQuery(name string) {
+	c.queries.mu.Lock()
+	defer c.queries.mu.Unlock()
+	delete(c.queries.specs, name)
+}
+
+// RegisteredQuery returns the query spec saved under a name and whether it was found.
+func (c *Client) RegisteredQuery(name string) (QuerySpec, bool) {
+	c.queries.mu.RLock()
+	defer c.queries.mu.RUnlock()
+
+	spec, ok := c.queries.specs[name]
+	return spec, ok
+}
+
+// RegisteredQueries returns the names of the saved queries, sorted.
+func (c *Client) RegisteredQueries() []string {
+	c.queries.mu.RLock()
+	defer c.queries.mu.RUnlock()
+
+	names := make([]string, 0, len(c.queries.specs))
+	for name := range c.queries.specs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// WithSavedQuery applies the query spec saved with RegisterQuery under the given name, like
+// WithSpec. Other methods of the chain can refine the saved query.
+//
+// An unknown name is recorded in the chain of methods and returned by Execute as ErrQueryNotFound.
+//
+// Example:
+//
+//	result, err := table.ListRecords().
+//		WithSavedQuery("active-users").
+//		WhereIsEqualTo("Country", "CL").
+//		Execute()
+func (b *listRecordsBuilder) WithSavedQuery(name string) *listRecordsBuilder {
+	spec, ok := b.table.client.RegisteredQuery(name)
+	if !ok {
+		b.filterProvider.chainErr = errors.Join(b.filterProvider.chainErr, fmt.Errorf("%w: %q", ErrQueryNotFound, name))
+		return b
+	}
+
+	return b.WithSpec(spec)
+}
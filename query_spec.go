@@ -0,0 +1,195 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// querySpecVersion is the version of the JSON encoding of QuerySpec
+const querySpecVersion = 1
+
+// QuerySpec is the serializable configuration of a list query (filters, sorts, fields, view and
+// pagination), so saved searches can be stored in a database or passed between services and
+// executed later against any table with WithSpec.
+//
+// Its JSON encoding includes a version, so specs stored by a version of the SDK keep working
+// with later versions.
+//
+// Example:
+//
+//	spec, err := table.ListRecords().WhereIsEqualTo("Status", "active").SortDescBy("CreatedAt").Spec()
+//	encoded, err := json.Marshal(spec)
+//
+//	// Later, maybe in another service
+//	var saved nocodbgo.QuerySpec
+//	err = json.Unmarshal(encoded, &saved)
+//	result, err := table.ListRecords().WithSpec(saved).Execute()
+type QuerySpec struct {
+	// Filters are the filter expressions, combined with AND (e.g. "(Status,eq,active)")
+	Filters []string
+	// Sorts are the columns to sort by, prefixed with "-" for descending order (e.g. "-CreatedAt")
+	Sorts []string
+	// Fields are the fields to return, all of them if empty
+	Fields []string
+	// IncludeSystemFields returns the system fields along with the Fields
+	IncludeSystemFields bool
+	// ExcludeSystemFields removes the system fields from the returned records
+	ExcludeSystemFields bool
+	// ViewID is the view the query is run on
+	ViewID string
+	// Limit is the maximum number of records, the page size when fetching all the pages
+	Limit int
+	// Offset is the number of records to skip
+	Offset int
+	// Shuffle returns the records in random order
+	Shuffle bool
+	// QueryParams are the query parameters without a dedicated option
+	QueryParams map[string]string
+}
+
+// querySpecJSON is the JSON encoding of QuerySpec
+type querySpecJSON struct {
+	Version             int               `json:"version"`
+	Filters             []string          `json:"filters,omitempty"`
+	Sorts               []string          `json:"sorts,omitempty"`
+	Fields              []string          `json:"fields,omitempty"`
+	IncludeSystemFields bool              `json:"includeSystemFields,omitempty"`
+	ExcludeSystemFields bool              `json:"excludeSystemFields,omitempty"`
+	ViewID              string            `json:"viewId,omitempty"`
+	Limit               int               `json:"limit,omitempty"`
+	Offset              int               `json:"offset,omitempty"`
+	Shuffle             bool              `json:"shuffle,omitempty"`
+	QueryParams         map[string]string `json:"queryParams,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface for QuerySpec.
+func (s QuerySpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(querySpecJSON{
+		Version:             querySpecVersion,
+		Filters:             s.Filters,
+		Sorts:               s.Sorts,
+		Fields:              s.Fields,
+		IncludeSystemFields: s.IncludeSystemFields,
+		ExcludeSystemFields: s.ExcludeSystemFields,
+		ViewID:              s.ViewID,
+		Limit:               s.Limit,
+		Offset:              s.Offset,
+		Shuffle:             s.Shuffle,
+		QueryParams:         s.QueryParams,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for QuerySpec.
+// It rejects specs encoded by a newer, unknown version.
+func (s *QuerySpec) UnmarshalJSON(data []byte) error {
+	var raw querySpecJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal query spec: %w", err)
+	}
+
+	if raw.Version > querySpecVersion {
+		return fmt.Errorf("%w: unsupported query spec version %d", ErrInvalidQuery, raw.Version)
+	}
+
+	*s = QuerySpec{
+		Filters:             raw.Filters,
+		Sorts:               raw.Sorts,
+		Fields:              raw.Fields,
+		IncludeSystemFields: raw.IncludeSystemFields,
+		ExcludeSystemFields: raw.ExcludeSystemFields,
+		ViewID:              raw.ViewID,
+		Limit:               raw.Limit,
+		Offset:              raw.Offset,
+		Shuffle:             raw.Shuffle,
+		QueryParams:         raw.QueryParams,
+	}
+
+	return nil
+}
+
+// Spec returns the configuration of the query as a QuerySpec, including the defaults of the
+// table. It returns the errors recorded in the chain of methods, since an invalid query can't be
+// saved.
+//
+// The search of SearchAllFields is saved as a filter, so it must be given its columns.
+func (b *listRecordsBuilder) Spec() (QuerySpec, error) {
+	if err := errors.Join(b.filterProvider.chainErr, b.sortProvider.chainErr, b.paginationProvider.chainErr); err != nil {
+		return QuerySpec{}, fmt.Errorf("error in the chain of methods: %w", err)
+	}
+	if b.search != nil && len(b.search.columns) == 0 {
+		return QuerySpec{}, fmt.Errorf("%w: a search without columns can't be saved", ErrInvalidQuery)
+	}
+
+	spec := QuerySpec{
+		Filters:             slices.Clone(b.filterProvider.rawFilters),
+		Sorts:               slices.Clone(b.sortProvider.rawSorts),
+		Fields:              slices.Clone(b.fieldProvider.rawFields),
+		IncludeSystemFields: b.fieldProvider.includeSystemFields,
+		ExcludeSystemFields: b.fieldProvider.excludeSystemFields,
+		ViewID:              b.viewIDProvider.rawViewID,
+		Limit:               b.paginationProvider.rawLimit,
+		Offset:              b.paginationProvider.rawOffset,
+		Shuffle:             b.shuffleProvider.rawShuffle,
+	}
+	if b.search != nil {
+		spec.Filters = append(spec.Filters, b.search.filter())
+	}
+	if len(b.queryParamProvider.rawParams) > 0 {
+		spec.QueryParams = map[string]string{}
+		for key := range b.queryParamProvider.rawParams {
+			spec.QueryParams[key] = b.queryParamProvider.rawParams.Get(key)
+		}
+	}
+
+	return spec, nil
+}
+
+// WithSpec applies a QuerySpec to the query. The filters and sorts are added to the ones of the
+// query, and the other options replace the ones of the query when set in the spec.
+//
+// Invalid options of the spec are recorded in the chain of methods like the errors of the
+// equivalent methods.
+func (b *listRecordsBuilder) WithSpec(spec QuerySpec) *listRecordsBuilder {
+	for _, filter := range spec.Filters {
+		b.Where(filter)
+	}
+
+	for _, sort := range spec.Sorts {
+		if column, ok := strings.CutPrefix(sort, "-"); ok {
+			b.SortDescBy(column)
+		} else {
+			b.SortAscBy(sort)
+		}
+	}
+
+	if len(spec.Fields) > 0 {
+		b.ReturnFields(spec.Fields...)
+	}
+	if spec.IncludeSystemFields {
+		b.IncludeSystemFields()
+	}
+	if spec.ExcludeSystemFields {
+		b.ExcludeSystemFields()
+	}
+	if spec.ViewID != "" {
+		b.WithViewId(spec.ViewID)
+	}
+	if spec.Limit != 0 {
+		b.Limit(spec.Limit)
+	}
+	if spec.Offset != 0 {
+		b.Offset(spec.Offset)
+	}
+	if spec.Shuffle {
+		b.Shuffle()
+	}
+
+	for key, value := range spec.QueryParams {
+		b.WithQueryParam(key, value)
+	}
+
+	return b
+}
@@ -0,0 +1,99 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestQuerySpec(t *testing.T) {
+	client, err := NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	original := table.ListRecords().
+		WhereIsEqualTo("Status", "active").
+		WhereIsGreaterThan("Age", "18").
+		SortDescBy("CreatedAt").
+		SortAscBy("Name").
+		ReturnFields("Name", "Email").
+		IncludeSystemFields().
+		WithViewId("vw_1").
+		Limit(50).
+		Offset(100).
+		WithQueryParam("nestedPage", "2")
+
+	spec, err := original.Spec()
+	if err != nil {
+		t.Fatalf("Spec() error = %v", err)
+	}
+
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded QuerySpec
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", encoded, err)
+	}
+	if !reflect.DeepEqual(decoded, spec) {
+		t.Errorf("Unmarshal(Marshal()) = %+v, want %+v", decoded, spec)
+	}
+
+	restored := table.ListRecords().WithSpec(decoded)
+	if got, want := restored.String(), original.String(); got != want {
+		t.Errorf("WithSpec().String() = %q, want %q", got, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"version":99,"filters":["(Age,gt,1)"]}`), &decoded); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Unmarshal() with a newer version error = %v, want %v", err, ErrInvalidQuery)
+	}
+	if _, err := table.ListRecords().WithSpec(QuerySpec{Sorts: []string{"-"}}).Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("WithSpec() with an invalid sort error = %v, want %v", err, ErrInvalidQuery)
+	}
+	if _, err := table.ListRecords().Limit(-1).Spec(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Spec() of an invalid query error = %v, want %v", err, ErrInvalidQuery)
+	}
+}
+
+func TestQueryRegistry(t *testing.T) {
+	client, err := NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := client.RegisterQuery("", QuerySpec{}); !errors.Is(err, ErrQueryNameRequired) {
+		t.Errorf("RegisterQuery() with an empty name error = %v, want %v", err, ErrQueryNameRequired)
+	}
+
+	filters := []string{"(Status,eq,active)"}
+	if err := client.RegisterQuery("active-users", QuerySpec{Filters: filters, Sorts: []string{"-CreatedAt"}}); err != nil {
+		t.Fatalf("RegisterQuery() error = %v", err)
+	}
+	filters[0] = "(Status,eq,deleted)"
+	if err := client.RegisterQuery("adults", QuerySpec{Filters: []string{"(Age,ge,18)"}}); err != nil {
+		t.Fatalf("RegisterQuery() error = %v", err)
+	}
+	if got := client.RegisteredQueries(); !reflect.DeepEqual(got, []string{"active-users", "adults"}) {
+		t.Errorf("RegisteredQueries() = %v", got)
+	}
+
+	for _, tableID := range []string{"users", "customers"} {
+		got := client.Table(tableID).ListRecords().WithSavedQuery("active-users").WhereIsEqualTo("Country", "CL").String()
+		want := client.Table(tableID).ListRecords().Where("(Status,eq,active)").SortDescBy("CreatedAt").WhereIsEqualTo("Country", "CL").String()
+		if got != want {
+			t.Errorf("WithSavedQuery() on %s = %q, want %q", tableID, got, want)
+		}
+	}
+
+	client.UnregisterQuery("active-users")
+	if _, ok := client.RegisteredQuery("active-users"); ok {
+		t.Errorf("RegisteredQuery() after UnregisterQuery() found the query")
+	}
+	if _, err := client.Table("users").ListRecords().WithSavedQuery("active-users").Execute(); !errors.Is(err, ErrQueryNotFound) {
+		t.Errorf("WithSavedQuery() with an unknown name error = %v, want %v", err, ErrQueryNotFound)
+	}
+}
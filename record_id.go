@@ -0,0 +1,151 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// RecordID is the identifier of a record, an integer for the tables with an auto-increment
+// primary key and a string for the tables with a text primary key, such as a UUID.
+//
+// It's returned by the create operations and accepted by all the methods that take a record ID,
+// which also accept plain integers and strings. It's encoded to JSON as a number or a string.
+//
+// Example:
+//
+//	id, err := table.CreateRecord(data).Execute()
+//	// Handle error
+//	record, err := table.ReadRecord(id).Execute()
+//
+//	// For tables with an integer primary key
+//	if number, ok := id.Int64(); ok {
+//		fmt.Println("created record", number)
+//	}
+type RecordID struct {
+	number int64
+	text   string
+	isText bool
+}
+
+// IntID returns the RecordID of an integer primary key.
+func IntID(id int64) RecordID {
+	return RecordID{number: id}
+}
+
+// StringID returns the RecordID of a text primary key.
+func StringID(id string) RecordID {
+	return RecordID{text: id, isText: true}
+}
+
+// Int64 returns the ID as an integer and whether it is one, which is also the case for string IDs
+// holding an integer.
+func (id RecordID) Int64() (int64, bool) {
+	if !id.isText {
+		return id.number, true
+	}
+	number, err := strconv.ParseInt(id.text, 10, 64)
+	return number, err == nil
+}
+
+// IsZero reports whether the ID is empty, 0 or "".
+func (id RecordID) IsZero() bool {
+	return id.number == 0 && id.text == ""
+}
+
+// String implements the fmt.Stringer interface for RecordID, returning the ID as it appears in
+// the paths of the API.
+func (id RecordID) String() string {
+	if id.isText {
+		return id.text
+	}
+	return strconv.FormatInt(id.number, 10)
+}
+
+// value returns the ID as an int64 or a string, nil if it's empty.
+func (id RecordID) value() any {
+	switch {
+	case id.IsZero():
+		return nil
+	case id.isText:
+		return id.text
+	}
+	return id.number
+}
+
+// MarshalJSON implements the json.Marshaler interface for RecordID.
+func (id RecordID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.value())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for RecordID.
+// It accepts numbers, strings and null.
+func (id *RecordID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*id = RecordID{}
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*id = StringID(text)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRecordID, data)
+	}
+	parsed, err := parseRecordID(number)
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// parseRecordID converts a record ID given as any integer type, a string, a RecordID or a map or
+// struct containing an "Id" field to a RecordID, returning the zero RecordID for zero IDs.
+func parseRecordID(value any) (RecordID, error) {
+	id, err := toRecordID(value)
+	if err != nil {
+		return RecordID{}, err
+	}
+
+	switch v := id.(type) {
+	case nil:
+		return RecordID{}, nil
+	case int64:
+		return IntID(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return RecordID{}, fmt.Errorf("%w: %d overflows int64", ErrInvalidRecordID, v)
+		}
+		return IntID(int64(v)), nil
+	case string:
+		return StringID(v), nil
+	}
+	return RecordID{}, fmt.Errorf("%w: unsupported type %T", ErrInvalidRecordID, value)
+}
+
+// parseRecordIDs converts the provided value into a slice of RecordIDs, accepting the same values
+// as toRecordIDs. Zero IDs are skipped.
+func parseRecordIDs(value any) ([]RecordID, error) {
+	recordIDs, err := toRecordIDs(value)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]RecordID, 0, len(recordIDs))
+	for i, recordID := range recordIDs {
+		id, err := parseRecordID(recordID)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,83 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseRecordID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    RecordID
+		wantErr bool
+	}{
+		{name: "int", value: 5, want: IntID(5)},
+		{name: "int32", value: int32(6), want: IntID(6)},
+		{name: "large int64", value: int64(1) << 62, want: IntID(1 << 62)},
+		{name: "string", value: "0b6e0b3a-7c1f-4a4e-9f5e-2d1c3b4a5f6e", want: StringID("0b6e0b3a-7c1f-4a4e-9f5e-2d1c3b4a5f6e")},
+		{name: "json number", value: json.Number("9007199254740993"), want: IntID(9007199254740993)},
+		{name: "record ID", value: StringID("abc"), want: StringID("abc")},
+		{name: "map with Id", value: map[string]any{"Id": float64(7)}, want: IntID(7)},
+		{name: "zero", value: 0, want: RecordID{}},
+		{name: "empty string", value: "", want: RecordID{}},
+		{name: "uint64 overflow", value: uint64(1) << 63, wantErr: true},
+		{name: "unsupported type", value: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRecordID(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRecordID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRecordID) {
+				t.Errorf("parseRecordID() error = %v, want %v", err, ErrInvalidRecordID)
+			}
+			if got != tt.want {
+				t.Errorf("parseRecordID() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordIDJSON(t *testing.T) {
+	type record struct {
+		ID RecordID `json:"Id"`
+	}
+
+	tests := []struct {
+		json string
+		want RecordID
+	}{
+		{json: `{"Id":9007199254740993}`, want: IntID(9007199254740993)},
+		{json: `{"Id":"d7c5a1e2"}`, want: StringID("d7c5a1e2")},
+		{json: `{"Id":null}`, want: RecordID{}},
+	}
+
+	for _, tt := range tests {
+		var decoded record
+		if err := json.Unmarshal([]byte(tt.json), &decoded); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", tt.json, err)
+		}
+		if decoded.ID != tt.want {
+			t.Errorf("Unmarshal(%s) = %#v, want %#v", tt.json, decoded.ID, tt.want)
+		}
+
+		encoded, err := json.Marshal(decoded)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if string(encoded) != tt.json {
+			t.Errorf("Marshal() = %s, want %s", encoded, tt.json)
+		}
+	}
+
+	if number, ok := StringID("42").Int64(); !ok || number != 42 {
+		t.Errorf("Int64() = %d, %v, want 42, true", number, ok)
+	}
+	if _, ok := StringID("d7c5a1e2").Int64(); ok {
+		t.Error("Int64() of a UUID = true, want false")
+	}
+}
@@ -0,0 +1,32 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// RenderedQuery is the request a query builder sends when executed, rendered without executing it
+type RenderedQuery struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Path is the path of the request, without the base URL of the client
+	Path string
+	// Query are the query parameters of the request
+	Query url.Values
+}
+
+// String returns the method of the request followed by its path and encoded query parameters.
+func (q RenderedQuery) String() string {
+	if len(q.Query) == 0 {
+		return fmt.Sprintf("%s %s", q.Method, q.Path)
+	}
+	return fmt.Sprintf("%s %s?%s", q.Method, q.Path, q.Query.Encode())
+}
+
+// renderedString returns the string of a rendered query, or the error that prevented rendering it.
+func renderedString(query RenderedQuery, err error) string {
+	if err != nil {
+		return fmt.Sprintf("invalid query: %v", err)
+	}
+	return query.String()
+}
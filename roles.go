@@ -0,0 +1,158 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BaseRole is the role of a user in a base, which sets what the user can do with its tables and records
+type BaseRole string
+
+const (
+	// BaseRoleOwner can do everything, including deleting the base
+	BaseRoleOwner BaseRole = "owner"
+	// BaseRoleCreator can change the tables, columns and views of the base and invite users
+	BaseRoleCreator BaseRole = "creator"
+	// BaseRoleEditor can create, update and delete records
+	BaseRoleEditor BaseRole = "editor"
+	// BaseRoleCommenter can read records and comment on them
+	BaseRoleCommenter BaseRole = "commenter"
+	// BaseRoleViewer can only read records
+	BaseRoleViewer BaseRole = "viewer"
+	// BaseRoleNoAccess can't access the base
+	BaseRoleNoAccess BaseRole = "no-access"
+)
+
+// baseRoleRanks orders the base roles from the one with the fewest permissions to the owner
+var baseRoleRanks = map[BaseRole]int{
+	BaseRoleNoAccess:  0,
+	BaseRoleViewer:    1,
+	BaseRoleCommenter: 2,
+	BaseRoleEditor:    3,
+	BaseRoleCreator:   4,
+	BaseRoleOwner:     5,
+}
+
+// IsValid reports whether the role is one of the base roles of NocoDB.
+func (r BaseRole) IsValid() bool {
+	_, ok := baseRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether the role has all the permissions of the given role. Unknown roles have
+// no permissions.
+//
+// Example:
+//
+//	nocodbgo.BaseRoleEditor.AtLeast(nocodbgo.BaseRoleCommenter) // true
+func (r BaseRole) AtLeast(role BaseRole) bool {
+	if !r.IsValid() || !role.IsValid() {
+		return false
+	}
+	return baseRoleRanks[r] >= baseRoleRanks[role]
+}
+
+// hasBaseRoleBuilder is used to build a check of the role of the user in a base with a fluent API
+type hasBaseRoleBuilder struct {
+	client  *Client
+	baseID  string
+	minRole BaseRole
+
+	contextProvider[*hasBaseRoleBuilder]
+}
+
+// HasBaseRole initializes a builder that reports whether the user of the API token has at least
+// the given role in the base, so applications can check up front that their token can perform the
+// operations they need instead of failing halfway. Super admins have every role in every base.
+//
+// Parameters:
+//   - baseID:  The identifier of the base (e.g. "p_xxxxxxxxxxxxxx").
+//   - minRole: The role the user needs, a role with more permissions is also accepted.
+//
+// Example:
+//
+//	ok, err := client.HasBaseRole("p_xxxxxxxxxxxxxx", nocodbgo.BaseRoleEditor).WithContext(ctx).Execute()
+//	// Handle error
+//	if !ok {
+//		log.Fatal("the API token can't edit the records of the base")
+//	}
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Auth/operation/auth-me
+//   - https://docs.nocodb.com/roles-and-permissions/roles-permissions-overview
+func (c *Client) HasBaseRole(baseID string, minRole BaseRole) *hasBaseRoleBuilder {
+	b := &hasBaseRoleBuilder{
+		client:  c,
+		baseID:  baseID,
+		minRole: minRole,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *hasBaseRoleBuilder) Execute() (bool, error) {
+	if b.baseID == "" {
+		return false, ErrBaseIDRequired
+	}
+	if !b.minRole.IsValid() {
+		return false, fmt.Errorf("%w: %q", ErrInvalidRole, b.minRole)
+	}
+
+	query := url.Values{}
+	query.Set("base_id", b.baseID)
+
+	respBody, err := b.client.request(b.contextProvider.ctx, http.MethodGet, "/api/v2/auth/user/me", nil, query)
+	if err != nil {
+		return false, withOperation(fmt.Errorf("failed to read user info: %w", err), "HasBaseRole", "", RecordID{})
+	}
+
+	var response struct {
+		Roles     any `json:"roles"`
+		BaseRoles any `json:"base_roles"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return false, fmt.Errorf("failed to unmarshal user info response: %w", err)
+	}
+
+	for _, role := range parseRoles(response.Roles) {
+		if OrgRole(role) == OrgRoleSuperAdmin {
+			return true, nil
+		}
+	}
+	for _, role := range parseRoles(response.BaseRoles) {
+		if BaseRole(role).AtLeast(b.minRole) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// parseRoles returns the names of the roles of a user, which the API returns either as a comma
+// separated string or as an object with the enabled roles set to true.
+func parseRoles(value any) []string {
+	var roles []string
+
+	switch v := value.(type) {
+	case string:
+		for _, role := range strings.Split(v, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+	case map[string]any:
+		for role, enabled := range v {
+			if metaBool(enabled) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles
+}
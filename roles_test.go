@@ -0,0 +1,85 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBaseRoleAtLeast(t *testing.T) {
+	tests := []struct {
+		role BaseRole
+		min  BaseRole
+		want bool
+	}{
+		{BaseRoleOwner, BaseRoleCreator, true},
+		{BaseRoleEditor, BaseRoleEditor, true},
+		{BaseRoleEditor, BaseRoleCommenter, true},
+		{BaseRoleViewer, BaseRoleCommenter, false},
+		{BaseRoleNoAccess, BaseRoleViewer, false},
+		{"guest", BaseRoleNoAccess, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.AtLeast(tt.min); got != tt.want {
+			t.Errorf("%q.AtLeast(%q) = %v, want %v", tt.role, tt.min, got, tt.want)
+		}
+	}
+}
+
+func TestHasBaseRole(t *testing.T) {
+	responses := map[string]string{
+		"p_edit":   `{"id":"us_1","roles":"org-level-viewer","base_roles":{"editor":true}}`,
+		"p_view":   `{"id":"us_1","roles":{"org-level-creator":true},"base_roles":"viewer"}`,
+		"p_none":   `{"id":"us_1","roles":"org-level-viewer"}`,
+		"p_shared": `{"id":"us_1","roles":"org-level-viewer","base_roles":{"owner":false,"commenter":1}}`,
+		"p_super":  `{"id":"us_1","roles":"super,org-level-creator"}`,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/auth/user/me", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(responses[r.URL.Query().Get("base_id")]))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		baseID string
+		min    BaseRole
+		want   bool
+	}{
+		{"p_edit", BaseRoleEditor, true},
+		{"p_edit", BaseRoleCreator, false},
+		{"p_view", BaseRoleViewer, true},
+		{"p_view", BaseRoleCommenter, false},
+		{"p_none", BaseRoleViewer, false},
+		{"p_shared", BaseRoleCommenter, true},
+		{"p_shared", BaseRoleEditor, false},
+		{"p_super", BaseRoleOwner, true},
+	}
+
+	for _, tt := range tests {
+		got, err := client.HasBaseRole(tt.baseID, tt.min).WithContext(ctx).Execute()
+		if err != nil {
+			t.Fatalf("HasBaseRole(%q, %q) error = %v", tt.baseID, tt.min, err)
+		}
+		if got != tt.want {
+			t.Errorf("HasBaseRole(%q, %q) = %v, want %v", tt.baseID, tt.min, got, tt.want)
+		}
+	}
+
+	if _, err := client.HasBaseRole("p_edit", "admin").Execute(); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("HasBaseRole() error = %v, want %v", err, ErrInvalidRole)
+	}
+	if _, err := client.HasBaseRole("", BaseRoleViewer).Execute(); !errors.Is(err, ErrBaseIDRequired) {
+		t.Errorf("HasBaseRole() error = %v, want %v", err, ErrBaseIDRequired)
+	}
+}
@@ -0,0 +1,315 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+// TablePlan is the plan of a table of a base
+type TablePlan struct {
+	// Title is the title of the table
+	Title string
+	// TableID is the identifier of the live table, empty for the tables to create
+	TableID string
+	// Columns are the changes to the columns of the table
+	Columns Plan
+	// Options are the select options to add, by the title of their column
+	Options map[string][]nocodbgo.SchemaDocumentOption
+	// Formulas are the formula columns to add, after the other columns
+	Formulas []nocodbgo.SchemaDocumentColumn
+	// Views are the views to create, with their filters and sorts
+	Views []nocodbgo.SchemaDocumentView
+}
+
+// IsEmpty reports whether the plan has no changes.
+func (p TablePlan) IsEmpty() bool {
+	return p.TableID != "" && p.Columns.IsEmpty() && len(p.Options) == 0 && len(p.Formulas) == 0 && len(p.Views) == 0
+}
+
+// BasePlan is the list of changes that make the tables of a base match an exported base schema
+type BasePlan struct {
+	// Tables are the plans of the tables of the document, in its order
+	Tables []TablePlan
+}
+
+// IsEmpty reports whether the plan has no changes.
+func (p BasePlan) IsEmpty() bool {
+	for _, table := range p.Tables {
+		if !table.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// PlanBase reads the live tables of the base and plans the changes that make them match a schema
+// exported with nocodbgo.Base.ExportSchema, without changing anything.
+//
+// Tables are matched by title. The tables missing in the base are created, and for every table
+// the missing columns are added and the columns with a different type changed as described in
+// Diff, the missing select options added and the missing formula columns and views created.
+// Nothing is removed, and existing formulas and views are left as they are, so importing the same
+// document again plans no changes.
+//
+// Links, lookups and rollups are not planned, since they depend on the identifiers of other
+// tables, and the primary key of the created tables is always an "Id" column.
+//
+// Example:
+//
+//	var document nocodbgo.BaseSchemaDocument
+//	err := json.Unmarshal(data, &document)
+//	// Handle error
+//	plan, err := schema.PlanBase(ctx, client.Base("p_xxxxxxxxxxxxxx"), document)
+//	// Handle error
+//	err = plan.Apply(ctx, client.Base("p_xxxxxxxxxxxxxx"))
+func PlanBase(ctx context.Context, base *nocodbgo.Base, document nocodbgo.BaseSchemaDocument) (BasePlan, error) {
+	tables, err := base.ListTables().WithContext(ctx).Execute()
+	if err != nil {
+		return BasePlan{}, fmt.Errorf("failed to list tables: %w", err)
+	}
+
+	tableIDs := map[string]string{}
+	for _, table := range tables {
+		tableIDs[table.Title] = table.ID
+	}
+
+	plan := BasePlan{}
+	planned := map[string]bool{}
+
+	for _, desired := range document.Tables {
+		if desired.Table == "" {
+			return BasePlan{}, fmt.Errorf("%w: table without title", ErrInvalidDefinition)
+		}
+		if planned[desired.Table] {
+			return BasePlan{}, fmt.Errorf("%w: duplicated table %q", ErrInvalidDefinition, desired.Table)
+		}
+		planned[desired.Table] = true
+
+		var live nocodbgo.TableSchema
+		var views []nocodbgo.ViewInfo
+		if tableID, ok := tableIDs[desired.Table]; ok {
+			table := base.Table(tableID)
+			if live, err = table.ReadSchema().WithContext(ctx).Execute(); err != nil {
+				return BasePlan{}, fmt.Errorf("failed to read schema of table %q: %w", desired.Table, err)
+			}
+			if views, err = table.ListViews().WithContext(ctx).Execute(); err != nil {
+				return BasePlan{}, fmt.Errorf("failed to list views of table %q: %w", desired.Table, err)
+			}
+		}
+
+		tablePlan, err := planTable(live, views, desired)
+		if err != nil {
+			return BasePlan{}, fmt.Errorf("failed to plan table %q: %w", desired.Table, err)
+		}
+		tablePlan.TableID = tableIDs[desired.Table]
+
+		plan.Tables = append(plan.Tables, tablePlan)
+	}
+
+	return plan, nil
+}
+
+// planTable compares the live schema and views of a table, empty for a table to create, against
+// its exported schema.
+func planTable(live nocodbgo.TableSchema, views []nocodbgo.ViewInfo, desired nocodbgo.SchemaDocument) (TablePlan, error) {
+	columns, err := Diff(live, FromDocument(desired))
+	if err != nil {
+		return TablePlan{}, err
+	}
+
+	plan := TablePlan{Title: desired.Table, Columns: columns}
+
+	for _, column := range desired.Columns {
+		current, exists := live.Column(column.Title)
+
+		if column.Type == nocodbgo.ColumnTypeFormula && !exists {
+			plan.Formulas = append(plan.Formulas, column)
+			continue
+		}
+
+		var missing []nocodbgo.SchemaDocumentOption
+		for _, option := range column.Options {
+			if !hasSelectOption(current.SelectOptions(), option.Title) {
+				missing = append(missing, option)
+			}
+		}
+		if len(missing) > 0 {
+			if plan.Options == nil {
+				plan.Options = map[string][]nocodbgo.SchemaDocumentOption{}
+			}
+			plan.Options[column.Title] = missing
+		}
+	}
+
+	for _, view := range desired.Views {
+		if !hasView(views, view.Title) {
+			plan.Views = append(plan.Views, view)
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply applies the plans of the tables to the base in order through the meta API: it creates the
+// missing tables, applies the changes to their columns, adds the select options, creates the
+// formula columns and finally creates the views with their filters and sorts.
+//
+// The operation is not atomic, if a change fails the previous changes are not reverted and the
+// returned error describes the failed table.
+func (p BasePlan) Apply(ctx context.Context, base *nocodbgo.Base) error {
+	for _, tablePlan := range p.Tables {
+		if err := tablePlan.apply(ctx, base); err != nil {
+			return fmt.Errorf("failed to apply plan of table %q: %w", tablePlan.Title, err)
+		}
+	}
+
+	return nil
+}
+
+// apply applies the plan of a table, creating it first if needed.
+func (p TablePlan) apply(ctx context.Context, base *nocodbgo.Base) error {
+	if p.IsEmpty() {
+		return nil
+	}
+
+	var table *nocodbgo.Table
+	if p.TableID != "" {
+		table = base.Table(p.TableID)
+	} else {
+		created, err := base.CreateTable(p.Title).WithContext(ctx).Execute()
+		if err != nil {
+			return err
+		}
+		table = created
+	}
+
+	if err := p.Columns.Apply(ctx, table); err != nil {
+		return err
+	}
+
+	for _, column := range p.Formulas {
+		if _, err := table.CreateFormulaColumn(column.Title, column.Formula).WithContext(ctx).Execute(); err != nil {
+			return fmt.Errorf("failed to create formula column %q: %w", column.Title, err)
+		}
+	}
+
+	live, err := table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to read table schema: %w", err)
+	}
+
+	for _, column := range live.Columns {
+		options, ok := p.Options[column.Title]
+		if !ok {
+			continue
+		}
+		update := table.UpdateSelectOptions(column.ID).WithContext(ctx)
+		for _, option := range options {
+			update.Add(option.Title, option.Color)
+		}
+		if _, err := update.Execute(); err != nil {
+			return err
+		}
+	}
+
+	if len(p.Views) == 0 {
+		return nil
+	}
+
+	// A created table already has its default view
+	views, err := table.ListViews().WithContext(ctx).Execute()
+	if err != nil {
+		return err
+	}
+
+	for _, desired := range p.Views {
+		if hasView(views, desired.Title) {
+			continue
+		}
+		if err := createView(ctx, table, live, desired); err != nil {
+			return fmt.Errorf("failed to create view %q: %w", desired.Title, err)
+		}
+	}
+
+	return nil
+}
+
+// createView creates a view of a table with its filters and sorts.
+func createView(ctx context.Context, table *nocodbgo.Table, live nocodbgo.TableSchema, desired nocodbgo.SchemaDocumentView) error {
+	view, err := table.CreateView(desired.Title, desired.Type).WithContext(ctx).Execute()
+	if err != nil {
+		return err
+	}
+
+	if err := createFilters(ctx, view, live, desired.Filters, ""); err != nil {
+		return err
+	}
+
+	for _, sort := range desired.Sorts {
+		column, ok := live.Column(sort.Column)
+		if !ok {
+			return fmt.Errorf("%w: sort column %q", nocodbgo.ErrColumnNotFound, sort.Column)
+		}
+		if err := view.CreateSort(nocodbgo.ViewSort{ColumnID: column.ID, Direction: sort.Direction}).WithContext(ctx).Execute(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createFilters creates the filters of a view, and the filters of the groups inside them.
+func createFilters(ctx context.Context, view *nocodbgo.View, live nocodbgo.TableSchema, filters []nocodbgo.SchemaDocumentFilter, parentID string) error {
+	for _, desired := range filters {
+		filter := nocodbgo.ViewFilter{
+			ParentID:        parentID,
+			ComparisonOp:    desired.ComparisonOp,
+			ComparisonSubOp: desired.ComparisonSubOp,
+			LogicalOp:       desired.LogicalOp,
+			Value:           desired.Value,
+			IsGroup:         desired.Column == "",
+		}
+		if !filter.IsGroup {
+			column, ok := live.Column(desired.Column)
+			if !ok {
+				return fmt.Errorf("%w: filter column %q", nocodbgo.ErrColumnNotFound, desired.Column)
+			}
+			filter.ColumnID = column.ID
+		}
+
+		created, err := view.CreateFilter(filter).WithContext(ctx).Execute()
+		if err != nil {
+			return err
+		}
+
+		if filter.IsGroup {
+			if err := createFilters(ctx, view, live, desired.Children, created.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasSelectOption reports whether the options include one with the given title.
+func hasSelectOption(options []nocodbgo.SelectOption, title string) bool {
+	for _, option := range options {
+		if option.Title == title {
+			return true
+		}
+	}
+	return false
+}
+
+// hasView reports whether the views include one with the given title.
+func hasView(views []nocodbgo.ViewInfo, title string) bool {
+	for _, view := range views {
+		if view.Title == title {
+			return true
+		}
+	}
+	return false
+}
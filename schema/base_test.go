@@ -0,0 +1,230 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+// fakeBase is an in memory base served through the meta API endpoints used by the base plans
+type fakeBase struct {
+	mu       sync.Mutex
+	requests []string
+	tables   []map[string]any
+	columns  map[string][]map[string]any
+	views    map[string][]map[string]any
+}
+
+func (f *fakeBase) handler() http.Handler {
+	write := func(w http.ResponseWriter, v any) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	decode := func(r *http.Request) map[string]any {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		return body
+	}
+	schemaOf := func(tableID string) map[string]any {
+		for _, table := range f.tables {
+			if table["id"] == tableID {
+				return map[string]any{"id": tableID, "title": table["title"], "columns": f.columns[tableID]}
+			}
+		}
+		return nil
+	}
+	columnByID := func(columnID string) map[string]any {
+		for _, columns := range f.columns {
+			for _, column := range columns {
+				if column["id"] == columnID {
+					return column
+				}
+			}
+		}
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/bases/p_prod/tables", func(w http.ResponseWriter, r *http.Request) {
+		write(w, map[string]any{"list": f.tables})
+	})
+	mux.HandleFunc("POST /api/v2/meta/bases/p_prod/tables", func(w http.ResponseWriter, r *http.Request) {
+		body := decode(r)
+		title := body["title"].(string)
+		tableID := "md_" + strings.ToLower(title)
+		f.requests = append(f.requests, "create table "+title)
+		f.tables = append(f.tables, map[string]any{"id": tableID, "title": title})
+		f.columns[tableID] = []map[string]any{{"id": "cl_" + tableID + "_id", "title": "Id", "uidt": "ID", "pk": true}}
+		f.views[tableID] = []map[string]any{{"id": "vw_" + tableID, "title": title, "type": 3}}
+		write(w, map[string]any{"id": tableID})
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/{id}", func(w http.ResponseWriter, r *http.Request) {
+		write(w, schemaOf(r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/{id}/views", func(w http.ResponseWriter, r *http.Request) {
+		write(w, map[string]any{"list": f.views[r.PathValue("id")]})
+	})
+	mux.HandleFunc("POST /api/v2/meta/tables/{id}/columns", func(w http.ResponseWriter, r *http.Request) {
+		body := decode(r)
+		tableID := r.PathValue("id")
+		body["id"] = "cl_" + strings.ToLower(body["title"].(string))
+		f.requests = append(f.requests, "create column "+body["title"].(string)+" "+body["uidt"].(string))
+		f.columns[tableID] = append(f.columns[tableID], body)
+		write(w, schemaOf(tableID))
+	})
+	mux.HandleFunc("GET /api/v2/meta/columns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		write(w, columnByID(r.PathValue("id")))
+	})
+	mux.HandleFunc("PATCH /api/v2/meta/columns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body := decode(r)
+		var titles []string
+		for _, option := range body["colOptions"].(map[string]any)["options"].([]any) {
+			titles = append(titles, option.(map[string]any)["title"].(string))
+		}
+		f.requests = append(f.requests, "update options "+r.PathValue("id")+" "+strings.Join(titles, ","))
+		columnByID(r.PathValue("id"))["colOptions"] = body["colOptions"]
+		write(w, map[string]any{})
+	})
+	mux.HandleFunc("POST /api/v2/meta/tables/{id}/grids", func(w http.ResponseWriter, r *http.Request) {
+		body := decode(r)
+		viewID := "vw_" + strings.ToLower(body["title"].(string))
+		f.requests = append(f.requests, "create view "+body["title"].(string))
+		f.views[r.PathValue("id")] = append(f.views[r.PathValue("id")], map[string]any{"id": viewID, "title": body["title"], "type": 3})
+		write(w, map[string]any{"id": viewID})
+	})
+	mux.HandleFunc("POST /api/v2/meta/views/{id}/filters", func(w http.ResponseWriter, r *http.Request) {
+		body := decode(r)
+		request := "create filter " + r.PathValue("id")
+		for _, field := range []string{"fk_parent_id", "fk_column_id", "comparison_op"} {
+			if body[field] != nil {
+				request += " " + str(body[field])
+			}
+		}
+		if body["is_group"] == true {
+			request += " group"
+		}
+		f.requests = append(f.requests, request)
+		write(w, map[string]any{"id": "fi_" + str(len(f.requests))})
+	})
+	mux.HandleFunc("POST /api/v2/meta/views/{id}/sorts", func(w http.ResponseWriter, r *http.Request) {
+		body := decode(r)
+		f.requests = append(f.requests, "create sort "+r.PathValue("id")+" "+str(body["fk_column_id"])+" "+str(body["direction"]))
+		write(w, map[string]any{})
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func str(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return strings.Trim(string(b), `"`)
+}
+
+func TestPlanBase(t *testing.T) {
+	fake := &fakeBase{
+		tables: []map[string]any{{"id": "md_tags", "title": "Tags"}},
+		columns: map[string][]map[string]any{"md_tags": {
+			{"id": "cl_tags_id", "title": "Id", "uidt": "ID", "pk": true},
+			{"id": "cl_color", "title": "Color", "uidt": "SingleSelect", "colOptions": map[string]any{"options": []any{map[string]any{"title": "Red"}}}},
+		}},
+		views: map[string][]map[string]any{"md_tags": {{"id": "vw_md_tags", "title": "Tags", "type": 3}}},
+	}
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	client, err := nocodbgo.NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	base := client.Base("p_prod")
+	ctx := context.Background()
+
+	document := nocodbgo.BaseSchemaDocument{Version: 1, Tables: []nocodbgo.SchemaDocument{
+		{
+			Table: "Tags",
+			Columns: []nocodbgo.SchemaDocumentColumn{
+				{Title: "Id", Type: nocodbgo.ColumnTypeID, PrimaryKey: true},
+				{Title: "Color", Type: nocodbgo.ColumnTypeSingleSelect, Options: []nocodbgo.SchemaDocumentOption{{Title: "Red"}, {Title: "Blue"}}},
+			},
+			Views: []nocodbgo.SchemaDocumentView{{Title: "Tags", Type: "grid"}},
+		},
+		{
+			Table: "Products",
+			Columns: []nocodbgo.SchemaDocumentColumn{
+				{Title: "Id", Type: nocodbgo.ColumnTypeID, PrimaryKey: true},
+				{Title: "Name", Type: nocodbgo.ColumnTypeSingleLineText},
+				{Title: "Price", Type: nocodbgo.ColumnTypeDecimal},
+				{Title: "Total", Type: nocodbgo.ColumnTypeFormula, Formula: "{Price} * 2"},
+				{Title: "Supplier", Type: nocodbgo.ColumnTypeLinks},
+			},
+			Views: []nocodbgo.SchemaDocumentView{
+				{Title: "Products", Type: "grid"},
+				{
+					Title: "Cheap",
+					Type:  "grid",
+					Filters: []nocodbgo.SchemaDocumentFilter{
+						{Column: "Price", ComparisonOp: "lt", LogicalOp: "and", Value: 10},
+						{LogicalOp: "and", Children: []nocodbgo.SchemaDocumentFilter{{Column: "Name", ComparisonOp: "notblank", LogicalOp: "or"}}},
+					},
+					Sorts: []nocodbgo.SchemaDocumentSort{{Column: "Total", Direction: "desc"}},
+				},
+			},
+		},
+	}}
+
+	plan, err := PlanBase(ctx, base, document)
+	if err != nil {
+		t.Fatalf("PlanBase() error = %v", err)
+	}
+	if len(plan.Tables) != 2 || plan.Tables[0].TableID != "md_tags" || plan.Tables[1].TableID != "" {
+		t.Fatalf("PlanBase() = %+v", plan)
+	}
+	if want := map[string][]nocodbgo.SchemaDocumentOption{"Color": {{Title: "Blue"}}}; !reflect.DeepEqual(plan.Tables[0].Options, want) {
+		t.Errorf("PlanBase() options = %+v, want %+v", plan.Tables[0].Options, want)
+	}
+	if len(plan.Tables[0].Views) != 0 || len(plan.Tables[1].Formulas) != 1 || len(plan.Tables[1].Columns.Steps) != 2 {
+		t.Errorf("PlanBase() = %+v", plan)
+	}
+
+	if err := plan.Apply(ctx, base); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	want := []string{
+		"update options cl_color Red,Blue",
+		"create table Products",
+		"create column Name SingleLineText",
+		"create column Price Decimal",
+		"create column Total Formula",
+		"create view Cheap",
+		"create filter vw_cheap cl_price lt",
+		"create filter vw_cheap group",
+		"create filter vw_cheap fi_8 cl_name notblank",
+		"create sort vw_cheap cl_total desc",
+	}
+	if !reflect.DeepEqual(fake.requests, want) {
+		t.Errorf("Apply() requests =\n%q\nwant\n%q", fake.requests, want)
+	}
+
+	// Importing the same document again changes nothing
+	plan, err = PlanBase(ctx, base, document)
+	if err != nil {
+		t.Fatalf("PlanBase() error = %v", err)
+	}
+	if !plan.IsEmpty() {
+		t.Errorf("PlanBase() after Apply() = %+v, want an empty plan", plan)
+	}
+}
@@ -0,0 +1,257 @@
+// Package schema compares a desired definition of the columns of a NocoDB table against its live
+// schema, and plans the migration that makes the table match it: the columns to add, to rename
+// and to change the type of.
+//
+// The definition can be written as a Definition or derived from the struct the records are
+// decoded into. Columns of the table that are not in the definition are left as they are, and
+// the system columns, like the primary key, are never changed.
+//
+// PlanBase does the same for all the tables of a base and their views, from a schema exported
+// with nocodbgo.Base.ExportSchema, to promote the structure of a base between environments.
+//
+// Example:
+//
+//	type Product struct {
+//		nocodbgo.SystemFields
+//		Name  string           `json:"Name"`
+//		Price nocodbgo.Decimal `json:"Price" nocodb:",type=Currency,renamed=Cost"`
+//		Notes string           `json:"Notes" nocodb:",type=LongText"`
+//	}
+//
+//	definition, err := schema.FromStruct(Product{})
+//	// Handle error
+//	plan, err := schema.PlanMigration(ctx, client.Table("m_xxxxxxxxxxxxxx"), definition)
+//	// Handle error
+//	for _, step := range plan.Steps {
+//		fmt.Println(step)
+//	}
+//	err = plan.Apply(ctx, client.Table("m_xxxxxxxxxxxxxx"))
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+// ErrInvalidDefinition is returned when a definition can't be planned, like a definition with
+// duplicated titles or unknown column types
+var ErrInvalidDefinition = errors.New("invalid schema definition")
+
+// Definition is the desired schema of a table
+type Definition struct {
+	// Columns are the desired columns of the table
+	Columns []Column
+}
+
+// Column is a desired column of a table
+type Column struct {
+	// Title is the title of the column
+	Title string
+	// Type is the type of the column
+	Type nocodbgo.ColumnType
+	// RenamedFrom are the previous titles of the column, so a live column with one of them is
+	// renamed instead of adding a new column
+	RenamedFrom []string
+	// Meta contains type specific settings sent when the column is added or its type changed
+	// (e.g. the precision of a Decimal column)
+	Meta map[string]any
+}
+
+// FromDocument returns the definition of the columns of a schema exported with
+// nocodbgo.Table.ExportSchema. The primary key and the computed columns, like formulas and
+// links, are left out since a plan can't create them.
+//
+// Example:
+//
+//	var document nocodbgo.SchemaDocument
+//	err := json.Unmarshal(data, &document)
+//	// Handle error
+//	plan, err := schema.PlanMigration(ctx, table, schema.FromDocument(document))
+func FromDocument(document nocodbgo.SchemaDocument) Definition {
+	definition := Definition{}
+
+	for _, column := range document.Columns {
+		if column.PrimaryKey || column.Type.IsReadOnly() || column.Type.IsLink() {
+			continue
+		}
+		definition.Columns = append(definition.Columns, Column{
+			Title: column.Title,
+			Type:  column.Type,
+			Meta:  column.Meta,
+		})
+	}
+
+	return definition
+}
+
+// Types of the struct fields whose column type is inferred
+var (
+	systemFieldsType = reflect.TypeFor[nocodbgo.SystemFields]()
+	dateType         = reflect.TypeFor[nocodbgo.Date]()
+	timestampType    = reflect.TypeFor[nocodbgo.Timestamp]()
+	timeType         = reflect.TypeFor[time.Time]()
+	decimalType      = reflect.TypeFor[nocodbgo.Decimal]()
+	durationType     = reflect.TypeFor[nocodbgo.Duration]()
+	geoPointType     = reflect.TypeFor[nocodbgo.GeoPoint]()
+	optionalType     = reflect.TypeFor[nocodbgo.Optional[string]]()
+)
+
+// FromStruct returns the definition of the columns of a struct, or pointer to struct, that
+// records are decoded into.
+//
+// The columns are named like when decoding records, after the `nocodb:"Column"` tag or the name
+// in the json tag. The type is inferred from the type of the field (strings are SingleLineText,
+// integers are Number, floats and nocodbgo.Decimal are Decimal, bools are Checkbox, nocodbgo.Date
+// is Date and times are DateTime), looking through pointers and nocodbgo.Optional, and it is set
+// with the "type" option of the nocodb tag for the other fields or to override it. The "renamed" option, which can be repeated, sets the previous
+// titles of the column.
+//
+// Embedded nocodbgo.SystemFields are ignored, since the system columns are managed by NocoDB.
+//
+// Example:
+//
+//	type Product struct {
+//		Name  string `json:"Name" nocodb:",renamed=Title"`
+//		Notes string `json:"Notes" nocodb:",type=LongText"`
+//		Tags  []string `json:"Tags" nocodb:",type=MultiSelect"`
+//	}
+func FromStruct(v any) (Definition, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return Definition{}, fmt.Errorf("%w: expected a struct, got %T", ErrInvalidDefinition, v)
+	}
+
+	columns, err := structColumns(t)
+	if err != nil {
+		return Definition{}, err
+	}
+
+	return Definition{Columns: columns}, nil
+}
+
+// structColumns returns the columns of the fields of a struct, including the fields of embedded
+// structs.
+func structColumns(t reflect.Type) ([]Column, error) {
+	var columns []Column
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			if field.Type == systemFieldsType {
+				continue
+			}
+			embedded, err := structColumns(field.Type)
+			if err != nil {
+				return nil, err
+			}
+			columns = append(columns, embedded...)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		column := Column{Title: name}
+		if column.Title == "" {
+			column.Title = field.Name
+		}
+
+		title, options, _ := strings.Cut(field.Tag.Get("nocodb"), ",")
+		if title != "" {
+			column.Title = title
+		}
+
+		jsonEncoded := false
+		for _, option := range strings.Split(options, ",") {
+			key, value, _ := strings.Cut(option, "=")
+			switch key {
+			case "json":
+				jsonEncoded = true
+			case "type":
+				column.Type = nocodbgo.ColumnType(value)
+			case "renamed":
+				column.RenamedFrom = append(column.RenamedFrom, value)
+			}
+		}
+
+		if column.Type == "" {
+			column.Type = inferColumnType(field.Type, jsonEncoded)
+		}
+		if column.Type == "" {
+			return nil, fmt.Errorf("%w: can't infer the column type of field %s of type %s, set it with the type option of the nocodb tag", ErrInvalidDefinition, field.Name, field.Type)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// inferColumnType returns the column type of a struct field type, or an empty type if it can't
+// be inferred.
+func inferColumnType(t reflect.Type, jsonEncoded bool) nocodbgo.ColumnType {
+	if jsonEncoded {
+		return nocodbgo.ColumnTypeLongText
+	}
+
+	for {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		} else if value := optionalValueType(t); value != nil {
+			t = value
+		} else {
+			break
+		}
+	}
+
+	switch t {
+	case dateType:
+		return nocodbgo.ColumnTypeDate
+	case timestampType, timeType:
+		return nocodbgo.ColumnTypeDateTime
+	case decimalType:
+		return nocodbgo.ColumnTypeDecimal
+	case durationType:
+		return nocodbgo.ColumnTypeDuration
+	case geoPointType:
+		return nocodbgo.ColumnTypeGeoData
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return nocodbgo.ColumnTypeSingleLineText
+	case reflect.Bool:
+		return nocodbgo.ColumnTypeCheckbox
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return nocodbgo.ColumnTypeNumber
+	case reflect.Float32, reflect.Float64:
+		return nocodbgo.ColumnTypeDecimal
+	}
+
+	return ""
+}
+
+// optionalValueType returns the type of the value of a nocodbgo.Optional type, or nil if the type
+// is not an Optional.
+func optionalValueType(t reflect.Type) reflect.Type {
+	if t.PkgPath() != optionalType.PkgPath() || !strings.HasPrefix(t.Name(), "Optional[") {
+		return nil
+	}
+	get, _ := t.MethodByName("Get")
+	return get.Type.Out(0)
+}
@@ -0,0 +1,236 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+// StepKind is the kind of change of a migration step
+type StepKind string
+
+const (
+	// StepRename renames a live column
+	StepRename StepKind = "rename"
+	// StepRetype changes the type of a live column
+	StepRetype StepKind = "retype"
+	// StepAdd adds a new column
+	StepAdd StepKind = "add"
+)
+
+// Step is a change of a migration plan
+type Step struct {
+	// Kind is the kind of change
+	Kind StepKind
+	// ColumnID is the identifier of the live column, empty for the columns to add
+	ColumnID string
+	// Title is the title of the column after the step
+	Title string
+	// OldTitle is the title of the column before a rename
+	OldTitle string
+	// Type is the type of the column after the step
+	Type nocodbgo.ColumnType
+	// OldType is the type of the column before a type change
+	OldType nocodbgo.ColumnType
+	// Meta contains the type specific settings of the column to add or to change the type of
+	Meta map[string]any
+}
+
+// String returns a human readable description of the step.
+func (s Step) String() string {
+	switch s.Kind {
+	case StepRename:
+		return fmt.Sprintf("rename column %q to %q", s.OldTitle, s.Title)
+	case StepRetype:
+		return fmt.Sprintf("change type of column %q from %s to %s", s.Title, s.OldType, s.Type)
+	case StepAdd:
+		return fmt.Sprintf("add column %q of type %s", s.Title, s.Type)
+	}
+	return fmt.Sprintf("%s column %q", s.Kind, s.Title)
+}
+
+// Plan is the ordered list of changes that make a table match a definition
+type Plan struct {
+	// Steps are the changes, in the order they are applied: renames first, so the titles they
+	// free can be used by the other steps, then type changes and finally the columns to add
+	Steps []Step
+}
+
+// IsEmpty reports whether the plan has no changes.
+func (p Plan) IsEmpty() bool {
+	return len(p.Steps) == 0
+}
+
+// PlanMigration reads the live schema of the table and plans the changes that make it match the
+// definition, without changing anything.
+func PlanMigration(ctx context.Context, table *nocodbgo.Table, definition Definition) (Plan, error) {
+	live, err := table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to read table schema: %w", err)
+	}
+
+	return Diff(live, definition)
+}
+
+// Diff compares the live schema of a table against a definition and plans the changes that make
+// the table match it.
+//
+// A desired column matches the live column with its title, unless another desired column was
+// renamed from it, or else the live column with one of the titles it was renamed from. Matched
+// columns with a different type have their type changed, except the system columns and the
+// computed columns, like formulas or links, which can't be changed by a plan.
+// Desired columns without a match are added.
+func Diff(live nocodbgo.TableSchema, definition Definition) (Plan, error) {
+	if err := validate(definition); err != nil {
+		return Plan{}, err
+	}
+
+	// The titles other columns were renamed from belong to those columns, even if a desired column
+	// has the same title, like when two columns swap their titles
+	claimedBy := map[string]string{}
+	for _, desired := range definition.Columns {
+		for _, oldTitle := range desired.RenamedFrom {
+			claimedBy[oldTitle] = desired.Title
+		}
+	}
+
+	var renames, retypes, adds []Step
+	matched := map[string]bool{}
+
+	for _, desired := range definition.Columns {
+		current, ok := live.Column(desired.Title)
+		if claimer, claimed := claimedBy[desired.Title]; ok && claimed && claimer != desired.Title {
+			ok = false
+		}
+		if !ok {
+			for _, oldTitle := range desired.RenamedFrom {
+				if column, found := live.Column(oldTitle); found && !matched[column.ID] {
+					current, ok = column, true
+					break
+				}
+			}
+		}
+
+		if !ok {
+			if desired.Type.IsReadOnly() || desired.Type.IsLink() {
+				return Plan{}, fmt.Errorf("%w: column %q of type %s can't be added by a plan", ErrInvalidDefinition, desired.Title, desired.Type)
+			}
+			adds = append(adds, Step{Kind: StepAdd, Title: desired.Title, Type: desired.Type, Meta: desired.Meta})
+			continue
+		}
+
+		if matched[current.ID] {
+			return Plan{}, fmt.Errorf("%w: columns %q and %q match the same live column", ErrInvalidDefinition, current.Title, desired.Title)
+		}
+		matched[current.ID] = true
+
+		if current.Title != desired.Title {
+			renames = append(renames, Step{Kind: StepRename, ColumnID: current.ID, Title: desired.Title, OldTitle: current.Title, Type: current.UIDT})
+		}
+
+		if current.UIDT == desired.Type || current.System || current.PrimaryKey {
+			continue
+		}
+		if current.UIDT.IsReadOnly() || current.UIDT.IsLink() || desired.Type.IsReadOnly() || desired.Type.IsLink() {
+			return Plan{}, fmt.Errorf("%w: column %q can't be changed from %s to %s by a plan", ErrInvalidDefinition, desired.Title, current.UIDT, desired.Type)
+		}
+		retypes = append(retypes, Step{Kind: StepRetype, ColumnID: current.ID, Title: desired.Title, Type: desired.Type, OldType: current.UIDT, Meta: desired.Meta})
+	}
+
+	renames, err := orderRenames(live, renames)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	return Plan{Steps: slices.Concat(renames, retypes, adds)}, nil
+}
+
+// validate checks that the columns of the definition have a title and a known type, and that the
+// titles are not repeated.
+func validate(definition Definition) error {
+	titles := map[string]bool{}
+
+	for _, column := range definition.Columns {
+		if column.Title == "" {
+			return fmt.Errorf("%w: column without title", ErrInvalidDefinition)
+		}
+		if titles[column.Title] {
+			return fmt.Errorf("%w: duplicated column %q", ErrInvalidDefinition, column.Title)
+		}
+		titles[column.Title] = true
+
+		if !column.Type.IsValid() {
+			return fmt.Errorf("%w: column %q has unknown type %q", ErrInvalidDefinition, column.Title, column.Type)
+		}
+	}
+
+	return nil
+}
+
+// orderRenames orders the renames so no column is renamed to a title still used by a column
+// renamed later, returning an error if the renames swap titles in a cycle or a rename takes the
+// title of a column that is not renamed.
+func orderRenames(live nocodbgo.TableSchema, renames []Step) ([]Step, error) {
+	titles := map[string]string{}
+	for _, column := range live.Columns {
+		titles[column.Title] = column.ID
+	}
+
+	ordered := make([]Step, 0, len(renames))
+	for len(renames) > 0 {
+		pending := renames[:0]
+		for _, rename := range renames {
+			if _, taken := titles[rename.Title]; taken {
+				pending = append(pending, rename)
+				continue
+			}
+			delete(titles, rename.OldTitle)
+			titles[rename.Title] = rename.ColumnID
+			ordered = append(ordered, rename)
+		}
+
+		if len(pending) == len(renames) {
+			return nil, fmt.Errorf("%w: can't rename column %q to %q, the title is in use", ErrInvalidDefinition, pending[0].OldTitle, pending[0].Title)
+		}
+		renames = pending
+	}
+
+	return ordered, nil
+}
+
+// Apply applies the steps of the plan to the table in order through the meta API.
+//
+// The operation is not atomic, if a step fails the previous steps are not reverted and the
+// returned error describes the failed step.
+func (p Plan) Apply(ctx context.Context, table *nocodbgo.Table) error {
+	for i, step := range p.Steps {
+		var err error
+
+		switch step.Kind {
+		case StepRename:
+			err = table.UpdateColumn(step.ColumnID).Rename(step.Title).WithContext(ctx).Execute()
+		case StepRetype:
+			update := table.UpdateColumn(step.ColumnID).ChangeType(step.Type).WithContext(ctx)
+			if step.Meta != nil {
+				update.WithMeta(step.Meta)
+			}
+			err = update.Execute()
+		case StepAdd:
+			create := table.CreateColumn(step.Title, step.Type).WithContext(ctx)
+			if step.Meta != nil {
+				create.WithMeta(step.Meta)
+			}
+			_, err = create.Execute()
+		default:
+			err = fmt.Errorf("unknown step kind %q", step.Kind)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to apply step %d (%s): %w", i+1, step, err)
+		}
+	}
+
+	return nil
+}
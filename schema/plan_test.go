@@ -0,0 +1,205 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo"
+)
+
+// liveSchema is the live schema of the products table of the tests
+const liveSchema = `{"id":"md_products","title":"Products","columns":[
+	{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+	{"id":"cl_title","title":"Title","uidt":"SingleLineText"},
+	{"id":"cl_cost","title":"Cost","uidt":"Decimal"},
+	{"id":"cl_notes","title":"Notes","uidt":"SingleLineText"},
+	{"id":"cl_total","title":"Total","uidt":"Formula"},
+	{"id":"cl_legacy","title":"Legacy","uidt":"SingleLineText"}
+]}`
+
+type product struct {
+	nocodbgo.SystemFields
+	Name     string           `json:"Name" nocodb:",renamed=Title"`
+	Price    nocodbgo.Decimal `json:"Price" nocodb:",type=Currency,renamed=Cost"`
+	Notes    string           `json:"Notes" nocodb:",type=LongText"`
+	Stock    int              `json:"Stock"`
+	Released nocodbgo.Date    `json:"Released" nocodb:"Release Date"`
+	Total    float64          `json:"Total" nocodb:",type=Formula"`
+	internal string
+}
+
+func TestFromStruct(t *testing.T) {
+	definition, err := FromStruct(&product{})
+	if err != nil {
+		t.Fatalf("FromStruct() error = %v", err)
+	}
+
+	want := Definition{Columns: []Column{
+		{Title: "Name", Type: nocodbgo.ColumnTypeSingleLineText, RenamedFrom: []string{"Title"}},
+		{Title: "Price", Type: nocodbgo.ColumnTypeCurrency, RenamedFrom: []string{"Cost"}},
+		{Title: "Notes", Type: nocodbgo.ColumnTypeLongText},
+		{Title: "Stock", Type: nocodbgo.ColumnTypeNumber},
+		{Title: "Release Date", Type: nocodbgo.ColumnTypeDate},
+		{Title: "Total", Type: nocodbgo.ColumnTypeFormula},
+	}}
+	if !reflect.DeepEqual(definition, want) {
+		t.Errorf("FromStruct() =\n%+v\nwant\n%+v", definition, want)
+	}
+
+	type update struct {
+		Name     nocodbgo.Optional[string]            `json:"Name"`
+		Price    nocodbgo.Optional[*nocodbgo.Decimal] `json:"Price"`
+		Released *nocodbgo.Optional[nocodbgo.Date]    `json:"Released"`
+		Active   nocodbgo.Optional[bool]              `json:"Active"`
+	}
+	definition, err = FromStruct(update{})
+	if err != nil {
+		t.Fatalf("FromStruct() error = %v", err)
+	}
+	want = Definition{Columns: []Column{
+		{Title: "Name", Type: nocodbgo.ColumnTypeSingleLineText},
+		{Title: "Price", Type: nocodbgo.ColumnTypeDecimal},
+		{Title: "Released", Type: nocodbgo.ColumnTypeDate},
+		{Title: "Active", Type: nocodbgo.ColumnTypeCheckbox},
+	}}
+	if !reflect.DeepEqual(definition, want) {
+		t.Errorf("FromStruct() =\n%+v\nwant\n%+v", definition, want)
+	}
+
+	type unknown struct {
+		Tags []string `json:"Tags"`
+	}
+	if _, err := FromStruct(unknown{}); !errors.Is(err, ErrInvalidDefinition) {
+		t.Errorf("FromStruct() error = %v, want %v", err, ErrInvalidDefinition)
+	}
+}
+
+func TestFromDocument(t *testing.T) {
+	document := nocodbgo.SchemaDocument{Columns: []nocodbgo.SchemaDocumentColumn{
+		{Title: "Id", Type: nocodbgo.ColumnTypeID, PrimaryKey: true},
+		{Title: "Total", Type: nocodbgo.ColumnTypeFormula, Formula: "{Price} * 2"},
+		{Title: "Price", Type: nocodbgo.ColumnTypeDecimal, Meta: map[string]any{"precision": 2}},
+	}}
+
+	want := Definition{Columns: []Column{{Title: "Price", Type: nocodbgo.ColumnTypeDecimal, Meta: map[string]any{"precision": 2}}}}
+	if got := FromDocument(document); !reflect.DeepEqual(got, want) {
+		t.Errorf("FromDocument() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	var live nocodbgo.TableSchema
+	if err := json.Unmarshal([]byte(liveSchema), &live); err != nil {
+		t.Fatal(err)
+	}
+	definition, err := FromStruct(product{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := Diff(live, definition)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+
+	want := []Step{
+		{Kind: StepRename, ColumnID: "cl_title", Title: "Name", OldTitle: "Title", Type: nocodbgo.ColumnTypeSingleLineText},
+		{Kind: StepRename, ColumnID: "cl_cost", Title: "Price", OldTitle: "Cost", Type: nocodbgo.ColumnTypeDecimal},
+		{Kind: StepRetype, ColumnID: "cl_cost", Title: "Price", Type: nocodbgo.ColumnTypeCurrency, OldType: nocodbgo.ColumnTypeDecimal},
+		{Kind: StepRetype, ColumnID: "cl_notes", Title: "Notes", Type: nocodbgo.ColumnTypeLongText, OldType: nocodbgo.ColumnTypeSingleLineText},
+		{Kind: StepAdd, Title: "Stock", Type: nocodbgo.ColumnTypeNumber},
+		{Kind: StepAdd, Title: "Release Date", Type: nocodbgo.ColumnTypeDate},
+	}
+	if !reflect.DeepEqual(plan.Steps, want) {
+		t.Errorf("Diff() =\n%+v\nwant\n%+v", plan.Steps, want)
+	}
+
+	// Renames that reuse titles are ordered so every title is free when it is taken, and a cycle of
+	// renames is rejected below
+	swap := Definition{Columns: []Column{
+		{Title: "Legacy", Type: nocodbgo.ColumnTypeSingleLineText, RenamedFrom: []string{"Notes"}},
+		{Title: "Archive", Type: nocodbgo.ColumnTypeSingleLineText, RenamedFrom: []string{"Legacy"}},
+	}}
+	plan, err = Diff(live, swap)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(plan.Steps) != 2 || plan.Steps[0].Title != "Archive" || plan.Steps[1].Title != "Legacy" {
+		t.Errorf("Diff() = %v", plan.Steps)
+	}
+
+	for _, invalid := range []Definition{
+		{Columns: []Column{{Title: "Name", Type: "Text"}}},
+		{Columns: []Column{{Title: "Name", Type: nocodbgo.ColumnTypeNumber}, {Title: "Name", Type: nocodbgo.ColumnTypeNumber}}},
+		{Columns: []Column{{Title: "Total", Type: nocodbgo.ColumnTypeNumber}}},
+		{Columns: []Column{{Title: "Tax", Type: nocodbgo.ColumnTypeRollup}}},
+		{Columns: []Column{
+			{Title: "Legacy", Type: nocodbgo.ColumnTypeSingleLineText, RenamedFrom: []string{"Notes"}},
+			{Title: "Notes", Type: nocodbgo.ColumnTypeSingleLineText, RenamedFrom: []string{"Legacy"}},
+		}},
+	} {
+		if _, err := Diff(live, invalid); !errors.Is(err, ErrInvalidDefinition) {
+			t.Errorf("Diff(%+v) error = %v, want %v", invalid, err, ErrInvalidDefinition)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	var requests []string
+	titles := map[string]string{"cl_title": "Title", "cl_notes": "Notes"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/md_products", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(liveSchema))
+	})
+	mux.HandleFunc("GET /api/v2/meta/columns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"` + r.PathValue("id") + `","title":"` + titles[r.PathValue("id")] + `","uidt":"SingleLineText"}`))
+	})
+	mux.HandleFunc("PATCH /api/v2/meta/columns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, "update "+r.PathValue("id")+" "+body["title"].(string)+" "+body["uidt"].(string))
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/tables/md_products/columns", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, "create "+body["title"].(string)+" "+body["uidt"].(string))
+		_, _ = w.Write([]byte(`{"id":"md_products","columns":[{"id":"cl_new","title":"` + body["title"].(string) + `"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := nocodbgo.NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("md_products")
+
+	definition := Definition{Columns: []Column{
+		{Title: "Name", Type: nocodbgo.ColumnTypeSingleLineText, RenamedFrom: []string{"Title"}},
+		{Title: "Notes", Type: nocodbgo.ColumnTypeLongText},
+		{Title: "Stock", Type: nocodbgo.ColumnTypeNumber},
+	}}
+	plan, err := PlanMigration(context.Background(), table, definition)
+	if err != nil {
+		t.Fatalf("PlanMigration() error = %v", err)
+	}
+	if err := plan.Apply(context.Background(), table); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	want := []string{
+		"update cl_title Name SingleLineText",
+		"update cl_notes Notes LongText",
+		"create Stock Number",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("Apply() requests = %q, want %q", requests, want)
+	}
+}
@@ -0,0 +1,60 @@
+package nocodbgo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// listGalleryRecordsBuilder is used to build a list query for a gallery view with a fluent API
+type listGalleryRecordsBuilder struct {
+	view *SharedView
+
+	contextProvider[*listGalleryRecordsBuilder]
+	filterProvider[*listGalleryRecordsBuilder]
+	sortProvider[*listGalleryRecordsBuilder]
+	paginationProvider[*listGalleryRecordsBuilder]
+	fieldProvider[*listGalleryRecordsBuilder]
+}
+
+// ListGalleryRecords lists the records of a gallery view (or any other shared view) in the order
+// and with the filters configured in the view.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Public/operation/public-data-list
+func (v *SharedView) ListGalleryRecords() *listGalleryRecordsBuilder {
+	b := &listGalleryRecordsBuilder{
+		view: v,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+	b.sortProvider = newSortProvider(b)
+	b.paginationProvider = newPaginationProvider(b)
+	b.fieldProvider = newFieldProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listGalleryRecordsBuilder) Execute() (ListResponse, error) {
+	if err := errors.Join(b.filterProvider.chainErr, b.sortProvider.chainErr, b.paginationProvider.chainErr); err != nil {
+		return ListResponse{}, fmt.Errorf("error in the chain of methods: %w", err)
+	}
+
+	query := url.Values{}
+	query = b.filterProvider.apply(query)
+	query = b.sortProvider.apply(query)
+	query = b.paginationProvider.apply(query)
+	query = b.view.client.applyPageSize(query, b.paginationProvider.rawLimit)
+	query = b.fieldProvider.apply(query)
+
+	path := fmt.Sprintf("/api/v2/public/shared-view/%s/rows", b.view.uuid)
+	response, err := b.view.listSharedView(b.contextProvider.ctx, path, query)
+	if err != nil {
+		return ListResponse{}, fmt.Errorf("failed to list gallery records: %w", err)
+	}
+	b.fieldProvider.stripSystemFields(response.List...)
+
+	return response, nil
+}
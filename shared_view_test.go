@@ -0,0 +1,66 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSharedViewKanbanAndCalendar(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/public/shared-view/abc/group/cl_status", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("xc-password") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"msg":"invalid password"}`))
+			return
+		}
+		if r.URL.Query().Get("limit") != "5" {
+			t.Errorf("limit = %q, want 5", r.URL.Query().Get("limit"))
+		}
+		_, _ = w.Write([]byte(`[
+			{"key":"Todo","value":{"list":[{"Id":1}],"pageInfo":{"totalRows":7,"page":1,"pageSize":5}}},
+			{"key":null,"value":{"list":[],"pageInfo":{"totalRows":0}}}
+		]`))
+	})
+	mux.HandleFunc("GET /api/v2/public/calendar-view/abc/", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("from_date") != "2025-03-01 00:00:00+00:00" || query.Get("to_date") != "2025-04-01 00:00:00+00:00" {
+			t.Errorf("date range = %q - %q", query.Get("from_date"), query.Get("to_date"))
+		}
+		_, _ = w.Write([]byte(`{"list":[{"Id":2}],"pageInfo":{"totalRows":1}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	view := client.SharedView("abc")
+
+	if _, err := view.ListKanbanRecords("cl_status").Execute(); err == nil {
+		t.Error("ListKanbanRecords() without password error = nil, want error")
+	}
+
+	kanban, err := view.WithPassword("secret").ListKanbanRecords("cl_status").Limit(5).Execute()
+	if err != nil {
+		t.Fatalf("ListKanbanRecords() error = %v", err)
+	}
+	todo, ok := kanban.Group("Todo")
+	if !ok || len(todo.Records.List) != 1 || todo.Records.PageInfo.TotalRows != 7 {
+		t.Errorf("Group(Todo) = %+v, %v", todo, ok)
+	}
+	if _, ok := kanban.Group(nil); !ok {
+		t.Error("Group(nil) not found, want group of records without value")
+	}
+
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	calendar, err := view.ListCalendarRecords(from, from.AddDate(0, 1, 0)).Execute()
+	if err != nil {
+		t.Fatalf("ListCalendarRecords() error = %v", err)
+	}
+	if len(calendar.List) != 1 {
+		t.Errorf("ListCalendarRecords() returned %d records, want 1", len(calendar.List))
+	}
+}
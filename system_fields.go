@@ -0,0 +1,133 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Names of the system fields NocoDB adds to every table
+const (
+	SystemFieldID        = "Id"
+	SystemFieldCreatedAt = "CreatedAt"
+	SystemFieldUpdatedAt = "UpdatedAt"
+	SystemFieldCreatedBy = "CreatedBy"
+	SystemFieldUpdatedBy = "UpdatedBy"
+)
+
+// systemFieldNames are the names of all the system fields in the order NocoDB returns them
+var systemFieldNames = []string{
+	SystemFieldID,
+	SystemFieldCreatedAt,
+	SystemFieldUpdatedAt,
+	SystemFieldCreatedBy,
+	SystemFieldUpdatedBy,
+}
+
+// SystemFields contains the system fields NocoDB adds to every table.
+//
+// It is meant to be embedded in the structs used to decode records, so they don't have to
+// declare the system fields and parse their timestamps themselves.
+//
+// Example:
+//
+//	type User struct {
+//		nocodbgo.SystemFields
+//		Name  string `json:"Name"`
+//		Email string `json:"Email"`
+//	}
+//
+//	var user User
+//	err := response.DecodeInto(&user)
+//	fmt.Println(user.ID, user.CreatedAt.Format(time.RFC3339))
+type SystemFields struct {
+	// ID is the primary key of the record
+	ID int64 `json:"Id,omitempty"`
+	// CreatedAt is the time the record was created
+	CreatedAt Timestamp `json:"CreatedAt"`
+	// UpdatedAt is the time the record was last updated, zero if it was never updated
+	UpdatedAt Timestamp `json:"UpdatedAt"`
+	// CreatedBy is the user that created the record
+	CreatedBy *Collaborator `json:"CreatedBy,omitempty"`
+	// UpdatedBy is the user that last updated the record
+	UpdatedBy *Collaborator `json:"UpdatedBy,omitempty"`
+}
+
+// timestampLayouts are the layouts NocoDB uses for timestamps depending on the database backend
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Timestamp is a time.Time that decodes the timestamp formats NocoDB returns for DateTime,
+// CreatedTime and LastModifiedTime fields.
+//
+// Timestamps without a time zone are interpreted as UTC. A null value decodes into the zero time.
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal timestamp: %w", err)
+	}
+
+	parsed, err := parseTimestamp(value)
+	if err != nil {
+		return err
+	}
+
+	*t = Timestamp{Time: parsed}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for Timestamp.
+// It encodes the zero time as null so it is not written into DateTime fields.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// parseTimestamp parses a timestamp in any of the formats NocoDB returns.
+func parseTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("failed to parse timestamp %q", value)
+}
+
+// dropEmptySystemFields removes the read-only system fields without a value from a record built
+// from a struct, so embedding SystemFields doesn't send null timestamps on create and update.
+func dropEmptySystemFields(record map[string]any) {
+	for _, field := range systemFieldNames[1:] {
+		if value, ok := record[field]; ok && value == nil {
+			delete(record, field)
+		}
+	}
+}
+
+// isSystemField reports whether the field is one of the system fields NocoDB adds to every table.
+func isSystemField(field string) bool {
+	return slices.Contains(systemFieldNames, field)
+}
@@ -0,0 +1,100 @@
+package nocodbgo
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSystemFieldsDecode(t *testing.T) {
+	type User struct {
+		SystemFields
+		Name string `json:"Name"`
+	}
+
+	tests := []struct {
+		name      string
+		createdAt string
+		want      time.Time
+	}{
+		{name: "postgres", createdAt: "2024-03-05 10:20:30+00:00", want: time.Date(2024, 3, 5, 10, 20, 30, 0, time.UTC)},
+		{name: "postgres short offset", createdAt: "2024-03-05 12:20:30+02", want: time.Date(2024, 3, 5, 10, 20, 30, 0, time.UTC)},
+		{name: "rfc3339", createdAt: "2024-03-05T10:20:30.000Z", want: time.Date(2024, 3, 5, 10, 20, 30, 0, time.UTC)},
+		{name: "sqlite without zone", createdAt: "2024-03-05 10:20:30", want: time.Date(2024, 3, 5, 10, 20, 30, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{
+				"Id":        7,
+				"Name":      "John",
+				"CreatedAt": tt.createdAt,
+				"UpdatedAt": nil,
+				"CreatedBy": map[string]any{"id": "us_1", "email": "john@example.com", "display_name": "John"},
+			}
+
+			var user User
+			if err := decodeInto(data, &user); err != nil {
+				t.Fatalf("decodeInto() error = %v", err)
+			}
+			if user.ID != 7 || user.Name != "John" || user.CreatedBy == nil || user.CreatedBy.Email != "john@example.com" {
+				t.Errorf("decodeInto() = %+v", user)
+			}
+			if !user.CreatedAt.Equal(tt.want) {
+				t.Errorf("CreatedAt = %v, want %v", user.CreatedAt, tt.want)
+			}
+			if !user.UpdatedAt.IsZero() || user.UpdatedBy != nil {
+				t.Errorf("UpdatedAt = %v, UpdatedBy = %v, want zero", user.UpdatedAt, user.UpdatedBy)
+			}
+		})
+	}
+
+	var user User
+	if err := decodeInto(map[string]any{"CreatedAt": "yesterday"}, &user); err == nil {
+		t.Error("decodeInto() with invalid timestamp error = nil, want error")
+	}
+}
+
+func TestSystemFieldsEncode(t *testing.T) {
+	type User struct {
+		SystemFields
+		Name string `json:"Name"`
+	}
+
+	data, err := structToMap(User{Name: "John"})
+	if err != nil {
+		t.Fatalf("structToMap() error = %v", err)
+	}
+	if len(data) != 1 || data["Name"] != "John" {
+		t.Errorf("structToMap() = %v, want only the Name field", data)
+	}
+}
+
+func TestFieldProviderSystemFields(t *testing.T) {
+	b := &listRecordsBuilder{}
+	b.fieldProvider = newFieldProvider(b)
+
+	b.ReturnFields("Name", "CreatedAt").IncludeSystemFields()
+	got := b.fieldProvider.apply(url.Values{}).Get("fields")
+	if want := "Name,CreatedAt,Id,UpdatedAt,CreatedBy,UpdatedBy"; got != want {
+		t.Errorf("IncludeSystemFields() fields = %q, want %q", got, want)
+	}
+
+	b.ExcludeSystemFields()
+	got = b.fieldProvider.apply(url.Values{}).Get("fields")
+	if want := "Name"; got != want {
+		t.Errorf("ExcludeSystemFields() fields = %q, want %q", got, want)
+	}
+
+	record := map[string]any{"Id": 1, "Name": "John", "CreatedAt": "2024-03-05 10:20:30+00:00", "UpdatedBy": nil}
+	b.fieldProvider.stripSystemFields(record)
+	keys := make([]string, 0, len(record))
+	for key := range record {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"Id", "Name"}) {
+		t.Errorf("stripSystemFields() = %v, want Id and Name", record)
+	}
+}
@@ -0,0 +1,402 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// APIVersion is a version of the NocoDB data API
+type APIVersion string
+
+const (
+	// APIVersionV1 is the data API of the NocoDB versions before the v2 API was released
+	APIVersionV1 APIVersion = "v1"
+	// APIVersionV2 is the current data API, used by default
+	APIVersionV2 APIVersion = "v2"
+	// APIVersionV3 is the data API that nests the fields of the records under "fields" and
+	// paginates by page number
+	APIVersionV3 APIVersion = "v3"
+)
+
+// v1DataOrg is the organization segment of the v1 data API paths, which is always "noco"
+const v1DataOrg = "noco"
+
+// baseIDOf returns the base of the table, required by the v1 and v3 data APIs.
+func (t *Table) baseIDOf() (string, error) {
+	baseID := t.baseID
+	if baseID == "" {
+		baseID = t.client.defaultBaseID
+	}
+	if baseID == "" {
+		return "", fmt.Errorf("%w: the %s data API requires the base of the table", ErrBaseIDRequired, t.client.apiVersion)
+	}
+	return baseID, nil
+}
+
+// lookupBaseID returns the base of the table, reading it from the table schema if the table was
+// not obtained from a Base.
+func (t *Table) lookupBaseID(ctx context.Context) (string, error) {
+	if t.baseID != "" {
+		return t.baseID, nil
+	}
+
+	schema, err := t.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to read table schema: %w", err)
+	}
+	return schema.BaseID, nil
+}
+
+// tablePath returns the path of the table in the v1 or v3 data API, using the v1 bulk endpoints
+// if bulk is true.
+func (t *Table) tablePath(bulk bool) (string, error) {
+	baseID, err := t.baseIDOf()
+	if err != nil {
+		return "", err
+	}
+
+	if t.client.apiVersion == APIVersionV3 {
+		return fmt.Sprintf("/api/v3/data/%s/%s", url.PathEscape(baseID), url.PathEscape(t.tableID)), nil
+	}
+
+	prefix := "/api/v1/db/data"
+	if bulk {
+		prefix += "/bulk"
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", prefix, v1DataOrg, url.PathEscape(baseID), url.PathEscape(t.tableID)), nil
+}
+
+// recordsPath returns the path of the endpoint that lists the records of the table, or creates,
+// updates and deletes them in bulk if bulk is true.
+func (t *Table) recordsPath(bulk bool) (string, error) {
+	switch t.client.apiVersion {
+	case APIVersionV1:
+		return t.tablePath(bulk)
+	case APIVersionV3:
+		path, err := t.tablePath(bulk)
+		return path + "/records", err
+	}
+	return fmt.Sprintf("/api/v2/tables/%s/records", t.tableID), nil
+}
+
+// recordPath returns the path of the endpoint that reads a single record of the table.
+func (t *Table) recordPath(recordID RecordID) (string, error) {
+	if t.client.apiVersion == APIVersionV1 || t.client.apiVersion == APIVersionV3 {
+		path, err := t.recordsPath(false)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s", path, url.PathEscape(recordID.String())), nil
+	}
+	return fmt.Sprintf("/api/v2/tables/%s/records/%s", t.tableID, url.PathEscape(recordID.String())), nil
+}
+
+// countPath returns the path of the endpoint that counts the records of the table.
+func (t *Table) countPath() (string, error) {
+	if t.client.apiVersion == APIVersionV1 || t.client.apiVersion == APIVersionV3 {
+		path, err := t.tablePath(false)
+		if err != nil {
+			return "", err
+		}
+		return path + "/count", nil
+	}
+	return fmt.Sprintf("/api/v2/tables/%s/records/count", t.tableID), nil
+}
+
+// schemaPath returns the path of the meta API endpoint that reads the table.
+func (t *Table) schemaPath() string {
+	if t.client.apiVersion == APIVersionV1 {
+		return fmt.Sprintf("/api/v1/db/meta/tables/%s", t.tableID)
+	}
+	return fmt.Sprintf("/api/v2/meta/tables/%s", t.tableID)
+}
+
+// linksPath returns the path of the endpoint that lists, creates and deletes the records linked to
+// a record through a link field.
+//
+// The v1 data API addresses the links by the relation type and the title of the link column, so
+// they are read from the table schema. The targets of the v1 links are created and deleted one
+// by one, appending their ID to the path, which is reported by perTarget.
+func (t *Table) linksPath(ctx context.Context, linkFieldID string, recordID RecordID) (path string, perTarget bool, err error) {
+	switch t.client.apiVersion {
+	case APIVersionV1:
+	case APIVersionV3:
+		tablePath, err := t.tablePath(false)
+		if err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("%s/links/%s/%s", tablePath, url.PathEscape(linkFieldID), url.PathEscape(recordID.String())), false, nil
+	default:
+		return fmt.Sprintf("/api/v2/tables/%s/links/%s/records/%s", t.tableID, linkFieldID, url.PathEscape(recordID.String())), false, nil
+	}
+
+	tablePath, err := t.tablePath(false)
+	if err != nil {
+		return "", false, err
+	}
+
+	schema, err := t.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read table schema: %w", err)
+	}
+	column, ok := schema.columnByID(linkFieldID)
+	if !ok {
+		return "", false, fmt.Errorf("%w: link field %q", ErrColumnNotFound, linkFieldID)
+	}
+	relation, _ := column.ColOptions["type"].(string)
+	if relation == "" {
+		return "", false, fmt.Errorf("%w: %q is not a link field", ErrUnsupportedColumnType, column.Title)
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%s", tablePath, url.PathEscape(recordID.String()), relation, url.PathEscape(column.Title)), true, nil
+}
+
+// listQuery converts the query parameters of a list request, built for the v2 data API, to the
+// data API of the client.
+//
+// The v3 API paginates by page number instead of by offset, so the offset must be a multiple of
+// the limit, and expects the sorts as a JSON list.
+func (t *Table) listQuery(query url.Values) (url.Values, error) {
+	if t.client.apiVersion != APIVersionV3 {
+		return query, nil
+	}
+
+	converted := url.Values{}
+	for key, values := range query {
+		converted[key] = values
+	}
+
+	if query.Has("limit") || query.Has("offset") {
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		if limit < 1 {
+			limit = 25
+		}
+		if offset%limit != 0 {
+			return nil, fmt.Errorf("%w: the v3 data API requires an offset multiple of the limit, got offset %d and limit %d", ErrInvalidQuery, offset, limit)
+		}
+
+		converted.Del("limit")
+		converted.Del("offset")
+		converted.Set("pageSize", strconv.Itoa(limit))
+		converted.Set("page", strconv.Itoa(offset/limit+1))
+	}
+
+	if sorts := query.Get("sort"); sorts != "" {
+		var v3Sorts []map[string]string
+		for _, sort := range strings.Split(sorts, ",") {
+			direction := "asc"
+			if field, ok := strings.CutPrefix(sort, "-"); ok {
+				sort, direction = field, "desc"
+			}
+			v3Sorts = append(v3Sorts, map[string]string{"field": sort, "direction": direction})
+		}
+		encoded, err := json.Marshal(v3Sorts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode sorts: %w", err)
+		}
+		converted.Set("sort", string(encoded))
+	}
+
+	return converted, nil
+}
+
+// v3Record is a record in the format of the v3 data API
+type v3Record struct {
+	ID     any            `json:"id,omitempty"`
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// flatten converts the record to the format of the v2 data API, with the ID in the "Id" field.
+func (r v3Record) flatten() map[string]any {
+	record := make(map[string]any, len(r.Fields)+1)
+	for name, value := range r.Fields {
+		record[name] = value
+	}
+	if r.ID != nil {
+		record[SystemFieldID] = r.ID
+	}
+	return record
+}
+
+// v3ListResponse is the response of the list endpoints of the v3 data API
+type v3ListResponse struct {
+	Records []v3Record `json:"records"`
+	Next    string     `json:"next"`
+}
+
+// decodeList decodes the response of a list request made with the given query.
+func (t *Table) decodeList(body []byte, query url.Values) (ListResponse, error) {
+	if t.client.apiVersion != APIVersionV3 {
+		var response ListResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return ListResponse{}, err
+		}
+		return response, nil
+	}
+
+	var response v3ListResponse
+	if err := unmarshalRecords(body, &response); err != nil {
+		return ListResponse{}, err
+	}
+
+	list := make([]map[string]any, len(response.Records))
+	for i, record := range response.Records {
+		list[i] = exactNumbers(record.flatten()).(map[string]any)
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("pageSize"))
+	page = max(page, 1)
+
+	return ListResponse{
+		List: list,
+		PageInfo: PageInfo{
+			Page:        page,
+			PageSize:    pageSize,
+			IsFirstPage: page == 1,
+			IsLastPage:  response.Next == "",
+		},
+	}, nil
+}
+
+// decodeRecord decodes the response of a read request.
+func (t *Table) decodeRecord(body []byte) (map[string]any, error) {
+	if t.client.apiVersion != APIVersionV3 {
+		var record map[string]any
+		if err := unmarshalRecords(body, &record); err != nil {
+			return nil, err
+		}
+		exactNumbers(record)
+		return record, nil
+	}
+
+	var record v3Record
+	if err := unmarshalRecords(body, &record); err != nil {
+		return nil, err
+	}
+	return exactNumbers(record.flatten()).(map[string]any), nil
+}
+
+// decodeCreatedIDs decodes the IDs of the records returned by a create request.
+//
+// The v2 API returns the records with their "Id", the v1 API returns the IDs or the records with
+// the primary key under its column name depending on the database, and the v3 API returns the
+// records under "records". The ID is found in a field named "Id" in any case, in the only field of
+// the record, or else in the field of the primary key, read from the table schema.
+func (t *Table) decodeCreatedIDs(ctx context.Context, body []byte) ([]RecordID, error) {
+	// The numbers are decoded as json.Number, since large IDs don't fit in a float64
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var response []any
+	if t.client.apiVersion == APIVersionV3 {
+		var v3Response v3ListResponse
+		if err := decoder.Decode(&v3Response); err != nil {
+			return nil, err
+		}
+		for _, record := range v3Response.Records {
+			response = append(response, map[string]any{"id": record.ID})
+		}
+	} else if err := decoder.Decode(&response); err != nil {
+		return nil, err
+	}
+
+	// primaryKeyFields are the title and column name of the primary key, read once when needed
+	var primaryKeyFields []string
+
+	var ids []RecordID
+	for _, created := range response {
+		switch v := created.(type) {
+		case json.Number, string:
+			if id, err := parseRecordID(v); err == nil {
+				ids = append(ids, createdRecordID(id))
+			}
+		case map[string]any:
+			id, found := createdID(v)
+			if !found {
+				if primaryKeyFields == nil {
+					schema, err := t.ReadSchema().WithContext(ctx).Execute()
+					if err != nil {
+						return nil, fmt.Errorf("failed to read table schema to find the primary key: %w", err)
+					}
+					primaryKey, _ := schema.PrimaryKey()
+					primaryKeyFields = []string{primaryKey.Title, primaryKey.ColumnName}
+				}
+				for _, field := range primaryKeyFields {
+					if value, found := v[field]; found && field != "" {
+						id = value
+						break
+					}
+				}
+			}
+			if id, err := parseRecordID(id); err == nil && !id.IsZero() {
+				ids = append(ids, createdRecordID(id))
+			}
+		}
+	}
+	return ids, nil
+}
+
+// createdRecordID returns the ID of a created record as an integer RecordID if it holds an
+// integer, since some databases return the integer IDs as strings, and unchanged otherwise, like
+// UUIDs.
+func createdRecordID(id RecordID) RecordID {
+	if number, ok := id.Int64(); ok {
+		return IntID(number)
+	}
+	return id
+}
+
+// createdID returns the ID of a record returned by a create request, found in the "Id" field
+// with any case or in the only field of the record, and whether it was found.
+func createdID(record map[string]any) (any, bool) {
+	if id, ok := record[SystemFieldID]; ok {
+		return id, true
+	}
+	for field, value := range record {
+		if strings.EqualFold(field, SystemFieldID) {
+			return value, true
+		}
+	}
+	if len(record) == 1 {
+		for _, value := range record {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// encodeRecords converts the records of a create or update request, in the format of the v2 data
+// API, to the data API of the client.
+func (t *Table) encodeRecords(records []map[string]any) any {
+	if t.client.apiVersion != APIVersionV3 {
+		return records
+	}
+
+	converted := make([]v3Record, len(records))
+	for i, record := range records {
+		converted[i] = v3Record{Fields: map[string]any{}}
+		for name, value := range record {
+			if name == SystemFieldID {
+				converted[i].ID = value
+				continue
+			}
+			converted[i].Fields[name] = value
+		}
+	}
+	return converted
+}
+
+// recordIDKey returns the key of the record IDs in the body of the delete and link requests.
+func (t *Table) recordIDKey() string {
+	if t.client.apiVersion == APIVersionV3 {
+		return "id"
+	}
+	return SystemFieldID
+}
@@ -0,0 +1,252 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestV1DataAPI(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	record := func(w http.ResponseWriter, r *http.Request, body string) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(body))
+	}
+	mux.HandleFunc("GET /api/v1/db/data/noco/p_base/users", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"list":[{"Id":1,"Name":"Ana"}],"pageInfo":{"isLastPage":true}}`)
+	})
+	mux.HandleFunc("GET /api/v1/db/data/noco/p_base/users/count", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"count":1}`)
+	})
+	mux.HandleFunc("GET /api/v1/db/data/noco/p_base/users/1", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"Id":1,"Name":"Ana"}`)
+	})
+	mux.HandleFunc("/api/v1/db/data/bulk/noco/p_base/users", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `[{"id":2},3]`)
+	})
+	mux.HandleFunc("GET /api/v1/db/meta/tables/users", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"id":"users","columns":[{"id":"cl_teams","title":"Teams","uidt":"Links","colOptions":{"type":"mm"}}]}`)
+	})
+	mux.HandleFunc("/api/v1/db/data/noco/p_base/users/1/mm/Teams/", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"msg":"ok"}`)
+	})
+	mux.HandleFunc("GET /api/v1/db/data/noco/p_base/users/1/mm/Teams", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"list":[{"Id":7}],"pageInfo":{"isLastPage":true}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").WithV1DataAPI("").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := client.Table("users").ListRecords().Execute(); !errors.Is(err, ErrBaseIDRequired) {
+		t.Errorf("ListRecords() without base error = %v, want %v", err, ErrBaseIDRequired)
+	}
+
+	users := client.Base("p_base").Table("users")
+
+	list, err := users.ListRecords().Execute()
+	if err != nil || len(list.List) != 1 {
+		t.Errorf("ListRecords() = %v, %v, want 1 record", list.List, err)
+	}
+	if count, err := users.CountRecords().Execute(); err != nil || count != 1 {
+		t.Errorf("CountRecords() = %v, %v, want 1", count, err)
+	}
+	if read, err := users.ReadRecord(1).Execute(); err != nil || read.Data["Name"] != "Ana" {
+		t.Errorf("ReadRecord() = %v, %v, want Ana", read.Data, err)
+	}
+	ids, err := users.CreateRecords([]map[string]any{{"Name": "Bob"}, {"Name": "Cid"}}).Execute()
+	if err != nil || !slices.Equal(ids, []RecordID{IntID(2), IntID(3)}) {
+		t.Errorf("CreateRecords() = %v, %v, want [2 3]", ids, err)
+	}
+	if err := users.UpdateRecord(map[string]any{"Id": 2, "Name": "Bob"}).Execute(); err != nil {
+		t.Errorf("UpdateRecord() error = %v", err)
+	}
+	if err := users.DeleteRecord(3).Execute(); err != nil {
+		t.Errorf("DeleteRecord() error = %v", err)
+	}
+	if linked, err := users.ListLinks("cl_teams", 1).Execute(); err != nil || len(linked.List) != 1 {
+		t.Errorf("ListLinks() = %v, %v, want 1 record", linked.List, err)
+	}
+	if err := users.CreateLinks("cl_teams", 1, []int{7, 8}).Execute(); err != nil {
+		t.Errorf("CreateLinks() error = %v", err)
+	}
+	if err := users.DeleteLink("cl_teams", 1, 7).Execute(); err != nil {
+		t.Errorf("DeleteLink() error = %v", err)
+	}
+
+	want := []string{
+		"GET /api/v1/db/data/noco/p_base/users",
+		"GET /api/v1/db/data/noco/p_base/users/count",
+		"GET /api/v1/db/data/noco/p_base/users/1",
+		"POST /api/v1/db/data/bulk/noco/p_base/users",
+		"PATCH /api/v1/db/data/bulk/noco/p_base/users",
+		"DELETE /api/v1/db/data/bulk/noco/p_base/users",
+		"GET /api/v1/db/meta/tables/users",
+		"GET /api/v1/db/data/noco/p_base/users/1/mm/Teams",
+		"GET /api/v1/db/meta/tables/users",
+		"POST /api/v1/db/data/noco/p_base/users/1/mm/Teams/7",
+		"POST /api/v1/db/data/noco/p_base/users/1/mm/Teams/8",
+		"GET /api/v1/db/meta/tables/users",
+		"DELETE /api/v1/db/data/noco/p_base/users/1/mm/Teams/7",
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests =\n%v\nwant\n%v", requests, want)
+	}
+}
+
+func TestV3DataAPI(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	record := func(w http.ResponseWriter, r *http.Request, body string) {
+		payload, _ := io.ReadAll(r.Body)
+		request := r.Method + " " + r.URL.Path
+		if r.URL.RawQuery != "" {
+			request += "?" + r.URL.Query().Encode()
+		}
+		if len(payload) > 0 {
+			request += " " + string(payload)
+		}
+		requests = append(requests, request)
+		_, _ = w.Write([]byte(body))
+	}
+	mux.HandleFunc("GET /api/v3/data/p_base/users/records", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"records":[{"id":1,"fields":{"Name":"Ana"}}],"next":""}`)
+	})
+	mux.HandleFunc("GET /api/v3/data/p_base/users/count", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"count":1}`)
+	})
+	mux.HandleFunc("GET /api/v3/data/p_base/users/records/1", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"id":1,"fields":{"Name":"Ana"}}`)
+	})
+	mux.HandleFunc("/api/v3/data/p_base/users/records", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"records":[{"id":2},{"id":3}]}`)
+	})
+	mux.HandleFunc("/api/v3/data/p_base/users/links/cl_teams/1", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r, `{"records":[{"id":7,"fields":{"Title":"Core"}}],"next":"https://app.nocodb.com/next"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	if _, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").WithAPIVersion("v9").Create(); !errors.Is(err, ErrUnsupportedAPIVersion) {
+		t.Errorf("Create() with unknown version error = %v, want %v", err, ErrUnsupportedAPIVersion)
+	}
+
+	client, err := NewClient().
+		WithBaseURL(server.URL).
+		WithAPIToken("test-token").
+		WithAPIVersion(APIVersionV3).
+		WithDefaultBaseID("p_base").
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	users := client.Table("users")
+
+	list, err := users.ListRecords().SortAscBy("Name").SortDescBy("Age").Limit(10).Offset(20).Execute()
+	if err != nil || len(list.List) != 1 || list.List[0]["Id"] != float64(1) || list.List[0]["Name"] != "Ana" {
+		t.Errorf("ListRecords() = %v, %v, want the flattened record", list.List, err)
+	}
+	if !list.PageInfo.IsLastPage || list.PageInfo.Page != 3 {
+		t.Errorf("ListRecords() page info = %+v, want the last page 3", list.PageInfo)
+	}
+	if _, err := users.ListRecords().Limit(10).Offset(5).Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("ListRecords() with unaligned offset error = %v, want %v", err, ErrInvalidQuery)
+	}
+	if count, err := users.CountRecords().Execute(); err != nil || count != 1 {
+		t.Errorf("CountRecords() = %v, %v, want 1", count, err)
+	}
+	if read, err := users.ReadRecord(1).Execute(); err != nil || read.Data["Name"] != "Ana" || read.Data["Id"] != float64(1) {
+		t.Errorf("ReadRecord() = %v, %v, want Ana", read.Data, err)
+	}
+	ids, err := users.CreateRecords([]map[string]any{{"Name": "Bob"}, {"Name": "Cid"}}).Execute()
+	if err != nil || !slices.Equal(ids, []RecordID{IntID(2), IntID(3)}) {
+		t.Errorf("CreateRecords() = %v, %v, want [2 3]", ids, err)
+	}
+	if err := users.UpdateRecord(map[string]any{"Id": 2, "Name": "Bob"}).Execute(); err != nil {
+		t.Errorf("UpdateRecord() error = %v", err)
+	}
+	if err := users.DeleteRecord(3).Execute(); err != nil {
+		t.Errorf("DeleteRecord() error = %v", err)
+	}
+	linked, err := users.ListLinks("cl_teams", 1).Execute()
+	if err != nil || len(linked.List) != 1 || linked.PageInfo.IsLastPage {
+		t.Errorf("ListLinks() = %v, %+v, %v, want 1 record and more pages", linked.List, linked.PageInfo, err)
+	}
+	if err := users.CreateLinks("cl_teams", 1, []int{7, 8}).Execute(); err != nil {
+		t.Errorf("CreateLinks() error = %v", err)
+	}
+
+	want := []string{
+		`GET /api/v3/data/p_base/users/records?page=3&pageSize=10&sort=%5B%7B%22direction%22%3A%22asc%22%2C%22field%22%3A%22Name%22%7D%2C%7B%22direction%22%3A%22desc%22%2C%22field%22%3A%22Age%22%7D%5D`,
+		`GET /api/v3/data/p_base/users/count`,
+		`GET /api/v3/data/p_base/users/records/1`,
+		`POST /api/v3/data/p_base/users/records [{"fields":{"Name":"Bob"}},{"fields":{"Name":"Cid"}}]`,
+		`PATCH /api/v3/data/p_base/users/records [{"id":2,"fields":{"Name":"Bob"}}]`,
+		`DELETE /api/v3/data/p_base/users/records [{"id":3}]`,
+		`GET /api/v3/data/p_base/users/links/cl_teams/1`,
+		`POST /api/v3/data/p_base/users/links/cl_teams/1 [{"id":7},{"id":8}]`,
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests =\n%v\nwant\n%v", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDecodeCreatedIDs(t *testing.T) {
+	schemaReads := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/customers", func(w http.ResponseWriter, r *http.Request) {
+		schemaReads++
+		_, _ = w.Write([]byte(`{"id":"customers","columns":[
+			{"id":"cl_code","title":"Customer Code","column_name":"customer_code","uidt":"Number","pk":true},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText"}
+		]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	customers := client.Table("customers")
+
+	tests := []struct {
+		name            string
+		body            string
+		want            []RecordID
+		wantSchemaReads int
+	}{
+		{name: "Id", body: `[{"Id":1},{"Id":2}]`, want: []RecordID{IntID(1), IntID(2)}},
+		{name: "lowercase id", body: `[{"id":3,"Name":"Ana"},{"ID":"4","Name":"Bob"}]`, want: []RecordID{IntID(3), IntID(4)}},
+		{name: "single field", body: `[{"pk":5},6]`, want: []RecordID{IntID(5), IntID(6)}},
+		{name: "primary key title", body: `[{"Customer Code":7,"Name":"Ana"},{"Customer Code":8,"Name":"Bob"}]`, want: []RecordID{IntID(7), IntID(8)}, wantSchemaReads: 1},
+		{name: "primary key column name", body: `[{"customer_code":9,"Name":"Ana"}]`, want: []RecordID{IntID(9)}, wantSchemaReads: 1},
+		{name: "large IDs", body: `[{"Id":9007199254740993},9007199254740995]`, want: []RecordID{IntID(9007199254740993), IntID(9007199254740995)}},
+		{name: "UUIDs", body: `[{"Id":"d7c5a1e2-5b1f-4c3a-9e2d-1f0a6b7c8d9e"},{"Id":"42"}]`, want: []RecordID{StringID("d7c5a1e2-5b1f-4c3a-9e2d-1f0a6b7c8d9e"), IntID(42)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemaReads = 0
+			ids, err := customers.decodeCreatedIDs(context.Background(), []byte(tt.body))
+			if err != nil {
+				t.Fatalf("decodeCreatedIDs() error = %v", err)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("decodeCreatedIDs() = %v, want %v", ids, tt.want)
+			}
+			if schemaReads != tt.wantSchemaReads {
+				t.Errorf("schema reads = %d, want %d", schemaReads, tt.wantSchemaReads)
+			}
+		})
+	}
+}
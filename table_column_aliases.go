@@ -0,0 +1,188 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// columnAliasTTL is how long the renames resolved from the table schema are reused before the
+// schema is read again
+const columnAliasTTL = time.Minute
+
+// columnAliases resolves the old titles of renamed columns to their current titles, reading the
+// table schema at most once per columnAliasTTL
+type columnAliases struct {
+	// aliases maps the old titles to the new titles
+	aliases map[string]string
+	// onDeprecated is called the first time each renamed column is found, nil to ignore
+	onDeprecated func(oldTitle string, newTitle string)
+
+	mu       sync.Mutex
+	renames  columnRenames   // renames are the aliases whose old title no longer exists
+	resolved time.Time       // resolved is when the renames were resolved, zero if never
+	notified map[string]bool // notified are the old titles whose deprecation has been reported
+}
+
+// columnRenames maps the old titles of the renamed columns to their current titles
+type columnRenames map[string]string
+
+// WithColumnAliases returns a copy of the table that keeps the code referencing the old title of
+// a column working after the column is renamed in NocoDB, so the code and the column can be
+// renamed without downtime.
+//
+// The aliases map the old titles to the new ones. Once the old title no longer exists in the
+// table schema and the new one does, the old title is replaced by the new one in the filters,
+// sorts and fields of the queries and in the records created and updated, and the records read
+// are returned with the value of the column under both titles.
+//
+// The onDeprecated function, if not nil, is called the first time each renamed column is found,
+// to report it with the logger of the application as a reminder to update the code.
+//
+// The schema is read when the table is first used and then once per minute at most.
+//
+// Example:
+//
+//	// Deploy first, then rename "Mail" to "Email" in NocoDB
+//	users := client.Table("m_xxxxxxxxxxxxxx").WithColumnAliases(map[string]string{
+//		"Mail": "Email",
+//	}, func(oldTitle string, newTitle string) {
+//		slog.Warn("deprecated column title", "old", oldTitle, "new", newTitle)
+//	})
+//
+//	// Keeps working before and after the rename
+//	result, err := users.ListRecords().WhereIsEqualTo("Mail", "ana@example.com").Execute()
+func (t *Table) WithColumnAliases(aliases map[string]string, onDeprecated func(oldTitle string, newTitle string)) *Table {
+	table := *t
+	table.aliases = nil
+	if len(aliases) > 0 {
+		table.aliases = &columnAliases{
+			aliases:      maps.Clone(aliases),
+			onDeprecated: onDeprecated,
+			notified:     map[string]bool{},
+		}
+	}
+	return &table
+}
+
+// columnRenames returns the renames of the column aliases of the table, nil if it has none.
+func (t *Table) columnRenames(ctx context.Context) (columnRenames, error) {
+	if t.aliases == nil {
+		return nil, nil
+	}
+
+	a := t.aliases
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := t.client.clock.Now()
+	if !a.resolved.IsZero() && now.Sub(a.resolved) < columnAliasTTL {
+		return a.renames, nil
+	}
+
+	schema, err := t.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read table schema to resolve column aliases: %w", err)
+	}
+
+	renames := columnRenames{}
+	for oldTitle, newTitle := range a.aliases {
+		if _, ok := schema.Column(oldTitle); ok {
+			continue
+		}
+		if _, ok := schema.Column(newTitle); !ok {
+			continue
+		}
+		renames[oldTitle] = newTitle
+		if !a.notified[oldTitle] && a.onDeprecated != nil {
+			a.onDeprecated(oldTitle, newTitle)
+			a.notified[oldTitle] = true
+		}
+	}
+
+	a.renames = renames
+	a.resolved = now
+	return renames, nil
+}
+
+// column returns the current title of a column.
+func (r columnRenames) column(title string) string {
+	if newTitle, ok := r[title]; ok {
+		return newTitle
+	}
+	return title
+}
+
+// query returns a copy of the query parameters of a list request with the old titles replaced
+// in the filters, sorts and fields.
+func (r columnRenames) query(query url.Values) url.Values {
+	if len(r) == 0 {
+		return query
+	}
+
+	renamed := url.Values{}
+	for key, values := range query {
+		renamed[key] = values
+	}
+
+	if where := query.Get("where"); where != "" {
+		renamed.Set("where", filterComparisonPattern.ReplaceAllStringFunc(where, func(match string) string {
+			title, operator, _ := strings.Cut(match[1:], ",")
+			return "(" + r.column(strings.TrimSpace(title)) + "," + operator
+		}))
+	}
+	if sort := query.Get("sort"); sort != "" {
+		sorts := strings.Split(sort, ",")
+		for i, column := range sorts {
+			descending := strings.HasPrefix(column, "-")
+			sorts[i] = r.column(strings.TrimPrefix(column, "-"))
+			if descending {
+				sorts[i] = "-" + sorts[i]
+			}
+		}
+		renamed.Set("sort", strings.Join(sorts, ","))
+	}
+	if fields := query.Get("fields"); fields != "" {
+		columns := strings.Split(fields, ",")
+		for i, column := range columns {
+			columns[i] = r.column(column)
+		}
+		renamed.Set("fields", strings.Join(columns, ","))
+	}
+
+	return renamed
+}
+
+// records returns the records of a create or update request with the old titles replaced,
+// without modifying the original records.
+func (r columnRenames) records(records []map[string]any) []map[string]any {
+	if len(r) == 0 {
+		return records
+	}
+
+	renamed := make([]map[string]any, len(records))
+	for i, record := range records {
+		renamed[i] = make(map[string]any, len(record))
+		for column, value := range record {
+			renamed[i][r.column(column)] = value
+		}
+	}
+	return renamed
+}
+
+// addOldTitles adds the values of the renamed columns of the records read under their old titles.
+func (r columnRenames) addOldTitles(records ...map[string]any) {
+	for _, record := range records {
+		for oldTitle, newTitle := range r {
+			if value, ok := record[newTitle]; ok {
+				if _, exists := record[oldTitle]; !exists {
+					record[oldTitle] = value
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestColumnAliases(t *testing.T) {
+	fake := nocodbgotest.New()
+	fake.Seed("users",
+		map[string]any{"Name": "Ana", "Email": "ana@example.com"},
+		map[string]any{"Name": "Bob", "Email": "bob@example.com"},
+	)
+
+	var schemaReads atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/users", func(w http.ResponseWriter, r *http.Request) {
+		schemaReads.Add(1)
+		_, _ = w.Write([]byte(`{"id":"users","title":"Users","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText"},
+			{"id":"cl_email","title":"Email","uidt":"Email"}
+		]}`))
+	})
+	mux.Handle("/", fake)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	var deprecated []string
+	users := client.Table("users").WithColumnAliases(map[string]string{
+		"Mail":     "Email",
+		"FullName": "Full Name",
+	}, func(oldTitle string, newTitle string) {
+		deprecated = append(deprecated, oldTitle+" -> "+newTitle)
+	})
+
+	result, err := users.ListRecords().WhereIsEqualTo("Mail", "bob@example.com").ReturnFields("Name", "Mail").Execute()
+	if err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if len(result.List) != 1 || result.List[0]["Mail"] != "bob@example.com" || result.List[0]["Email"] != "bob@example.com" {
+		t.Errorf("ListRecords() = %v, want Bob with Mail and Email", result.List)
+	}
+
+	if err := users.UpdateRecord(map[string]any{"Id": 1, "Mail": "ana@acme.com"}).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	if got := fake.Records("users")[0]; got["Email"] != "ana@acme.com" || got["Mail"] != nil {
+		t.Errorf("updated record = %v, want the new Email", got)
+	}
+
+	count, err := users.CountRecords().WhereIsLike("Mail", "%acme%").Execute()
+	if err != nil || count != 1 {
+		t.Errorf("CountRecords() = %d, %v, want 1", count, err)
+	}
+
+	// The rendered requests are the ones sent
+	list, err := users.ListRecords().WhereIsEqualTo("Mail", "ana@acme.com").SortAscBy("Mail").Query()
+	if err != nil || list.Query.Get("where") != "(Email,eq,ana@acme.com)" || list.Query.Get("sort") != "Email" {
+		t.Errorf("ListRecords().Query() = %v, %v, want the new title", list, err)
+	}
+	count, err = users.CountRecords().WhereIsEqualTo("Mail", "ana@acme.com").Execute()
+	if rendered := users.CountRecords().WhereIsEqualTo("Mail", "ana@acme.com").String(); err != nil || count != 1 || !strings.Contains(rendered, "Email") {
+		t.Errorf("CountRecords() = %d, %v, rendered %q, want the new title", count, err, rendered)
+	}
+
+	if got := schemaReads.Load(); got != 1 {
+		t.Errorf("schema reads = %d, want 1", got)
+	}
+	if !reflect.DeepEqual(deprecated, []string{"Mail -> Email"}) {
+		t.Errorf("deprecated = %q, want one deprecation of Mail", deprecated)
+	}
+}
+
+func TestColumnRenamesQuery(t *testing.T) {
+	renames := columnRenames{"Mail": "Email", "Age": "Years"}
+
+	query := renames.query(map[string][]string{
+		"where":  {"(Mail,eq,ana@example.com)~and((Age,gt,18)~or(Name,like,Mail%))"},
+		"sort":   {"-Age,Name"},
+		"fields": {"Name,Mail"},
+		"limit":  {"10"},
+	})
+
+	for key, want := range map[string]string{
+		"where":  "(Email,eq,ana@example.com)~and((Years,gt,18)~or(Name,like,Mail%))",
+		"sort":   "-Years,Name",
+		"fields": "Name,Email",
+		"limit":  "10",
+	} {
+		if got := query.Get(key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
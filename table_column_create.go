@@ -0,0 +1,285 @@
+package nocodbgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+)
+
+// RollupFunction is the aggregation function of a Rollup column
+type RollupFunction string
+
+// Rollup functions supported by NocoDB
+const (
+	RollupAvg           RollupFunction = "avg"
+	RollupAvgDistinct   RollupFunction = "avgDistinct"
+	RollupCount         RollupFunction = "count"
+	RollupCountDistinct RollupFunction = "countDistinct"
+	RollupMax           RollupFunction = "max"
+	RollupMin           RollupFunction = "min"
+	RollupSum           RollupFunction = "sum"
+	RollupSumDistinct   RollupFunction = "sumDistinct"
+)
+
+// rollupFunctions are the valid rollup functions
+var rollupFunctions = []RollupFunction{
+	RollupAvg, RollupAvgDistinct, RollupCount, RollupCountDistinct,
+	RollupMax, RollupMin, RollupSum, RollupSumDistinct,
+}
+
+// formulaReferenceRegexp matches the column references of a formula (e.g. "{Price}")
+var formulaReferenceRegexp = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// columnValidator validates the column to create against the schema of the table
+type columnValidator func(ctx context.Context, table *Table, schema TableSchema) error
+
+// createColumnBuilder is used to build a column creation with a fluent API
+type createColumnBuilder struct {
+	table      *Table
+	body       map[string]any
+	validate   columnValidator
+	skipChecks bool
+	chainErr   error // Stores any error in the chain of methods
+
+	contextProvider[*createColumnBuilder]
+}
+
+// newCreateColumnBuilder creates a column creation builder with the given request body and validation.
+func newCreateColumnBuilder(t *Table, body map[string]any, validate columnValidator) *createColumnBuilder {
+	b := &createColumnBuilder{
+		table:    t,
+		body:     body,
+		validate: validate,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// dedicatedColumnTypes are the column types created with their own builder, since they require
+// settings that reference other columns or tables
+var dedicatedColumnTypes = map[ColumnType]string{
+	ColumnTypeFormula:             "CreateFormulaColumn",
+	ColumnTypeLookup:              "CreateLookupColumn",
+	ColumnTypeRollup:              "CreateRollupColumn",
+	ColumnTypeLinks:               "CreateLinkColumn",
+	ColumnTypeLinkToAnotherRecord: "CreateLinkColumn",
+}
+
+// CreateColumn initializes a builder that creates a column of the given type in the table.
+//
+// The column type is validated before sending the request. Formula, Lookup, Rollup and link
+// columns are created with their own builders, like CreateFormulaColumn, and the ID and foreign
+// key columns are managed by NocoDB.
+//
+// Parameters:
+//   - title:      The title of the new column.
+//   - columnType: The type of the column (e.g. ColumnTypeSingleLineText).
+//
+// Example:
+//
+//	column, err := table.CreateColumn("Price", nocodbgo.ColumnTypeDecimal).
+//		WithMeta(map[string]any{"precision": 2}).
+//		Execute()
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/overview
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-create
+func (t *Table) CreateColumn(title string, columnType ColumnType) *createColumnBuilder {
+	body := map[string]any{
+		"title": title,
+		"uidt":  columnType,
+	}
+
+	b := newCreateColumnBuilder(t, body, nil)
+	switch {
+	case !columnType.IsValid():
+		b.chainErr = fmt.Errorf("%w: unknown column type %q", ErrUnsupportedColumnType, columnType)
+	case dedicatedColumnTypes[columnType] != "":
+		b.chainErr = fmt.Errorf("%w: %s columns are created with %s", ErrUnsupportedColumnType, columnType, dedicatedColumnTypes[columnType])
+	case columnType == ColumnTypeID || columnType == ColumnTypeForeignKey:
+		b.chainErr = fmt.Errorf("%w: %s columns are managed by NocoDB", ErrUnsupportedColumnType, columnType)
+	}
+
+	return b
+}
+
+// WithMeta sets the type specific settings of the column, like the precision of a Decimal column
+// or the currency of a Currency column.
+func (b *createColumnBuilder) WithMeta(meta map[string]any) *createColumnBuilder {
+	b.body["meta"] = meta
+	return b
+}
+
+// CreateFormulaColumn initializes a builder that creates a Formula column in the table.
+//
+// Before creating the column, the columns referenced in the formula are checked to exist in the table.
+//
+// Parameters:
+//   - title:   The title of the new column.
+//   - formula: The formula, referencing other columns by title between braces (e.g. "{Price} * {Quantity}").
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/formula/formula
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-create
+func (t *Table) CreateFormulaColumn(title string, formula string) *createColumnBuilder {
+	body := map[string]any{
+		"title":       title,
+		"uidt":        ColumnTypeFormula,
+		"formula_raw": formula,
+	}
+
+	return newCreateColumnBuilder(t, body, func(_ context.Context, _ *Table, schema TableSchema) error {
+		for _, match := range formulaReferenceRegexp.FindAllStringSubmatch(formula, -1) {
+			if _, ok := schema.Column(match[1]); !ok {
+				return fmt.Errorf("%w: formula references %q", ErrColumnNotFound, match[1])
+			}
+		}
+		return nil
+	})
+}
+
+// CreateLookupColumn initializes a builder that creates a Lookup column in the table, which shows
+// the values of a column of the records linked through a link column.
+//
+// Before creating the column, the link column is checked to exist in the table and the lookup
+// column is checked to exist in the linked table.
+//
+// Parameters:
+//   - title:            The title of the new column.
+//   - relationColumnID: The identifier of the link column of this table.
+//   - lookupColumnID:   The identifier of the column of the linked table to show.
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/links-based/lookup
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-create
+func (t *Table) CreateLookupColumn(title string, relationColumnID string, lookupColumnID string) *createColumnBuilder {
+	body := map[string]any{
+		"title":                 title,
+		"uidt":                  ColumnTypeLookup,
+		"fk_relation_column_id": relationColumnID,
+		"fk_lookup_column_id":   lookupColumnID,
+	}
+
+	return newCreateColumnBuilder(t, body, func(ctx context.Context, table *Table, schema TableSchema) error {
+		return validateRelatedColumn(ctx, table, schema, relationColumnID, lookupColumnID)
+	})
+}
+
+// CreateRollupColumn initializes a builder that creates a Rollup column in the table, which
+// aggregates the values of a column of the records linked through a link column.
+//
+// Before creating the column, the link column is checked to exist in the table and the rollup
+// column is checked to exist in the linked table.
+//
+// Parameters:
+//   - title:            The title of the new column.
+//   - relationColumnID: The identifier of the link column of this table.
+//   - rollupColumnID:   The identifier of the column of the linked table to aggregate.
+//   - function:         The aggregation function (e.g. RollupSum).
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/links-based/rollup
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-create
+func (t *Table) CreateRollupColumn(title string, relationColumnID string, rollupColumnID string, function RollupFunction) *createColumnBuilder {
+	body := map[string]any{
+		"title":                 title,
+		"uidt":                  ColumnTypeRollup,
+		"fk_relation_column_id": relationColumnID,
+		"fk_rollup_column_id":   rollupColumnID,
+		"rollup_function":       function,
+	}
+
+	return newCreateColumnBuilder(t, body, func(ctx context.Context, table *Table, schema TableSchema) error {
+		if !slices.Contains(rollupFunctions, function) {
+			return fmt.Errorf("invalid rollup function %q", function)
+		}
+		return validateRelatedColumn(ctx, table, schema, relationColumnID, rollupColumnID)
+	})
+}
+
+// WithoutValidation skips checking the referenced columns before creating the column, saving the
+// requests needed to read the schemas.
+func (b *createColumnBuilder) WithoutValidation() *createColumnBuilder {
+	b.skipChecks = true
+	return b
+}
+
+// Execute finalizes and executes the operation, returning the created column.
+func (b *createColumnBuilder) Execute() (Column, error) {
+	if b.chainErr != nil {
+		return Column{}, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	ctx := b.contextProvider.ctx
+
+	if !b.skipChecks && b.validate != nil {
+		schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+		if err != nil {
+			return Column{}, fmt.Errorf("failed to validate column: %w", err)
+		}
+		if err := b.validate(ctx, b.table, schema); err != nil {
+			return Column{}, err
+		}
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/tables/%s/columns", b.table.tableID)
+	respBody, err := b.table.client.request(ctx, http.MethodPost, path, b.body, nil)
+	if err != nil {
+		return Column{}, withOperation(fmt.Errorf("failed to create column: %w", err), "CreateColumn", b.table.tableID, RecordID{})
+	}
+
+	// The response is the table with all its columns
+	title, _ := b.body["title"].(string)
+	var response TableSchema
+	if err := json.Unmarshal(respBody, &response); err == nil {
+		if column, ok := response.Column(title); ok {
+			return column, nil
+		}
+	}
+
+	schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return Column{}, fmt.Errorf("failed to read created column: %w", err)
+	}
+
+	column, ok := schema.Column(title)
+	if !ok {
+		return Column{}, fmt.Errorf("%w: created column %q", ErrColumnNotFound, title)
+	}
+
+	return column, nil
+}
+
+// validateRelatedColumn checks that the relation column is a link column of the table and that the
+// related column exists in the linked table.
+func validateRelatedColumn(ctx context.Context, table *Table, schema TableSchema, relationColumnID string, relatedColumnID string) error {
+	relation, ok := schema.columnByID(relationColumnID)
+	if !ok {
+		return fmt.Errorf("%w: relation column %s", ErrColumnNotFound, relationColumnID)
+	}
+
+	if !relation.UIDT.IsLink() {
+		return fmt.Errorf("%w: %s is %s, not a link column", ErrUnsupportedColumnType, relation.Title, relation.UIDT)
+	}
+
+	relatedTableID, _ := relation.ColOptions["fk_related_model_id"].(string)
+	if relatedTableID == "" {
+		return fmt.Errorf("link column %s has no related table", relation.Title)
+	}
+
+	relatedSchema, err := table.client.Table(relatedTableID).ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to read related table schema: %w", err)
+	}
+
+	if _, ok := relatedSchema.columnByID(relatedColumnID); !ok {
+		return fmt.Errorf("%w: column %s in related table %s", ErrColumnNotFound, relatedColumnID, relatedSchema.Title)
+	}
+
+	return nil
+}
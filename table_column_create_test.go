@@ -0,0 +1,103 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newSchemaServer returns a server with the meta API endpoints needed to create columns, which
+// records the body of the last created column
+func newSchemaServer(t *testing.T, created *map[string]any) *Client {
+	t.Helper()
+
+	orders := `{"id":"md_orders","title":"Orders","columns":[
+		{"id":"cl_price","title":"Price","uidt":"Decimal"},
+		{"id":"cl_items","title":"Items","uidt":"Links","colOptions":{"fk_related_model_id":"md_items"}}
+	]}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/md_orders", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(orders))
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/md_items", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"md_items","title":"Items","columns":[{"id":"cl_qty","title":"Quantity","uidt":"Number"}]}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/tables/md_orders/columns", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(created)
+		_, _ = w.Write([]byte(`{"id":"md_orders","columns":[{"id":"cl_new","title":"` + (*created)["title"].(string) + `"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return client
+}
+
+func TestCreateComputedColumns(t *testing.T) {
+	var created map[string]any
+	orders := newSchemaServer(t, &created).Table("md_orders")
+
+	column, err := orders.CreateFormulaColumn("Total", "{Price} * 2").Execute()
+	if err != nil {
+		t.Fatalf("CreateFormulaColumn() error = %v", err)
+	}
+	if column.ID != "cl_new" || created["formula_raw"] != "{Price} * 2" {
+		t.Errorf("CreateFormulaColumn() = %+v, body %v", column, created)
+	}
+
+	_, err = orders.CreateFormulaColumn("Total", "{Missing} * 2").Execute()
+	if !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("CreateFormulaColumn() with unknown column error = %v, want %v", err, ErrColumnNotFound)
+	}
+
+	if _, err := orders.CreateLookupColumn("Quantities", "cl_items", "cl_qty").Execute(); err != nil {
+		t.Errorf("CreateLookupColumn() error = %v", err)
+	}
+
+	_, err = orders.CreateLookupColumn("Prices", "cl_price", "cl_qty").Execute()
+	if !errors.Is(err, ErrUnsupportedColumnType) {
+		t.Errorf("CreateLookupColumn() with non link column error = %v, want %v", err, ErrUnsupportedColumnType)
+	}
+
+	_, err = orders.CreateRollupColumn("Total Quantity", "cl_items", "cl_missing", RollupSum).Execute()
+	if !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("CreateRollupColumn() with unknown column error = %v, want %v", err, ErrColumnNotFound)
+	}
+
+	if _, err := orders.CreateRollupColumn("Total Quantity", "cl_items", "cl_qty", "median").Execute(); err == nil {
+		t.Error("CreateRollupColumn() with invalid function error = nil, want error")
+	}
+
+	if _, err := orders.CreateRollupColumn("Total Quantity", "cl_items", "cl_qty", RollupSum).Execute(); err != nil {
+		t.Errorf("CreateRollupColumn() error = %v", err)
+	}
+	if created["rollup_function"] != "sum" {
+		t.Errorf("CreateRollupColumn() body = %v", created)
+	}
+}
+
+func TestCreateColumn(t *testing.T) {
+	var created map[string]any
+	orders := newSchemaServer(t, &created).Table("md_orders")
+
+	column, err := orders.CreateColumn("Tax", ColumnTypeDecimal).WithMeta(map[string]any{"precision": 2}).Execute()
+	if err != nil {
+		t.Fatalf("CreateColumn() error = %v", err)
+	}
+	if column.ID != "cl_new" || created["uidt"] != "Decimal" || created["meta"].(map[string]any)["precision"] != float64(2) {
+		t.Errorf("CreateColumn() = %+v, body %v", column, created)
+	}
+
+	for _, columnType := range []ColumnType{"Text", ColumnTypeFormula, ColumnTypeLinks, ColumnTypeID} {
+		if _, err := orders.CreateColumn("Invalid", columnType).Execute(); !errors.Is(err, ErrUnsupportedColumnType) {
+			t.Errorf("CreateColumn(%q) error = %v, want %v", columnType, err, ErrUnsupportedColumnType)
+		}
+	}
+}
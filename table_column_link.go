@@ -0,0 +1,156 @@
+package nocodbgo
+
+import (
+	"fmt"
+)
+
+// LinkRelation is the type of relation of a link column
+type LinkRelation string
+
+// Link relations supported by NocoDB
+const (
+	// LinkHasMany links each record to many records of the target table
+	LinkHasMany LinkRelation = "hm"
+	// LinkManyToMany links many records to many records of the target table
+	LinkManyToMany LinkRelation = "mm"
+	// LinkOneToOne links each record to a single record of the target table
+	LinkOneToOne LinkRelation = "oo"
+)
+
+// createLinkColumnBuilder is used to build a link column creation with a fluent API
+type createLinkColumnBuilder struct {
+	table           *Table
+	title           string
+	targetTable     *Table
+	relation        LinkRelation
+	symmetricTitle  string
+	skipValidations bool
+
+	contextProvider[*createLinkColumnBuilder]
+}
+
+// CreateLinkColumn initializes a builder that creates a Links column between this table and the
+// target table.
+//
+// NocoDB also creates the symmetric link column in the target table, titled after this table by
+// default. Use WithSymmetricTitle to choose its title.
+//
+// Parameters:
+//   - title:       The title of the new link column in this table.
+//   - targetTable: The table to link to.
+//   - relation:    The type of relation (e.g. LinkHasMany or LinkManyToMany).
+//
+// Example:
+//
+//	// Each customer has many orders, and each order shows its customer in "Customer"
+//	column, err := customers.CreateLinkColumn("Orders", orders, nocodbgo.LinkHasMany).
+//		WithSymmetricTitle("Customer").
+//		Execute()
+//
+// Documentation:
+//   - https://docs.nocodb.com/fields/field-types/links-based/links
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-create
+func (t *Table) CreateLinkColumn(title string, targetTable *Table, relation LinkRelation) *createLinkColumnBuilder {
+	b := &createLinkColumnBuilder{
+		table:       t,
+		title:       title,
+		targetTable: targetTable,
+		relation:    relation,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// WithSymmetricTitle sets the title of the link column created in the target table.
+func (b *createLinkColumnBuilder) WithSymmetricTitle(title string) *createLinkColumnBuilder {
+	b.symmetricTitle = title
+	return b
+}
+
+// WithoutValidation skips checking that the target table exists before creating the column.
+func (b *createLinkColumnBuilder) WithoutValidation() *createLinkColumnBuilder {
+	b.skipValidations = true
+	return b
+}
+
+// Execute finalizes and executes the operation, returning the created link column of this table.
+func (b *createLinkColumnBuilder) Execute() (Column, error) {
+	ctx := b.contextProvider.ctx
+
+	if b.targetTable == nil || b.targetTable.tableID == "" {
+		return Column{}, fmt.Errorf("failed to create link column: target table is required")
+	}
+
+	if b.relation != LinkHasMany && b.relation != LinkManyToMany && b.relation != LinkOneToOne {
+		return Column{}, fmt.Errorf("failed to create link column: invalid relation %q", b.relation)
+	}
+
+	// The target schema is needed before creating the column to find the symmetric column afterwards
+	var targetBefore TableSchema
+	if !b.skipValidations || b.symmetricTitle != "" {
+		schema, err := b.targetTable.ReadSchema().WithContext(ctx).Execute()
+		if err != nil {
+			return Column{}, fmt.Errorf("failed to read target table schema: %w", err)
+		}
+		targetBefore = schema
+	}
+
+	body := map[string]any{
+		"title":    b.title,
+		"uidt":     ColumnTypeLinks,
+		"parentId": b.table.tableID,
+		"childId":  b.targetTable.tableID,
+		"type":     b.relation,
+	}
+
+	column, err := newCreateColumnBuilder(b.table, body, nil).WithContext(ctx).Execute()
+	if err != nil {
+		return Column{}, err
+	}
+
+	if b.symmetricTitle == "" {
+		return column, nil
+	}
+
+	targetAfter, err := b.targetTable.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return Column{}, fmt.Errorf("failed to read target table schema: %w", err)
+	}
+
+	symmetric, ok := findSymmetricLinkColumn(targetBefore, targetAfter, b.table.tableID, column.ID)
+	if !ok {
+		return Column{}, fmt.Errorf("%w: symmetric link column in %s", ErrColumnNotFound, targetAfter.Title)
+	}
+
+	err = updateColumn(ctx, b.table.client, symmetric.ID, func(raw map[string]any, _ Column) error {
+		raw["title"] = b.symmetricTitle
+		return nil
+	})
+	if err != nil {
+		return Column{}, fmt.Errorf("failed to rename symmetric link column: %w", err)
+	}
+
+	return column, nil
+}
+
+// findSymmetricLinkColumn returns the link column that was added to the target table and points
+// to the given table, ignoring the column created in the table itself for self links.
+func findSymmetricLinkColumn(before TableSchema, after TableSchema, tableID string, createdColumnID string) (Column, bool) {
+	for _, column := range after.Columns {
+		if column.ID == createdColumnID {
+			continue
+		}
+		if _, existed := before.columnByID(column.ID); existed {
+			continue
+		}
+		if !column.UIDT.IsLink() {
+			continue
+		}
+		if relatedTableID, _ := column.ColOptions["fk_related_model_id"].(string); relatedTableID == tableID {
+			return column, true
+		}
+	}
+	return Column{}, false
+}
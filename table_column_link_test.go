@@ -0,0 +1,25 @@
+package nocodbgo
+
+import "testing"
+
+func TestFindSymmetricLinkColumn(t *testing.T) {
+	before := TableSchema{Columns: []Column{
+		{ID: "cl_old", UIDT: "Links", ColOptions: map[string]any{"fk_related_model_id": "md_customers"}},
+	}}
+	after := TableSchema{Title: "Orders", Columns: []Column{
+		{ID: "cl_old", UIDT: "Links", ColOptions: map[string]any{"fk_related_model_id": "md_customers"}},
+		{ID: "cl_created", UIDT: "Links", ColOptions: map[string]any{"fk_related_model_id": "md_customers"}},
+		{ID: "cl_other", UIDT: "Links", ColOptions: map[string]any{"fk_related_model_id": "md_products"}},
+		{ID: "cl_fk", UIDT: "ForeignKey"},
+		{ID: "cl_new", UIDT: "Links", ColOptions: map[string]any{"fk_related_model_id": "md_customers"}},
+	}}
+
+	column, ok := findSymmetricLinkColumn(before, after, "md_customers", "cl_created")
+	if !ok || column.ID != "cl_new" {
+		t.Errorf("findSymmetricLinkColumn() = %v, %v, want cl_new", column.ID, ok)
+	}
+
+	if _, ok := findSymmetricLinkColumn(after, after, "md_customers", "cl_created"); ok {
+		t.Error("findSymmetricLinkColumn() without new columns found a column, want none")
+	}
+}
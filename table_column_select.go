@@ -0,0 +1,176 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SelectOption is an option of a SingleSelect or MultiSelect column
+type SelectOption struct {
+	// ID is the unique identifier of the option, empty for options not yet created
+	ID string `json:"id,omitempty"`
+	// Title is the value of the option
+	Title string `json:"title"`
+	// Color is the color of the option as a hexadecimal code (e.g. "#cfdffe")
+	Color string `json:"color,omitempty"`
+	// Order is the position of the option among the options of the column
+	Order float64 `json:"order,omitempty"`
+}
+
+// SelectOptions returns the options of a SingleSelect or MultiSelect column, or nil for other columns.
+func (c Column) SelectOptions() []SelectOption {
+	raw, ok := c.ColOptions["options"]
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	var options []SelectOption
+	if err := json.Unmarshal(data, &options); err != nil {
+		return nil
+	}
+
+	return options
+}
+
+// selectOptionChange is a change applied to the options of a select column
+type selectOptionChange func(options []SelectOption) ([]SelectOption, error)
+
+// updateSelectOptionsBuilder is used to modify the options of a select column with a fluent API
+type updateSelectOptionsBuilder struct {
+	table    *Table
+	columnID string
+	changes  []selectOptionChange
+
+	contextProvider[*updateSelectOptionsBuilder]
+}
+
+// UpdateSelectOptions initializes a builder that modifies the options of a SingleSelect or
+// MultiSelect column of the table.
+//
+// The changes are applied in order to the current options, which are read right before updating
+// the column, so options not mentioned are kept as they are. Renamed options keep their identifier,
+// so NocoDB also renames the value in the existing records.
+//
+// Parameters:
+//   - columnID: The identifier of the select column (e.g. "cl_xxxxxxxxxxxxxx").
+//
+// Example:
+//
+//	err := table.UpdateSelectOptions("cl_xxxxxxxxxxxxxx").
+//		Add("Archived", "#cccccc").
+//		Rename("Todo", "To do").
+//		Recolor("Done", "#00ff00").
+//		Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-update
+func (t *Table) UpdateSelectOptions(columnID string) *updateSelectOptionsBuilder {
+	b := &updateSelectOptionsBuilder{
+		table:    t,
+		columnID: columnID,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Add adds a new option at the end of the options. Adding an option that already exists does nothing.
+//
+// Parameters:
+//   - title: The value of the option.
+//   - color: The color of the option as a hexadecimal code, empty to let NocoDB choose one.
+func (b *updateSelectOptionsBuilder) Add(title string, color string) *updateSelectOptionsBuilder {
+	b.changes = append(b.changes, func(options []SelectOption) ([]SelectOption, error) {
+		if selectOptionIndex(options, title) >= 0 {
+			return options, nil
+		}
+		return append(options, SelectOption{Title: title, Color: color, Order: float64(len(options) + 1)}), nil
+	})
+	return b
+}
+
+// Rename changes the value of an existing option.
+func (b *updateSelectOptionsBuilder) Rename(title string, newTitle string) *updateSelectOptionsBuilder {
+	b.changes = append(b.changes, func(options []SelectOption) ([]SelectOption, error) {
+		i := selectOptionIndex(options, title)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrSelectOptionNotFound, title)
+		}
+		options[i].Title = newTitle
+		return options, nil
+	})
+	return b
+}
+
+// Recolor changes the color of an existing option.
+func (b *updateSelectOptionsBuilder) Recolor(title string, color string) *updateSelectOptionsBuilder {
+	b.changes = append(b.changes, func(options []SelectOption) ([]SelectOption, error) {
+		i := selectOptionIndex(options, title)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrSelectOptionNotFound, title)
+		}
+		options[i].Color = color
+		return options, nil
+	})
+	return b
+}
+
+// Remove removes an existing option. Removing an option that doesn't exist does nothing.
+func (b *updateSelectOptionsBuilder) Remove(title string) *updateSelectOptionsBuilder {
+	b.changes = append(b.changes, func(options []SelectOption) ([]SelectOption, error) {
+		i := selectOptionIndex(options, title)
+		if i < 0 {
+			return options, nil
+		}
+		return append(options[:i], options[i+1:]...), nil
+	})
+	return b
+}
+
+// Execute finalizes and executes the operation, returning the resulting options.
+func (b *updateSelectOptionsBuilder) Execute() ([]SelectOption, error) {
+	if b.columnID == "" {
+		return nil, ErrColumnIDRequired
+	}
+
+	var options []SelectOption
+	err := updateColumn(b.contextProvider.ctx, b.table.client, b.columnID, func(raw map[string]any, column Column) error {
+		if column.UIDT != ColumnTypeSingleSelect && column.UIDT != ColumnTypeMultiSelect {
+			return fmt.Errorf("%w: %s is %s, not a select column", ErrUnsupportedColumnType, column.Title, column.UIDT)
+		}
+
+		options = column.SelectOptions()
+		for _, change := range b.changes {
+			var err error
+			if options, err = change(options); err != nil {
+				return err
+			}
+		}
+
+		colOptions := copyMap(column.ColOptions)
+		colOptions["options"] = options
+		raw["colOptions"] = colOptions
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update select options: %w", err)
+	}
+
+	return options, nil
+}
+
+// selectOptionIndex returns the index of the option with the given title, or -1 if not found.
+func selectOptionIndex(options []SelectOption, title string) int {
+	for i, option := range options {
+		if option.Title == title {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,74 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateSelectOptions(t *testing.T) {
+	var updated map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/columns/cl_status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"cl_status","title":"Status","uidt":"SingleSelect","meta":"{}","colOptions":{"options":[
+			{"id":"sl_1","title":"Todo","color":"#aaaaaa","order":1},
+			{"id":"sl_2","title":"Done","color":"#bbbbbb","order":2}
+		]}}`))
+	})
+	mux.HandleFunc("PATCH /api/v2/meta/columns/cl_status", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&updated)
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/columns/cl_name", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"cl_name","title":"Name","uidt":"SingleLineText"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("md_1")
+
+	options, err := table.UpdateSelectOptions("cl_status").
+		Add("Archived", "#cccccc").
+		Add("Done", "").
+		Rename("Todo", "To do").
+		Recolor("Done", "#00ff00").
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	want := []SelectOption{
+		{ID: "sl_1", Title: "To do", Color: "#aaaaaa", Order: 1},
+		{ID: "sl_2", Title: "Done", Color: "#00ff00", Order: 2},
+		{Title: "Archived", Color: "#cccccc", Order: 3},
+	}
+	if !reflect.DeepEqual(options, want) {
+		t.Errorf("Execute() = %+v, want %+v", options, want)
+	}
+
+	if updated["title"] != "Status" || updated["meta"] != "{}" {
+		t.Errorf("update body = %v, want the rest of the column settings kept", updated)
+	}
+	sent := updated["colOptions"].(map[string]any)["options"].([]any)
+	if len(sent) != 3 || sent[0].(map[string]any)["id"] != "sl_1" {
+		t.Errorf("update options = %v", sent)
+	}
+
+	_, err = table.UpdateSelectOptions("cl_status").Rename("Missing", "Other").Execute()
+	if !errors.Is(err, ErrSelectOptionNotFound) {
+		t.Errorf("Rename() missing option error = %v, want %v", err, ErrSelectOptionNotFound)
+	}
+
+	_, err = table.UpdateSelectOptions("cl_name").Add("A", "").Execute()
+	if !errors.Is(err, ErrUnsupportedColumnType) {
+		t.Errorf("Execute() on text column error = %v, want %v", err, ErrUnsupportedColumnType)
+	}
+}
@@ -0,0 +1,96 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"maps"
+)
+
+// updateColumnBuilder is used to build a column update with a fluent API
+type updateColumnBuilder struct {
+	table    *Table
+	columnID string
+	title    string
+	uidt     ColumnType
+	meta     map[string]any
+
+	contextProvider[*updateColumnBuilder]
+}
+
+// UpdateColumn initializes a builder that renames a column or changes its type.
+//
+// The column is read and sent back with the changes, keeping the rest of its settings.
+//
+// Parameters:
+//   - columnID: The identifier of the column to update.
+//
+// Example:
+//
+//	err := table.UpdateColumn("cl_xxxxxxxxxxxxxx").
+//		Rename("Unit Price").
+//		ChangeType(nocodbgo.ColumnTypeCurrency).
+//		Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Column/operation/db-table-column-update
+func (t *Table) UpdateColumn(columnID string) *updateColumnBuilder {
+	b := &updateColumnBuilder{
+		table:    t,
+		columnID: columnID,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Rename changes the title of the column.
+func (b *updateColumnBuilder) Rename(title string) *updateColumnBuilder {
+	b.title = title
+	return b
+}
+
+// ChangeType changes the type of the column. NocoDB converts the existing values, which can lose
+// the values that can't be converted.
+func (b *updateColumnBuilder) ChangeType(columnType ColumnType) *updateColumnBuilder {
+	b.uidt = columnType
+	return b
+}
+
+// WithMeta sets type specific settings of the column, keeping the settings not given.
+func (b *updateColumnBuilder) WithMeta(meta map[string]any) *updateColumnBuilder {
+	b.meta = meta
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *updateColumnBuilder) Execute() error {
+	if b.columnID == "" {
+		return ErrColumnIDRequired
+	}
+	if b.uidt != "" && !b.uidt.IsValid() {
+		return fmt.Errorf("%w: unknown column type %q", ErrUnsupportedColumnType, b.uidt)
+	}
+
+	err := updateColumn(b.contextProvider.ctx, b.table.client, b.columnID, func(raw map[string]any, column Column) error {
+		if b.title != "" {
+			raw["title"] = b.title
+		}
+		if b.uidt != "" {
+			raw["uidt"] = b.uidt
+		}
+		if b.meta != nil {
+			meta := maps.Clone(column.Meta)
+			if meta == nil {
+				meta = map[string]any{}
+			}
+			maps.Copy(meta, b.meta)
+			raw["meta"] = meta
+		}
+		return nil
+	})
+	if err != nil {
+		return withOperation(err, "UpdateColumn", b.table.tableID, RecordID{})
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateColumn(t *testing.T) {
+	var updated map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/columns/cl_price", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"cl_price","title":"Cost","uidt":"Decimal","rqd":true,"meta":"{\"precision\":2}"}`))
+	})
+	mux.HandleFunc("PATCH /api/v2/meta/columns/cl_price", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&updated)
+		_, _ = w.Write([]byte(`{}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("md_1")
+
+	err = table.UpdateColumn("cl_price").
+		Rename("Price").
+		ChangeType(ColumnTypeCurrency).
+		WithMeta(map[string]any{"currency_code": "EUR"}).
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	want := map[string]any{
+		"id":    "cl_price",
+		"title": "Price",
+		"uidt":  "Currency",
+		"rqd":   true,
+		"meta":  map[string]any{"precision": float64(2), "currency_code": "EUR"},
+	}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("update body = %v, want %v", updated, want)
+	}
+
+	if err := table.UpdateColumn("cl_price").ChangeType("Money").Execute(); !errors.Is(err, ErrUnsupportedColumnType) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrUnsupportedColumnType)
+	}
+	if err := table.UpdateColumn("").Rename("Price").Execute(); !errors.Is(err, ErrColumnIDRequired) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrColumnIDRequired)
+	}
+}
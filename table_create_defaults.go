@@ -0,0 +1,71 @@
+package nocodbgo
+
+import "slices"
+
+// WithCreateDefaults returns a copy of the table that fills in the given values on every record
+// created from it, for example the tenant or the owner of the records. Values set by the caller
+// are kept.
+//
+// The original table is not modified, so the same table can be used with different defaults.
+//
+// Example:
+//
+//	orders := client.Table("m_xxxxxxxxxxxxxx").WithCreateDefaults(map[string]any{
+//		"TenantId": tenantID,
+//		"Status":   "draft",
+//	})
+//
+//	// Created with TenantId and Status set
+//	id, err := orders.CreateRecord(map[string]any{"Customer": "Ana"}).Execute()
+func (t *Table) WithCreateDefaults(values map[string]any) *Table {
+	table := *t
+	table.createDefaults = copyMap(t.createDefaults)
+	for field, value := range values {
+		table.createDefaults[field] = value
+	}
+	return &table
+}
+
+// BeforeCreate returns a copy of the table that calls the given hook with every record created
+// from it, after the defaults of WithCreateDefaults are filled in, so it can compute values like
+// slugs or fill in fields from the context of the application. Hooks are called in the order they
+// were added.
+//
+// The hook receives a copy of the record, so the data given to CreateRecord and CreateRecords is
+// not modified. The original table is not modified either.
+//
+// Example:
+//
+//	posts := client.Table("m_xxxxxxxxxxxxxx").BeforeCreate(func(record map[string]any) {
+//		if title, ok := record["Title"].(string); ok {
+//			record["Slug"] = slugify(title)
+//		}
+//	})
+func (t *Table) BeforeCreate(hook func(record map[string]any)) *Table {
+	table := *t
+	table.beforeCreateHooks = append(slices.Clip(t.beforeCreateHooks), hook)
+	return &table
+}
+
+// prepareCreated returns copies of the records with the create defaults of the table filled in
+// and the before create hooks called, or the records themselves if the table has none.
+func (t *Table) prepareCreated(records []map[string]any) []map[string]any {
+	if len(t.createDefaults) == 0 && len(t.beforeCreateHooks) == 0 {
+		return records
+	}
+
+	prepared := make([]map[string]any, len(records))
+	for i, record := range records {
+		prepared[i] = copyMap(record)
+		for field, value := range t.createDefaults {
+			if _, ok := prepared[i][field]; !ok {
+				prepared[i][field] = value
+			}
+		}
+		for _, hook := range t.beforeCreateHooks {
+			hook(prepared[i])
+		}
+	}
+
+	return prepared
+}
@@ -0,0 +1,40 @@
+package nocodbgo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreateDefaults(t *testing.T) {
+	client, fake := newFakeClient(t)
+	posts := client.Table("posts").
+		WithCreateDefaults(map[string]any{"TenantId": "acme", "Status": "draft"}).
+		BeforeCreate(func(record map[string]any) {
+			if title, ok := record["Title"].(string); ok {
+				record["Slug"] = strings.ReplaceAll(strings.ToLower(title), " ", "-")
+			}
+		})
+	tagged := posts.BeforeCreate(func(record map[string]any) {
+		record["Tagged"] = true
+	})
+
+	data := map[string]any{"Title": "Hello World"}
+	if _, err := posts.CreateRecord(data).Execute(); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if _, err := tagged.CreateRecords([]map[string]any{{"Title": "Second Post", "Status": "published"}}).Execute(); err != nil {
+		t.Fatalf("CreateRecords() error = %v", err)
+	}
+	if len(data) != 1 {
+		t.Errorf("CreateRecord() modified the data of the caller: %v", data)
+	}
+
+	records := fake.Records("posts")
+	first, second := records[0], records[1]
+	if first["TenantId"] != "acme" || first["Status"] != "draft" || first["Slug"] != "hello-world" || first["Tagged"] != nil {
+		t.Errorf("first record = %v, want the defaults and the slug", first)
+	}
+	if second["TenantId"] != "acme" || second["Status"] != "published" || second["Slug"] != "second-post" || second["Tagged"] != true {
+		t.Errorf("second record = %v, want the defaults, the slug, the tag and the status of the caller", second)
+	}
+}
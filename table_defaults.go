@@ -0,0 +1,45 @@
+package nocodbgo
+
+import "slices"
+
+// QueryDefaults are the query options applied by default to the queries built from a table
+type QueryDefaults struct {
+	// Fields are the fields returned by ListRecords and ListLinks, unless ReturnFields is used
+	Fields []string
+	// ViewID is the view used by ListRecords and CountRecords, unless WithViewId is used
+	ViewID string
+	// Filters are the filters applied to ListRecords, CountRecords and ListLinks, combined with
+	// the filters added to each query
+	Filters []string
+	// PageSize is the number of records per page of ListRecords and ListLinks, unless Limit is used
+	PageSize int
+}
+
+// WithDefaults returns a copy of the table that applies the given options to every
+// ListRecords, CountRecords and ListLinks query built from it.
+//
+// The original table is not modified, so the same table can be used with different defaults.
+// The operations that build their own queries, like Plan, ReplaceLinks, Mirror or Watch, always
+// work with every record and link of the table.
+//
+// Example:
+//
+//	// Only work with the active users and their public fields
+//	users := client.Table("m_xxxxxxxxxxxxxx").WithDefaults(nocodbgo.QueryDefaults{
+//		Fields:   []string{"Id", "Name", "Email"},
+//		Filters:  []string{"(Status,eq,active)"},
+//		PageSize: 100,
+//	})
+//
+//	// Returns the Id, Name and Email of the active admins
+//	admins, err := users.ListRecords().WhereIsEqualTo("Role", "admin").Execute()
+func (t *Table) WithDefaults(defaults QueryDefaults) *Table {
+	table := *t
+	table.defaults = QueryDefaults{
+		Fields:   slices.Clone(defaults.Fields),
+		ViewID:   defaults.ViewID,
+		Filters:  slices.Clone(defaults.Filters),
+		PageSize: max(defaults.PageSize, 0),
+	}
+	return &table
+}
@@ -0,0 +1,175 @@
+package nocodbgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTableWithDefaults(t *testing.T) {
+	var queries []url.Values
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/users/records", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_, _ = w.Write([]byte(`{"list":[],"pageInfo":{"isLastPage":true}}`))
+	})
+	mux.HandleFunc("GET /api/v2/tables/users/records/count", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_, _ = w.Write([]byte(`{"count":0}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	plain := client.Table("users")
+	users := plain.WithDefaults(QueryDefaults{
+		Fields:   []string{"Id", "Name"},
+		ViewID:   "vw_active",
+		Filters:  []string{"(Status,eq,active)"},
+		PageSize: 100,
+	})
+
+	if _, err := users.ListRecords().WhereIsEqualTo("Role", "admin").Execute(); err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if _, err := users.ListRecords().ReturnFields("Email").Limit(5).WithViewId("vw_all").Execute(); err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+	if _, err := users.CountRecords().Execute(); err != nil {
+		t.Fatalf("CountRecords() error = %v", err)
+	}
+	if _, err := plain.ListRecords().Execute(); err != nil {
+		t.Fatalf("ListRecords() error = %v", err)
+	}
+
+	want := []map[string]string{
+		{"fields": "Id,Name", "viewId": "vw_active", "where": "(Status,eq,active)~and(Role,eq,admin)", "limit": "100"},
+		{"fields": "Email", "viewId": "vw_all", "where": "(Status,eq,active)", "limit": "5"},
+		{"fields": "", "viewId": "vw_active", "where": "(Status,eq,active)", "limit": ""},
+		{"fields": "", "viewId": "", "where": "", "limit": ""},
+	}
+	for i, query := range queries {
+		for key, value := range want[i] {
+			if got := query.Get(key); got != value {
+				t.Errorf("request %d %s = %q, want %q", i, key, got, value)
+			}
+		}
+	}
+}
+
+// TestHelpersIgnoreDefaults checks that the operations built on internal queries see every record
+// and link of the table, not only the ones matching its default filters
+func TestHelpersIgnoreDefaults(t *testing.T) {
+	active := QueryDefaults{Filters: []string{"(Status,eq,active)"}, Fields: []string{"Name"}, PageSize: 1}
+
+	t.Run("Plan", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		fake.Seed("users", map[string]any{"Name": "Ana", "Status": "active"}, map[string]any{"Name": "Bob", "Status": "blocked"})
+
+		plan, err := client.Table("users").WithDefaults(active).Plan([]map[string]any{
+			{"Name": "Ana", "Status": "active"},
+			{"Name": "Bob", "Status": "blocked"},
+		}, "Name").Execute()
+		if err != nil {
+			t.Fatalf("Plan() error = %v", err)
+		}
+		if !plan.IsEmpty() {
+			t.Errorf("Plan() = %+v, want an empty plan", plan)
+		}
+	})
+
+	t.Run("CopyRecordsTo", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		fake.Seed("users", map[string]any{"Name": "Ana", "Status": "active"}, map[string]any{"Name": "Bob", "Status": "blocked"})
+
+		ids, err := client.Table("users").WithDefaults(active).CopyRecordsTo(client.Table("archive")).
+			WithColumnMapping(map[string]string{"Name": "Name", "Status": "Status"}).
+			Execute()
+		if err != nil || len(ids) != 2 {
+			t.Errorf("CopyRecordsTo() = %v, %v, want 2 records", ids, err)
+		}
+	})
+
+	t.Run("Links", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		fake.DefineLink("products", "cl_tags", "tags")
+		fake.Seed("tags", map[string]any{"Name": "Wood", "Status": "active"}, map[string]any{"Name": "Old", "Status": "blocked"})
+		ids := fake.Seed("products", map[string]any{"Name": "Chair"})
+
+		products := client.Table("products")
+		if err := products.CreateLinks("cl_tags", ids[0], []int{1, 2}).Execute(); err != nil {
+			t.Fatalf("CreateLinks() error = %v", err)
+		}
+
+		filtered := products.WithDefaults(active)
+		if linked, err := filtered.HasLink("cl_tags", ids[0], 2).Execute(); err != nil || !linked {
+			t.Errorf("HasLink() = %v, %v, want true", linked, err)
+		}
+		if err := filtered.ReplaceLinks("cl_tags", ids[0], []int{1}).Execute(); err != nil {
+			t.Fatalf("ReplaceLinks() error = %v", err)
+		}
+		if got := fake.LinkedIDs("products", "cl_tags", ids[0]); !reflect.DeepEqual(got, []int64{1}) {
+			t.Errorf("linked tags = %v, want [1]", got)
+		}
+	})
+
+	t.Run("Mirror", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		fake.Seed("users", map[string]any{"Name": "Ana", "Status": "active"}, map[string]any{"Name": "Bob", "Status": "blocked"})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		changes := make(chan []map[string]any, 10)
+		go func() {
+			_ = client.Table("users").WithDefaults(active).Mirror(func(records []map[string]any) error {
+				changes <- records
+				return nil
+			}).WithContext(ctx).WithInterval(5 * time.Millisecond).Execute()
+		}()
+
+		select {
+		case records := <-changes:
+			if len(records) != 2 || records[1]["Status"] != "blocked" {
+				t.Errorf("initial records = %v, want Ana and Bob with every field", records)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for mirror changes")
+		}
+	})
+
+	t.Run("Watch", func(t *testing.T) {
+		client, fake := newFakeClient(t)
+		fake.Seed("users", map[string]any{"Name": "Ana", "Status": "active"}, map[string]any{"Name": "Bob", "Status": "blocked"})
+		users := client.Table("users")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := users.WithDefaults(active).Watch(ctx, 5*time.Millisecond, WatchOptions{})
+		if err != nil {
+			t.Fatalf("Watch() error = %v", err)
+		}
+		if err := users.UpdateRecord(map[string]any{"Id": 2, "Name": "Bob Doe"}).Execute(); err != nil {
+			t.Fatalf("UpdateRecord() error = %v", err)
+		}
+
+		select {
+		case event := <-events:
+			if event.Err != nil || event.Type != ChangeUpdated || event.RecordID != "2" {
+				t.Errorf("event = %+v, want record 2 updated", event)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for watch events")
+		}
+	})
+}
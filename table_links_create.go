@@ -1,6 +1,7 @@
 package nocodbgo
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -10,8 +11,8 @@ import (
 type createLinkBuilder struct {
 	table            *Table
 	localLinkFieldID string
-	localRecordID    int
-	targetRecordID   int
+	localRecordID    any
+	targetRecord     any
 
 	contextProvider[*createLinkBuilder]
 }
@@ -20,14 +21,16 @@ type createLinkBuilder struct {
 //
 // Parameters:
 //   - localLinkFieldID: The identifier for the link field on the local table.
-//   - localRecordID:    The identifier for the local table record to which the target will be linked.
-//   - targetRecordID:   The identifier for the target table record that will be linked.
-func (t *Table) CreateLink(localLinkFieldID string, localRecordID int, targetRecordID int) *createLinkBuilder {
+//   - localRecordID:    The identifier for the local table record to which the target will be linked, can be
+//     a RecordID, any integer type or a string.
+//   - targetRecord:     The target table record that will be linked, can be an integer or string ID, or a
+//     map[string]any or struct containing an "Id" field.
+func (t *Table) CreateLink(localLinkFieldID string, localRecordID any, targetRecord any) *createLinkBuilder {
 	b := &createLinkBuilder{
 		table:            t,
 		localLinkFieldID: localLinkFieldID,
 		localRecordID:    localRecordID,
-		targetRecordID:   targetRecordID,
+		targetRecord:     targetRecord,
 	}
 
 	b.contextProvider = newContextProvider(b)
@@ -37,20 +40,8 @@ func (t *Table) CreateLink(localLinkFieldID string, localRecordID int, targetRec
 
 // Execute finalizes and executes the operation.
 func (b *createLinkBuilder) Execute() error {
-	if b.localLinkFieldID == "" {
-		return ErrLinkFieldIDRequired
-	}
-
-	if b.localRecordID == 0 {
-		return ErrRowIDRequired
-	}
-
-	if b.targetRecordID == 0 {
-		return nil
-	}
-
 	return b.table.
-		CreateLinks(b.localLinkFieldID, b.localRecordID, []int{b.targetRecordID}).
+		CreateLinks(b.localLinkFieldID, b.localRecordID, []any{b.targetRecord}).
 		WithContext(b.contextProvider.ctx).
 		Execute()
 }
@@ -60,38 +51,51 @@ func (b *createLinkBuilder) Execute() error {
 type createLinksBuilder struct {
 	table            *Table
 	localLinkFieldID string
-	localRecordID    int
-	targetRecordIDs  []int
+	localRecordID    RecordID
+	targetRecordIDs  []any
+	chainErr         error // Stores any error in the chain of methods
 
 	contextProvider[*createLinksBuilder]
+	headerProvider[*createLinksBuilder]
 }
 
 // CreateLinks initializes a builder for creating links between a local table record and multiple target table records.
 //
 // Parameters:
 //   - localLinkFieldID: The identifier for the link field on the local table.
-//   - localRecordID:    The identifier for the local table record to which the targets will be linked.
-//   - targetRecordIDs:  A slice of identifiers corresponding to the target table records to be linked.
-func (t *Table) CreateLinks(localLinkFieldID string, localRecordID int, targetRecordIDs []int) *createLinksBuilder {
+//   - localRecordID:    The identifier for the local table record to which the targets will be linked, can be
+//     a RecordID, any integer type or a string.
+//   - targetRecords:    The target table records to be linked, can be a slice of integer or string IDs, or a
+//     slice of map[string]any or structs containing an "Id" field (e.g. previously fetched records).
+func (t *Table) CreateLinks(localLinkFieldID string, localRecordID any, targetRecords any) *createLinksBuilder {
+	localID, localErr := parseRecordID(localRecordID)
+	targetRecordIDs, err := toRecordIDs(targetRecords)
+
 	b := &createLinksBuilder{
 		table:            t,
 		localLinkFieldID: localLinkFieldID,
-		localRecordID:    localRecordID,
+		localRecordID:    localID,
 		targetRecordIDs:  targetRecordIDs,
+		chainErr:         errors.Join(localErr, err),
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
 // Execute finalizes and executes the operation.
 func (b *createLinksBuilder) Execute() error {
+	if b.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
 	if b.localLinkFieldID == "" {
 		return ErrLinkFieldIDRequired
 	}
 
-	if b.localRecordID == 0 {
+	if b.localRecordID.IsZero() {
 		return ErrRowIDRequired
 	}
 
@@ -102,13 +106,28 @@ func (b *createLinksBuilder) Execute() error {
 	// Convert IDs to the payload format expected by the API
 	targetIDS := make([]map[string]any, len(b.targetRecordIDs))
 	for i, id := range b.targetRecordIDs {
-		targetIDS[i] = map[string]any{"Id": id}
+		targetIDS[i] = map[string]any{b.table.recordIDKey(): id}
+	}
+
+	path, perTarget, err := b.table.linksPath(b.contextProvider.ctx, b.localLinkFieldID, b.localRecordID)
+	if err != nil {
+		return err
+	}
+
+	if perTarget {
+		for _, id := range b.targetRecordIDs {
+			targetPath := fmt.Sprintf("%s/%v", path, id)
+			_, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodPost, targetPath, nil, nil, b.headerProvider.rawHeader)
+			if err != nil {
+				return withOperation(fmt.Errorf("failed to link records: %w", err), "CreateLinks", b.table.tableID, b.localRecordID)
+			}
+		}
+		return nil
 	}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/links/%s/records/%d", b.table.tableID, b.localLinkFieldID, b.localRecordID)
-	_, err := b.table.client.request(b.contextProvider.ctx, http.MethodPost, path, targetIDS, nil)
+	_, err = b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodPost, path, targetIDS, nil, b.headerProvider.rawHeader)
 	if err != nil {
-		return fmt.Errorf("failed to link records: %w", err)
+		return withOperation(fmt.Errorf("failed to link records: %w", err), "CreateLinks", b.table.tableID, b.localRecordID)
 	}
 
 	return nil
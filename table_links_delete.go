@@ -1,6 +1,7 @@
 package nocodbgo
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -11,8 +12,8 @@ import (
 type deleteLinkBuilder struct {
 	table            *Table
 	localLinkFieldID string
-	localRecordID    int
-	targetRecordID   int
+	localRecordID    any
+	targetRecord     any
 
 	contextProvider[*deleteLinkBuilder]
 }
@@ -21,14 +22,16 @@ type deleteLinkBuilder struct {
 //
 // Parameters:
 //   - localLinkFieldID: The identifier for the link field on the local table.
-//   - localRecordID:    The identifier for the local table record from which the link needs to be removed.
-//   - targetRecordID:   The identifier for the target table record that needs to be unlinked.
-func (t *Table) DeleteLink(localLinkFieldID string, localRecordID int, targetRecordID int) *deleteLinkBuilder {
+//   - localRecordID:    The identifier for the local table record from which the link needs to be removed, can be
+//     a RecordID, any integer type or a string.
+//   - targetRecord:     The target table record that needs to be unlinked, can be an integer or string ID, or a
+//     map[string]any or struct containing an "Id" field.
+func (t *Table) DeleteLink(localLinkFieldID string, localRecordID any, targetRecord any) *deleteLinkBuilder {
 	b := &deleteLinkBuilder{
 		table:            t,
 		localLinkFieldID: localLinkFieldID,
 		localRecordID:    localRecordID,
-		targetRecordID:   targetRecordID,
+		targetRecord:     targetRecord,
 	}
 
 	b.contextProvider = newContextProvider(b)
@@ -38,20 +41,8 @@ func (t *Table) DeleteLink(localLinkFieldID string, localRecordID int, targetRec
 
 // Execute finalizes and executes the operation.
 func (b *deleteLinkBuilder) Execute() error {
-	if b.localLinkFieldID == "" {
-		return ErrLinkFieldIDRequired
-	}
-
-	if b.localRecordID == 0 {
-		return ErrRowIDRequired
-	}
-
-	if b.targetRecordID == 0 {
-		return nil
-	}
-
 	return b.table.
-		DeleteLinks(b.localLinkFieldID, b.localRecordID, []int{b.targetRecordID}).
+		DeleteLinks(b.localLinkFieldID, b.localRecordID, []any{b.targetRecord}).
 		WithContext(b.contextProvider.ctx).
 		Execute()
 }
@@ -61,38 +52,51 @@ func (b *deleteLinkBuilder) Execute() error {
 type deleteLinksBuilder struct {
 	table            *Table
 	localLinkFieldID string
-	localRecordID    int
-	targetRecordIDs  []int
+	localRecordID    RecordID
+	targetRecordIDs  []any
+	chainErr         error // Stores any error in the chain of methods
 
 	contextProvider[*deleteLinksBuilder]
+	headerProvider[*deleteLinksBuilder]
 }
 
 // DeleteLinks unlinks multiple target table records from a local table record via a specified link field.
 //
 // Parameters:
 //   - localLinkFieldID: The identifier for the link field on the local table.
-//   - localRecordID:    The identifier for the local table record from which the links need to be removed.
-//   - targetRecordIDs:  A slice of identifiers for the target table records that need to be unlinked.
-func (t *Table) DeleteLinks(localLinkFieldID string, localRecordID int, targetRecordIDs []int) *deleteLinksBuilder {
+//   - localRecordID:    The identifier for the local table record from which the links need to be removed, can be
+//     a RecordID, any integer type or a string.
+//   - targetRecords:    The target table records that need to be unlinked, can be a slice of integer or string IDs,
+//     or a slice of map[string]any or structs containing an "Id" field (e.g. previously fetched records).
+func (t *Table) DeleteLinks(localLinkFieldID string, localRecordID any, targetRecords any) *deleteLinksBuilder {
+	localID, localErr := parseRecordID(localRecordID)
+	targetRecordIDs, err := toRecordIDs(targetRecords)
+
 	b := &deleteLinksBuilder{
 		table:            t,
 		localLinkFieldID: localLinkFieldID,
-		localRecordID:    localRecordID,
+		localRecordID:    localID,
 		targetRecordIDs:  targetRecordIDs,
+		chainErr:         errors.Join(localErr, err),
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
 // Execute finalizes and executes the operation.
 func (b *deleteLinksBuilder) Execute() error {
+	if b.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
 	if b.localLinkFieldID == "" {
 		return ErrLinkFieldIDRequired
 	}
 
-	if b.localRecordID == 0 {
+	if b.localRecordID.IsZero() {
 		return ErrRowIDRequired
 	}
 
@@ -103,13 +107,28 @@ func (b *deleteLinksBuilder) Execute() error {
 	// Convert IDs to the format expected by the API
 	ids := make([]map[string]any, len(b.targetRecordIDs))
 	for i, id := range b.targetRecordIDs {
-		ids[i] = map[string]any{"Id": id}
+		ids[i] = map[string]any{b.table.recordIDKey(): id}
+	}
+
+	path, perTarget, err := b.table.linksPath(b.contextProvider.ctx, b.localLinkFieldID, b.localRecordID)
+	if err != nil {
+		return err
+	}
+
+	if perTarget {
+		for _, id := range b.targetRecordIDs {
+			targetPath := fmt.Sprintf("%s/%v", path, id)
+			_, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodDelete, targetPath, nil, nil, b.headerProvider.rawHeader)
+			if err != nil {
+				return withOperation(fmt.Errorf("failed to unlink records: %w", err), "DeleteLinks", b.table.tableID, b.localRecordID)
+			}
+		}
+		return nil
 	}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/links/%s/records/%d", b.table.tableID, b.localLinkFieldID, b.localRecordID)
-	_, err := b.table.client.request(b.contextProvider.ctx, http.MethodDelete, path, ids, nil)
+	_, err = b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodDelete, path, ids, nil, b.headerProvider.rawHeader)
 	if err != nil {
-		return fmt.Errorf("failed to unlink records: %w", err)
+		return withOperation(fmt.Errorf("failed to unlink records: %w", err), "DeleteLinks", b.table.tableID, b.localRecordID)
 	}
 
 	return nil
@@ -0,0 +1,66 @@
+package nocodbgo
+
+import (
+	"fmt"
+)
+
+// hasLinkBuilder provides a fluent interface for checking whether a target record is linked to a local
+// record via a specified link field.
+type hasLinkBuilder struct {
+	table            *Table
+	localLinkFieldID string
+	localRecordID    any
+	targetRecord     any
+
+	contextProvider[*hasLinkBuilder]
+}
+
+// HasLink initializes a builder for checking whether a target record is linked to a local record.
+//
+// The check is done with a single filtered request that returns at most one record, which makes it
+// useful for idempotent linking workflows.
+//
+// Parameters:
+//   - localLinkFieldID: The identifier for the link field on the local table.
+//   - localRecordID:    The identifier for the local table record whose links will be checked, can be
+//     a RecordID, any integer type or a string.
+//   - targetRecord:     The target table record to look for, can be an integer or string ID, or a
+//     map[string]any or struct containing an "Id" field.
+func (t *Table) HasLink(localLinkFieldID string, localRecordID any, targetRecord any) *hasLinkBuilder {
+	b := &hasLinkBuilder{
+		table:            t,
+		localLinkFieldID: localLinkFieldID,
+		localRecordID:    localRecordID,
+		targetRecord:     targetRecord,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *hasLinkBuilder) Execute() (bool, error) {
+	targetRecordID, err := toRecordID(b.targetRecord)
+	if err != nil {
+		return false, fmt.Errorf("error in the chain of methods: %w", err)
+	}
+
+	if targetRecordID == nil {
+		return false, ErrRowIDRequired
+	}
+
+	response, err := b.table.
+		WithDefaults(QueryDefaults{}).
+		ListLinks(b.localLinkFieldID, b.localRecordID).
+		WithContext(b.contextProvider.ctx).
+		WhereIsEqualTo("Id", fmt.Sprint(targetRecordID)).
+		ReturnFields("Id").
+		Limit(1).
+		Execute()
+	if err != nil {
+		return false, fmt.Errorf("failed to check link: %w", err)
+	}
+
+	return len(response.List) > 0, nil
+}
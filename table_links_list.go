@@ -1,10 +1,12 @@
 package nocodbgo
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 // listLinksBuilder provides a fluent interface for constructing a query to retrieve linked records.
@@ -13,25 +15,31 @@ import (
 type listLinksBuilder struct {
 	table            *Table
 	localLinkFieldID string
-	localRecordID    int
+	localRecordID    RecordID
 
 	contextProvider[*listLinksBuilder]
 	filterProvider[*listLinksBuilder]
 	sortProvider[*listLinksBuilder]
 	paginationProvider[*listLinksBuilder]
 	fieldProvider[*listLinksBuilder]
+	queryParamProvider[*listLinksBuilder]
+	headerProvider[*listLinksBuilder]
+	paginationDriftProvider[*listLinksBuilder]
 }
 
 // ListLinks lists the target table records linked to a local table record via a specified link field.
 //
 // Parameters:
 //   - localLinkFieldID: the identifier of the link field used to associate records.
-//   - localRecordID: the identifier of the local table record whose linked records are being retrieved.
-func (t *Table) ListLinks(localLinkFieldID string, localRecordID int) *listLinksBuilder {
+//   - localRecordID: the identifier of the local table record whose linked records are being retrieved, can be
+//     a RecordID, any integer type or a string.
+func (t *Table) ListLinks(localLinkFieldID string, localRecordID any) *listLinksBuilder {
+	localID, err := parseRecordID(localRecordID)
+
 	b := &listLinksBuilder{
 		table:            t,
 		localLinkFieldID: localLinkFieldID,
-		localRecordID:    localRecordID,
+		localRecordID:    localID,
 	}
 
 	b.contextProvider = newContextProvider(b)
@@ -39,36 +47,165 @@ func (t *Table) ListLinks(localLinkFieldID string, localRecordID int) *listLinks
 	b.sortProvider = newSortProvider(b)
 	b.paginationProvider = newPaginationProvider(b)
 	b.fieldProvider = newFieldProvider(b)
+	b.queryParamProvider = newQueryParamProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+	b.paginationDriftProvider = newPaginationDriftProvider(b)
+
+	b.filterProvider.rawFilters = append(b.filterProvider.rawFilters, t.defaults.Filters...)
+	b.fieldProvider.rawFields = append(b.fieldProvider.rawFields, t.defaults.Fields...)
+	b.paginationProvider.rawLimit = t.defaults.PageSize
+
+	if err != nil {
+		b.filterProvider.addErr(err)
+	}
 
 	return b
 }
 
+// Clone returns a copy of the builder that can be modified and executed independently of the
+// original, so a base query can be built once and reused concurrently with per-request variations.
+//
+// Example:
+//
+//	base := table.ListLinks("cl_xxxxxxxxxxxxxx", recordID).WhereIsEqualTo("Status", "open")
+//
+//	// In each request
+//	query := base.Clone().WhereIsEqualTo("Owner", userID)
+func (b *listLinksBuilder) Clone() *listLinksBuilder {
+	c := &listLinksBuilder{
+		table:            b.table,
+		localLinkFieldID: b.localLinkFieldID,
+		localRecordID:    b.localRecordID,
+	}
+
+	c.contextProvider = b.contextProvider.clone(c)
+	c.filterProvider = b.filterProvider.clone(c)
+	c.sortProvider = b.sortProvider.clone(c)
+	c.paginationProvider = b.paginationProvider.clone(c)
+	c.fieldProvider = b.fieldProvider.clone(c)
+	c.queryParamProvider = b.queryParamProvider.clone(c)
+	c.headerProvider = b.headerProvider.clone(c)
+	c.paginationDriftProvider = b.paginationDriftProvider.clone(c)
+
+	return c
+}
+
 // Execute finalizes and executes the operation.
 func (b *listLinksBuilder) Execute() (ListResponse, error) {
+	if err := b.validate(); err != nil {
+		return ListResponse{}, err
+	}
+
+	return b.fetch(b.contextProvider.ctx, b.buildQuery())
+}
+
+// ExecuteAll finalizes and executes the operation fetching all the linked records page by page.
+//
+// If a limit has been set it is used as the page size, and if an offset has been set the
+// iteration starts from it.
+func (b *listLinksBuilder) ExecuteAll() (ListResponse, error) {
+	return collectAll(b.Iterate())
+}
+
+// Iterate finalizes the operation and returns an iterator over all the linked records,
+// fetching them page by page as the iteration advances.
+//
+// If a limit has been set it is used as the page size, and if an offset has been set the
+// iteration starts from it.
+func (b *listLinksBuilder) Iterate() *RecordIterator {
+	fetch := func(ctx context.Context, limit int, offset int) (ListResponse, error) {
+		if err := b.validate(); err != nil {
+			return ListResponse{}, err
+		}
+
+		query := b.buildQuery()
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("offset", strconv.Itoa(offset))
+		return b.fetch(ctx, query)
+	}
+
+	it := newRecordIterator(b.contextProvider.ctx, fetch, b.table.client.pageSize(b.paginationProvider.rawLimit, defaultIteratorPageSize), b.paginationProvider.rawOffset)
+	return b.paginationDriftProvider.apply(it)
+}
+
+// validate checks that the required identifiers have been provided and the query options are valid.
+func (b *listLinksBuilder) validate() error {
 	if b.localLinkFieldID == "" {
-		return ListResponse{}, ErrLinkFieldIDRequired
+		return ErrLinkFieldIDRequired
+	}
+
+	if b.localRecordID.IsZero() {
+		return ErrRowIDRequired
 	}
 
-	if b.localRecordID == 0 {
-		return ListResponse{}, ErrRowIDRequired
+	if err := errors.Join(b.filterProvider.chainErr, b.sortProvider.chainErr, b.paginationProvider.chainErr); err != nil {
+		return fmt.Errorf("error in the chain of methods: %w", err)
 	}
 
+	return nil
+}
+
+// buildQuery builds the query parameters for the request from all the providers.
+func (b *listLinksBuilder) buildQuery() url.Values {
 	query := url.Values{}
 	query = b.filterProvider.apply(query)
 	query = b.sortProvider.apply(query)
 	query = b.paginationProvider.apply(query)
+	query = b.table.client.applyPageSize(query, b.paginationProvider.rawLimit)
 	query = b.fieldProvider.apply(query)
+	query = b.queryParamProvider.apply(query)
+	return query
+}
+
+// Query renders the request the builder sends when executed, without executing it, to assert
+// in tests exactly what the builder sends or to debug its filters.
+//
+// With the v1 data API the path of the links depends on the link column, so the schema of the
+// table is read to render it.
+func (b *listLinksBuilder) Query() (RenderedQuery, error) {
+	if err := b.validate(); err != nil {
+		return RenderedQuery{}, err
+	}
+
+	return b.render(b.contextProvider.ctx, b.buildQuery())
+}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/links/%s/records/%d", b.table.tableID, b.localLinkFieldID, b.localRecordID)
-	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, query)
+// String implements the fmt.Stringer interface for listLinksBuilder, returning the rendered
+// request of the builder.
+func (b *listLinksBuilder) String() string {
+	return renderedString(b.Query())
+}
+
+// render renders the request with the given query parameters for the API version of the client.
+func (b *listLinksBuilder) render(ctx context.Context, query url.Values) (RenderedQuery, error) {
+	path, _, err := b.table.linksPath(ctx, b.localLinkFieldID, b.localRecordID)
+	if err != nil {
+		return RenderedQuery{}, err
+	}
+	query, err = b.table.listQuery(query)
+	if err != nil {
+		return RenderedQuery{}, err
+	}
+
+	return RenderedQuery{Method: http.MethodGet, Path: path, Query: query}, nil
+}
+
+// fetch sends the request with the given query parameters and decodes the response.
+func (b *listLinksBuilder) fetch(ctx context.Context, query url.Values) (ListResponse, error) {
+	rendered, err := b.render(ctx, query)
 	if err != nil {
-		return ListResponse{}, fmt.Errorf("failed to list linked records: %w", err)
+		return ListResponse{}, err
+	}
+	respBody, err := b.table.client.requestWithHeader(ctx, rendered.Method, rendered.Path, nil, rendered.Query, b.headerProvider.rawHeader)
+	if err != nil {
+		return ListResponse{}, withOperation(fmt.Errorf("failed to list linked records: %w", err), "ListLinks", b.table.tableID, b.localRecordID)
 	}
 
-	var response ListResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
+	response, err := b.table.decodeList(respBody, rendered.Query)
+	if err != nil {
 		return ListResponse{}, fmt.Errorf("failed to unmarshal linked records response: %w", err)
 	}
+	b.fieldProvider.stripSystemFields(response.List...)
 
 	return response, nil
 }
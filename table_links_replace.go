@@ -0,0 +1,142 @@
+package nocodbgo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// replaceLinksBuilder provides a fluent interface for declaratively setting the exact set of target records
+// linked to a local record via a specified link field.
+type replaceLinksBuilder struct {
+	table            *Table
+	localLinkFieldID string
+	localRecordID    RecordID
+	targetRecordIDs  []RecordID
+	chainErr         error // Stores any error in the chain of methods
+
+	contextProvider[*replaceLinksBuilder]
+}
+
+// ReplaceLinks initializes a builder that makes the target records linked to a local record exactly
+// match the provided ones.
+//
+// It fetches the currently linked records, computes the difference and only links or unlinks the
+// records that need to change. Passing an empty slice unlinks every linked record.
+//
+// Parameters:
+//   - localLinkFieldID: The identifier for the link field on the local table.
+//   - localRecordID:    The identifier for the local table record whose links will be replaced, can be
+//     a RecordID, any integer type or a string.
+//   - targetRecordIDs:  A slice of identifiers for the target table records that should remain linked, of
+//     RecordIDs, integers or strings.
+func (t *Table) ReplaceLinks(localLinkFieldID string, localRecordID any, targetRecordIDs any) *replaceLinksBuilder {
+	localID, localErr := parseRecordID(localRecordID)
+	targetIDs, err := parseRecordIDs(targetRecordIDs)
+
+	b := &replaceLinksBuilder{
+		table:            t,
+		localLinkFieldID: localLinkFieldID,
+		localRecordID:    localID,
+		targetRecordIDs:  targetIDs,
+		chainErr:         errors.Join(localErr, err),
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *replaceLinksBuilder) Execute() error {
+	if b.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.localLinkFieldID == "" {
+		return ErrLinkFieldIDRequired
+	}
+
+	if b.localRecordID.IsZero() {
+		return ErrRowIDRequired
+	}
+
+	currentIDs, err := b.currentLinkIDs()
+	if err != nil {
+		return err
+	}
+
+	toLink, toUnlink := diffRecordIDs(currentIDs, b.targetRecordIDs)
+
+	err = b.table.
+		DeleteLinks(b.localLinkFieldID, b.localRecordID, toUnlink).
+		WithContext(b.contextProvider.ctx).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("failed to replace links: %w", err)
+	}
+
+	err = b.table.
+		CreateLinks(b.localLinkFieldID, b.localRecordID, toLink).
+		WithContext(b.contextProvider.ctx).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("failed to replace links: %w", err)
+	}
+
+	return nil
+}
+
+// currentLinkIDs fetches the identifiers of all the target records currently linked to the local record.
+func (b *replaceLinksBuilder) currentLinkIDs() ([]RecordID, error) {
+	it := b.table.
+		WithDefaults(QueryDefaults{}).
+		ListLinks(b.localLinkFieldID, b.localRecordID).
+		WithContext(b.contextProvider.ctx).
+		ReturnFields("Id").
+		Iterate()
+
+	var ids []RecordID
+	for it.Next() {
+		if id, err := parseRecordID(it.Record()["Id"]); err == nil && !id.IsZero() {
+			ids = append(ids, id)
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read current links: %w", err)
+	}
+
+	return ids, nil
+}
+
+// diffRecordIDs compares the current and desired record identifiers and returns the identifiers
+// that must be added and the ones that must be removed to go from current to desired.
+//
+// The order of the desired and current slices is preserved and duplicated desired identifiers are ignored.
+// The identifiers are compared by their string form, so an integer ID matches a string holding it.
+func diffRecordIDs(current []RecordID, desired []RecordID) (toAdd []RecordID, toRemove []RecordID) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, id := range current {
+		currentSet[id.String()] = struct{}{}
+	}
+
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, id := range desired {
+		if _, seen := desiredSet[id.String()]; seen {
+			continue
+		}
+		desiredSet[id.String()] = struct{}{}
+
+		if _, ok := currentSet[id.String()]; !ok {
+			toAdd = append(toAdd, id)
+		}
+	}
+
+	for _, id := range current {
+		if _, ok := desiredSet[id.String()]; !ok {
+			toRemove = append(toRemove, id)
+		}
+	}
+
+	return toAdd, toRemove
+}
@@ -0,0 +1,57 @@
+package nocodbgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffRecordIDs(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      []RecordID
+		desired      []RecordID
+		wantToAdd    []RecordID
+		wantToRemove []RecordID
+	}{
+		{
+			name:         "no changes",
+			current:      []RecordID{IntID(1), IntID(2), IntID(3)},
+			desired:      []RecordID{IntID(3), IntID(2), IntID(1)},
+			wantToAdd:    nil,
+			wantToRemove: nil,
+		},
+		{
+			name:         "add and remove",
+			current:      []RecordID{IntID(1), IntID(2), IntID(3)},
+			desired:      []RecordID{IntID(2), IntID(4), IntID(5)},
+			wantToAdd:    []RecordID{IntID(4), IntID(5)},
+			wantToRemove: []RecordID{IntID(1), IntID(3)},
+		},
+		{
+			name:         "remove all",
+			current:      []RecordID{IntID(1), IntID(2)},
+			desired:      []RecordID{},
+			wantToAdd:    nil,
+			wantToRemove: []RecordID{IntID(1), IntID(2)},
+		},
+		{
+			name:         "add to empty with duplicates",
+			current:      nil,
+			desired:      []RecordID{IntID(7), IntID(7), IntID(8)},
+			wantToAdd:    []RecordID{IntID(7), IntID(8)},
+			wantToRemove: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := diffRecordIDs(tt.current, tt.desired)
+			if !reflect.DeepEqual(toAdd, tt.wantToAdd) {
+				t.Errorf("diffRecordIDs() toAdd = %v, want %v", toAdd, tt.wantToAdd)
+			}
+			if !reflect.DeepEqual(toRemove, tt.wantToRemove) {
+				t.Errorf("diffRecordIDs() toRemove = %v, want %v", toRemove, tt.wantToRemove)
+			}
+		})
+	}
+}
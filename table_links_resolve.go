@@ -0,0 +1,320 @@
+package nocodbgo
+
+import (
+	"fmt"
+)
+
+const (
+	// defaultResolveLinksBatchSize is the default number of target records fetched per request
+	// when resolving linked records
+	defaultResolveLinksBatchSize = 100
+
+	// resolveLinksNestedLimit is the number of linked records requested inline per record when
+	// reading the links of a batch of records. A record with as many links may have more, which
+	// are then listed through the links API
+	resolveLinksNestedLimit = 1000
+)
+
+// resolveLinksBuilder provides a fluent interface for resolving the records linked through a link
+// field and attaching them to the records that reference them.
+type resolveLinksBuilder struct {
+	table            *Table
+	records          []map[string]any
+	localLinkFieldID string
+	localLinkField   string
+	targetTable      *Table
+	batchSize        int
+
+	contextProvider[*resolveLinksBuilder]
+	fieldProvider[*resolveLinksBuilder]
+}
+
+// ResolveLinks initializes a builder that, given a list of records of this table, fetches the records they
+// are linked to from the target table and attaches them to each record under the link field title.
+//
+// The target records are fetched in batches using "in" filters instead of one request per record,
+// removing the common N+1 pattern. When the link field value of a record already contains the linked
+// IDs (e.g. belongs-to links) they are used directly. Otherwise, as with the has-many and many-to-many
+// links that the list API returns as counts, the records are read again in batches with the IDs of
+// their links inline. Only the records whose links can't be read that way, because they have more
+// than 1000 links or the server doesn't return them inline, are listed one by one through the links
+// API as a last resort.
+//
+// After execution the link field of each record contains a []map[string]any with the linked records,
+// so the response can be decoded into structs with nested struct slices.
+//
+// Parameters:
+//   - records:          The records of this table whose links will be resolved, they are not modified.
+//   - localLinkFieldID: The identifier for the link field on the local table.
+//   - localLinkField:   The title of the link field on the local table, used to read and attach the links.
+//   - targetTable:      The table that contains the linked records.
+func (t *Table) ResolveLinks(records []map[string]any, localLinkFieldID string, localLinkField string, targetTable *Table) *resolveLinksBuilder {
+	b := &resolveLinksBuilder{
+		table:            t,
+		records:          records,
+		localLinkFieldID: localLinkFieldID,
+		localLinkField:   localLinkField,
+		targetTable:      targetTable,
+		batchSize:        defaultResolveLinksBatchSize,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.fieldProvider = newFieldProvider(b)
+
+	return b
+}
+
+// WithBatchSize sets the maximum number of target records fetched per request.
+//
+// If not set, it defaults to 100.
+func (b *resolveLinksBuilder) WithBatchSize(batchSize int) *resolveLinksBuilder {
+	if batchSize > 0 {
+		b.batchSize = batchSize
+	}
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *resolveLinksBuilder) Execute() (ListResponse, error) {
+	if b.localLinkFieldID == "" {
+		return ListResponse{}, ErrLinkFieldIDRequired
+	}
+
+	linkedIDs, err := b.linkedIDs()
+	if err != nil {
+		return ListResponse{}, err
+	}
+
+	uniqueIDs := []any{}
+	seen := map[string]struct{}{}
+	for _, ids := range linkedIDs {
+		for _, id := range ids {
+			key := fmt.Sprint(id)
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				uniqueIDs = append(uniqueIDs, id)
+			}
+		}
+	}
+
+	targets, err := b.fetchTargets(uniqueIDs)
+	if err != nil {
+		return ListResponse{}, err
+	}
+
+	resolved := make([]map[string]any, len(b.records))
+	for i, record := range b.records {
+		copied := copyMap(record)
+
+		linked := []map[string]any{}
+		for _, id := range linkedIDs[i] {
+			if target, ok := targets[fmt.Sprint(id)]; ok {
+				linked = append(linked, target)
+			}
+		}
+
+		copied[b.localLinkField] = linked
+		resolved[i] = copied
+	}
+
+	return ListResponse{
+		List: resolved,
+		PageInfo: PageInfo{
+			TotalRows:   len(resolved),
+			Page:        1,
+			PageSize:    len(resolved),
+			IsFirstPage: true,
+			IsLastPage:  true,
+		},
+	}, nil
+}
+
+// linkedIDs returns the IDs of the target records linked to each record, reading them from the link
+// field values when present, from the links returned inline for batches of records otherwise, and
+// from the links API for the records left.
+func (b *resolveLinksBuilder) linkedIDs() ([][]any, error) {
+	linkedIDs := make([][]any, len(b.records))
+	pending := map[string][]int{}
+	pendingIDs := []RecordID{}
+
+	for i, record := range b.records {
+		if ids, ok := linkedIDsFromValue(record[b.localLinkField]); ok {
+			linkedIDs[i] = ids
+			continue
+		}
+
+		recordID, err := parseRecordID(record["Id"])
+		if err != nil || recordID.IsZero() {
+			return nil, fmt.Errorf("%w: record without Id field", ErrInvalidRecordID)
+		}
+
+		key := recordID.String()
+		if _, ok := pending[key]; !ok {
+			pendingIDs = append(pendingIDs, recordID)
+		}
+		pending[key] = append(pending[key], i)
+	}
+
+	for start := 0; start < len(pendingIDs); start += b.batchSize {
+		batch := pendingIDs[start:min(start+b.batchSize, len(pendingIDs))]
+
+		inline, err := b.inlineLinkedIDs(batch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, recordID := range batch {
+			ids, ok := inline[recordID.String()]
+			if !ok {
+				// Last resort, one request per record
+				if ids, err = b.listLinkedIDs(recordID); err != nil {
+					return nil, err
+				}
+			}
+			for _, i := range pending[recordID.String()] {
+				linkedIDs[i] = ids
+			}
+		}
+	}
+
+	return linkedIDs, nil
+}
+
+// inlineLinkedIDs reads the records with the given IDs again with the IDs of their links returned
+// inline in the link field, and returns them indexed by the record ID formatted as a string.
+//
+// The records whose links are not returned inline, or may have been cut at the nested limit, are
+// left out.
+func (b *resolveLinksBuilder) inlineLinkedIDs(recordIDs []RecordID) (map[string][]any, error) {
+	values := make([]string, len(recordIDs))
+	for i, recordID := range recordIDs {
+		values[i] = recordID.String()
+	}
+
+	response, err := b.table.
+		WithDefaults(QueryDefaults{}).
+		ListRecords().
+		WithContext(b.contextProvider.ctx).
+		WhereIsIn("Id", values...).
+		SortAscBy("Id").
+		ReturnFields("Id", b.localLinkField).
+		WithNestedFields(b.localLinkField, "Id").
+		WithNestedLimit(b.localLinkField, resolveLinksNestedLimit).
+		ExecuteAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read linked records: %w", err)
+	}
+
+	inline := make(map[string][]any, len(response.List))
+	for _, record := range response.List {
+		recordID, err := parseRecordID(record["Id"])
+		if err != nil || recordID.IsZero() {
+			continue
+		}
+		ids, ok := linkedIDsFromValue(record[b.localLinkField])
+		if !ok || len(ids) >= resolveLinksNestedLimit {
+			continue
+		}
+		inline[recordID.String()] = ids
+	}
+
+	return inline, nil
+}
+
+// listLinkedIDs lists the IDs of the target records linked to a record through the links API.
+func (b *resolveLinksBuilder) listLinkedIDs(recordID RecordID) ([]any, error) {
+	it := b.table.
+		WithDefaults(QueryDefaults{}).
+		ListLinks(b.localLinkFieldID, recordID).
+		WithContext(b.contextProvider.ctx).
+		ReturnFields("Id").
+		Iterate()
+
+	ids := []any{}
+	for it.Next() {
+		id, err := toRecordID(it.Record())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read linked records: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read linked records: %w", err)
+	}
+
+	return ids, nil
+}
+
+// fetchTargets fetches the target records with the given IDs in batches and returns them indexed
+// by their ID formatted as a string.
+func (b *resolveLinksBuilder) fetchTargets(ids []any) (map[string]map[string]any, error) {
+	targets := make(map[string]map[string]any, len(ids))
+
+	for start := 0; start < len(ids); start += b.batchSize {
+		end := start + b.batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		values := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			values = append(values, fmt.Sprint(id))
+		}
+
+		query := b.targetTable.
+			ListRecords().
+			WithContext(b.contextProvider.ctx).
+			WhereIsIn("Id", values...).
+			SortAscBy("Id")
+		if len(b.fieldProvider.rawFields) > 0 {
+			query = query.ReturnFields(append([]string{"Id"}, b.fieldProvider.rawFields...)...)
+		}
+
+		response, err := query.ExecuteAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch linked records: %w", err)
+		}
+
+		for _, record := range response.List {
+			id, err := toRecordID(record)
+			if err != nil || id == nil {
+				continue
+			}
+			targets[fmt.Sprint(id)] = record
+		}
+	}
+
+	return targets, nil
+}
+
+// linkedIDsFromValue extracts the linked record IDs from a link field value when it contains the
+// linked records themselves (a single object or a list of objects with an "Id" field).
+//
+// It returns false when the value doesn't contain the IDs, e.g. when it's a count of linked records.
+func linkedIDsFromValue(value any) ([]any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		id, err := toRecordID(v)
+		if err != nil || id == nil {
+			return nil, false
+		}
+		return []any{id}, true
+	case []any:
+		ids := make([]any, 0, len(v))
+		for _, item := range v {
+			itemMap, ok := item.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			id, err := toRecordID(itemMap)
+			if err != nil || id == nil {
+				return nil, false
+			}
+			ids = append(ids, id)
+		}
+		return ids, true
+	}
+
+	return nil, false
+}
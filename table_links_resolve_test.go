@@ -0,0 +1,112 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLinkedIDsFromValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  any
+		want   []any
+		wantOk bool
+	}{
+		{
+			name:   "belongs to object",
+			value:  map[string]any{"Id": float64(4), "Title": "Foo"},
+			want:   []any{int64(4)},
+			wantOk: true,
+		},
+		{
+			name:   "list of objects",
+			value:  []any{map[string]any{"Id": float64(1)}, map[string]any{"Id": "abc"}},
+			want:   []any{int64(1), "abc"},
+			wantOk: true,
+		},
+		{
+			name:   "empty list",
+			value:  []any{},
+			want:   []any{},
+			wantOk: true,
+		},
+		{
+			name:   "links count",
+			value:  float64(3),
+			wantOk: false,
+		},
+		{
+			name:   "missing value",
+			value:  nil,
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := linkedIDsFromValue(tt.value)
+			if ok != tt.wantOk {
+				t.Fatalf("linkedIDsFromValue() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if ok && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("linkedIDsFromValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveLinksInBatches(t *testing.T) {
+	var requests []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/customers/records", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		requests = append(requests, "customers where="+query.Get("where")+" nested="+query.Get("nested[Orders][fields]"))
+		// Customer 3 has its links as a count, as returned by servers without inline links
+		fmt.Fprint(w, `{"list":[{"Id":1,"Orders":[{"Id":10},{"Id":11}]},{"Id":2,"Orders":[]},{"Id":3,"Orders":1}],"pageInfo":{"isLastPage":true}}`)
+	})
+	mux.HandleFunc("GET /api/v2/tables/customers/links/orders/records/{recordId}", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "links "+r.PathValue("recordId"))
+		fmt.Fprint(w, `{"list":[{"Id":12}],"pageInfo":{"isLastPage":true}}`)
+	})
+	mux.HandleFunc("GET /api/v2/tables/orders/records", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "orders where="+r.URL.Query().Get("where"))
+		fmt.Fprint(w, `{"list":[{"Id":10,"Title":"A"},{"Id":11,"Title":"B"},{"Id":12,"Title":"C"}],"pageInfo":{"isLastPage":true}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// The list API returns has-many links as counts
+	records := []map[string]any{{"Id": 1, "Orders": 2}, {"Id": 2, "Orders": 0}, {"Id": 3, "Orders": 1}}
+	resolved, err := client.Table("customers").
+		ResolveLinks(records, "orders", "Orders", client.Table("orders")).
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	wantRequests := []string{
+		"customers where=(Id,in,1,2,3) nested=Id",
+		"links 3",
+		"orders where=(Id,in,10,11,12)",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %q, want %q", requests, wantRequests)
+	}
+
+	counts := make([]int, len(resolved.List))
+	for i, record := range resolved.List {
+		counts[i] = len(record["Orders"].([]map[string]any))
+	}
+	if !reflect.DeepEqual(counts, []int{2, 0, 1}) {
+		t.Errorf("linked records per customer = %v, want [2 0 1]", counts)
+	}
+}
@@ -0,0 +1,118 @@
+package nocodbgo
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+// newFakeServerClient starts a nocodbgotest server and returns a client connected to it
+func newFakeServerClient(t *testing.T) (*Client, *nocodbgotest.Server) {
+	t.Helper()
+
+	server := nocodbgotest.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := NewClient().
+		WithBaseURL(server.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(server.HTTPClient()).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return client, server
+}
+
+func TestLinksWithFakeServer(t *testing.T) {
+	client, server := newFakeServerClient(t)
+	server.DefineLink("customers", "orders", "orders")
+	server.Seed("customers", map[string]any{"Name": "John"}, map[string]any{"Name": "Jane"})
+	server.Seed("orders",
+		map[string]any{"Title": "A"},
+		map[string]any{"Title": "B"},
+		map[string]any{"Title": "C"},
+	)
+
+	customers := client.Table("customers")
+
+	t.Run("CreateLinks", func(t *testing.T) {
+		err := customers.CreateLinks("orders", 1, []map[string]any{{"Id": float64(1)}, {"Id": float64(2)}}).Execute()
+		if err != nil {
+			t.Fatalf("CreateLinks() error = %v", err)
+		}
+		if got := server.LinkedIDs("customers", "orders", 1); !reflect.DeepEqual(got, []int64{1, 2}) {
+			t.Errorf("LinkedIDs() = %v, want [1 2]", got)
+		}
+	})
+
+	t.Run("HasLink", func(t *testing.T) {
+		linked, err := customers.HasLink("orders", 1, 2).Execute()
+		if err != nil || !linked {
+			t.Errorf("HasLink() = %v, %v, want true, nil", linked, err)
+		}
+
+		linked, err = customers.HasLink("orders", 1, 3).Execute()
+		if err != nil || linked {
+			t.Errorf("HasLink() = %v, %v, want false, nil", linked, err)
+		}
+	})
+
+	t.Run("ReplaceLinks", func(t *testing.T) {
+		if err := customers.ReplaceLinks("orders", 1, []int{2, 3}).Execute(); err != nil {
+			t.Fatalf("ReplaceLinks() error = %v", err)
+		}
+		if got := server.LinkedIDs("customers", "orders", 1); !reflect.DeepEqual(got, []int64{2, 3}) {
+			t.Errorf("LinkedIDs() = %v, want [2 3]", got)
+		}
+	})
+
+	t.Run("ListLinksExecuteAll", func(t *testing.T) {
+		response, err := customers.ListLinks("orders", 1).Limit(1).SortDescBy("Title").ExecuteAll()
+		if err != nil {
+			t.Fatalf("ExecuteAll() error = %v", err)
+		}
+		if len(response.List) != 2 || response.List[0]["Title"] != "C" {
+			t.Errorf("ExecuteAll() list = %v, want [C B]", response.List)
+		}
+	})
+
+	t.Run("ResolveLinks", func(t *testing.T) {
+		list, err := customers.ListRecords().SortAscBy("Id").Execute()
+		if err != nil {
+			t.Fatalf("ListRecords() error = %v", err)
+		}
+
+		resolved, err := customers.
+			ResolveLinks(list.List, "orders", "Orders", client.Table("orders")).
+			ReturnFields("Title").
+			WithBatchSize(1).
+			Execute()
+		if err != nil {
+			t.Fatalf("ResolveLinks() error = %v", err)
+		}
+
+		type Order struct {
+			Title string `json:"Title"`
+		}
+		type Customer struct {
+			Name   string  `json:"Name"`
+			Orders []Order `json:"Orders"`
+		}
+
+		var decoded []Customer
+		if err := resolved.DecodeInto(&decoded); err != nil {
+			t.Fatalf("DecodeInto() error = %v", err)
+		}
+
+		want := []Customer{
+			{Name: "John", Orders: []Order{{Title: "B"}, {Title: "C"}}},
+			{Name: "Jane", Orders: []Order{}},
+		}
+		if !reflect.DeepEqual(decoded, want) {
+			t.Errorf("ResolveLinks() decoded = %+v, want %+v", decoded, want)
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultMirrorInterval is the default time between incremental refreshes of a mirror
+	defaultMirrorInterval = time.Minute
+
+	// defaultMirrorUpdatedAtField is the default field used to detect updated records
+	defaultMirrorUpdatedAtField = "UpdatedAt"
+)
+
+// mirrorBuilder is used to build a table mirror with a fluent API
+type mirrorBuilder struct {
+	table          *Table
+	onChange       func(records []map[string]any) error
+	onError        func(err error)
+	interval       time.Duration
+	updatedAtField string
+
+	contextProvider[*mirrorBuilder]
+	filterProvider[*mirrorBuilder]
+	fieldProvider[*mirrorBuilder]
+}
+
+// Mirror keeps a warm local copy of the table by doing an initial full fetch and then periodic
+// incremental refreshes that only fetch the records updated since the last refresh.
+//
+// The onChange callback is called once with all the records after the initial fetch and then with
+// the created or updated records after each refresh that finds changes, so it should upsert them by
+// ID into the caller's own slice, map or cache. Deleted records can't be detected this way.
+//
+// Execute blocks until the context is done, so it's usually run in its own goroutine.
+//
+// Parameters:
+//   - onChange: The callback that receives the fetched records, returning an error stops the mirror.
+//
+// Example:
+//
+//	var mu sync.Mutex
+//	users := map[float64]map[string]any{}
+//
+//	go table.Mirror(func(records []map[string]any) error {
+//		mu.Lock()
+//		defer mu.Unlock()
+//		for _, record := range records {
+//			users[record["Id"].(float64)] = record
+//		}
+//		return nil
+//	}).
+//		WithContext(ctx).
+//		WithInterval(30 * time.Second).
+//		Execute()
+func (t *Table) Mirror(onChange func(records []map[string]any) error) *mirrorBuilder {
+	b := &mirrorBuilder{
+		table:          t,
+		onChange:       onChange,
+		interval:       defaultMirrorInterval,
+		updatedAtField: defaultMirrorUpdatedAtField,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+	b.fieldProvider = newFieldProvider(b)
+
+	return b
+}
+
+// WithInterval sets the time between incremental refreshes.
+//
+// If not set, it defaults to one minute.
+func (b *mirrorBuilder) WithInterval(interval time.Duration) *mirrorBuilder {
+	if interval > 0 {
+		b.interval = interval
+	}
+	return b
+}
+
+// WithUpdatedAtField sets the DateTime field used to detect updated records.
+//
+// If not set, it defaults to the "UpdatedAt" system field.
+func (b *mirrorBuilder) WithUpdatedAtField(field string) *mirrorBuilder {
+	if field != "" {
+		b.updatedAtField = field
+	}
+	return b
+}
+
+// OnError sets a callback for errors that happen during the incremental refreshes.
+//
+// When set, refresh errors are reported to the callback and the mirror keeps running, otherwise
+// Execute returns the first error. Errors during the initial fetch are always returned.
+func (b *mirrorBuilder) OnError(onError func(err error)) *mirrorBuilder {
+	b.onError = onError
+	return b
+}
+
+// mirrorState tracks the most recent update seen by a mirror
+type mirrorState struct {
+	// lastUpdatedAt is the most recent value of the updated at field seen
+	lastUpdatedAt string
+	// boundary holds the fingerprints of the records updated at lastUpdatedAt, by ID, to avoid
+	// emitting them again since refreshes include the records updated at that same instant
+	boundary map[string]string
+}
+
+// Execute finalizes and executes the operation.
+//
+// It blocks until the context is done, returning the context error, or until an error occurs.
+func (b *mirrorBuilder) Execute() error {
+	if b.filterProvider.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.filterProvider.chainErr)
+	}
+
+	ctx := b.contextProvider.ctx
+	state := &mirrorState{boundary: map[string]string{}}
+
+	records, err := b.fetch(state)
+	if err != nil {
+		return fmt.Errorf("failed to fetch initial records: %w", err)
+	}
+
+	if err := b.onChange(records); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.table.client.clock.After(b.interval):
+		}
+
+		records, err := b.fetch(state)
+		if err != nil {
+			err = fmt.Errorf("failed to refresh records: %w", err)
+			if b.onError == nil {
+				return err
+			}
+			b.onError(err)
+			continue
+		}
+
+		if len(records) == 0 {
+			continue
+		}
+
+		if err := b.onChange(records); err != nil {
+			return err
+		}
+	}
+}
+
+// fetch fetches the records updated since the last fetch and updates the state.
+func (b *mirrorBuilder) fetch(state *mirrorState) ([]map[string]any, error) {
+	query := b.table.WithDefaults(QueryDefaults{}).ListRecords().WithContext(b.contextProvider.ctx).SortAscBy(b.updatedAtField)
+
+	for _, filter := range b.filterProvider.rawFilters {
+		query = query.Where(filter)
+	}
+
+	if state.lastUpdatedAt != "" {
+		query = query.Where(fmt.Sprintf("(%s,ge,exactDate,%s)", b.updatedAtField, state.lastUpdatedAt))
+	}
+
+	if len(b.fieldProvider.rawFields) > 0 {
+		query = query.ReturnFields(append([]string{"Id", b.updatedAtField}, b.fieldProvider.rawFields...)...)
+	}
+
+	response, err := query.ExecuteAll()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []map[string]any{}
+	for _, record := range response.List {
+		updatedAt, _ := record[b.updatedAtField].(string)
+		id := fmt.Sprint(record["Id"])
+		fingerprint, _ := json.Marshal(record)
+
+		if updatedAt == state.lastUpdatedAt && state.boundary[id] == string(fingerprint) {
+			continue
+		}
+
+		if updatedAt > state.lastUpdatedAt {
+			state.lastUpdatedAt = updatedAt
+			state.boundary = map[string]string{}
+		}
+		if updatedAt == state.lastUpdatedAt {
+			state.boundary[id] = string(fingerprint)
+		}
+
+		changed = append(changed, record)
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,113 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestMirror(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("users", map[string]any{"Name": "John"}, map[string]any{"Name": "Jane"})
+	table := client.Table("users")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []map[string]any, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- table.Mirror(func(records []map[string]any) error {
+			changes <- records
+			return nil
+		}).
+			WithContext(ctx).
+			WithInterval(5 * time.Millisecond).
+			Execute()
+	}()
+
+	receive := func() []map[string]any {
+		select {
+		case records := <-changes:
+			return records
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for mirror changes")
+			return nil
+		}
+	}
+
+	if initial := receive(); len(initial) != 2 {
+		t.Fatalf("initial records = %v, want 2 records", initial)
+	}
+
+	if err := table.UpdateRecord(map[string]any{"Id": 2, "Name": "Jane Doe"}).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+
+	changed := receive()
+	if len(changed) != 1 || changed[0]["Name"] != "Jane Doe" {
+		t.Errorf("changed records = %v, want only Jane Doe", changed)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Execute() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestMirrorWithClock(t *testing.T) {
+	clock := nocodbgotest.NewClock(time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC))
+	fake := nocodbgotest.New()
+	fake.SetClock(clock)
+	fake.Seed("users", map[string]any{"Name": "John"})
+
+	client, err := NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		WithClock(clock).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []map[string]any, 10)
+	go func() {
+		_ = table.Mirror(func(records []map[string]any) error {
+			changes <- records
+			return nil
+		}).
+			WithContext(ctx).
+			WithInterval(time.Hour).
+			Execute()
+	}()
+
+	if initial := <-changes; len(initial) != 1 {
+		t.Fatalf("initial records = %v, want 1 record", initial)
+	}
+
+	clock.WaitForTimers(1)
+	clock.Advance(time.Minute)
+	if err := table.UpdateRecord(map[string]any{"Id": 1, "Name": "John Doe"}).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+
+	select {
+	case records := <-changes:
+		t.Fatalf("changes before the interval = %v, want none", records)
+	default:
+	}
+
+	clock.Advance(time.Hour)
+	changed := <-changes
+	if len(changed) != 1 || changed[0]["Name"] != "John Doe" {
+		t.Errorf("changed records = %v, want only John Doe", changed)
+	}
+}
@@ -0,0 +1,68 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// nestedFieldsParam returns the query parameter that selects the fields of the records of a link
+// column returned inline.
+func nestedFieldsParam(linkField string) string {
+	return fmt.Sprintf("nested[%s][fields]", linkField)
+}
+
+// nestedLimitParam returns the query parameter that limits the number of records of a link column
+// returned inline.
+func nestedLimitParam(linkField string) string {
+	return fmt.Sprintf("nested[%s][limit]", linkField)
+}
+
+// WithNestedFields selects the fields of the linked records that are returned inline in the link
+// column, instead of only their display value, reducing the payload of wide relational tables.
+//
+// Empty link fields or field lists are ignored.
+//
+// Example:
+//
+//	// Return the Name and Email of the linked contacts of each company
+//	result, err := companies.ListRecords().
+//		WithNestedFields("Contacts", "Name", "Email").
+//		WithNestedLimit("Contacts", 5).
+//		Execute()
+//
+// Documentation:
+//   - https://docs.nocodb.com/developer-resources/rest-apis/overview/#query-params
+func (b *listRecordsBuilder) WithNestedFields(linkField string, fields ...string) *listRecordsBuilder {
+	if linkField == "" || len(fields) == 0 {
+		return b
+	}
+	return b.WithQueryParam(nestedFieldsParam(linkField), strings.Join(fields, ","))
+}
+
+// WithNestedLimit sets the maximum number of linked records returned inline in the link column
+// for each record. Empty link fields and limits lower than 1 are ignored.
+func (b *listRecordsBuilder) WithNestedLimit(linkField string, limit int) *listRecordsBuilder {
+	if linkField == "" || limit < 1 {
+		return b
+	}
+	return b.WithQueryParam(nestedLimitParam(linkField), strconv.Itoa(limit))
+}
+
+// WithNestedFields selects the fields of the records linked to the listed records that are
+// returned inline in their link columns. Empty link fields or field lists are ignored.
+func (b *listLinksBuilder) WithNestedFields(linkField string, fields ...string) *listLinksBuilder {
+	if linkField == "" || len(fields) == 0 {
+		return b
+	}
+	return b.WithQueryParam(nestedFieldsParam(linkField), strings.Join(fields, ","))
+}
+
+// WithNestedLimit sets the maximum number of records returned inline in the link columns of the
+// listed records. Empty link fields and limits lower than 1 are ignored.
+func (b *listLinksBuilder) WithNestedLimit(linkField string, limit int) *listLinksBuilder {
+	if linkField == "" || limit < 1 {
+		return b
+	}
+	return b.WithQueryParam(nestedLimitParam(linkField), strconv.Itoa(limit))
+}
@@ -0,0 +1,190 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+)
+
+// aggregatePageSize is the number of records fetched per request by the aggregates
+const aggregatePageSize = 1000
+
+// aggregateFunc is the function computed by an aggregate
+type aggregateFunc string
+
+const (
+	aggregateSum aggregateFunc = "sum"
+	aggregateAvg aggregateFunc = "avg"
+	aggregateMin aggregateFunc = "min"
+	aggregateMax aggregateFunc = "max"
+)
+
+// aggregateRecordsBuilder is used to build an aggregate of a column with a fluent API
+type aggregateRecordsBuilder struct {
+	table    *Table
+	column   string
+	function aggregateFunc
+
+	contextProvider[*aggregateRecordsBuilder]
+	filterProvider[*aggregateRecordsBuilder]
+	viewIDProvider[*aggregateRecordsBuilder]
+	queryParamProvider[*aggregateRecordsBuilder]
+	headerProvider[*aggregateRecordsBuilder]
+}
+
+// newAggregateRecordsBuilder returns the builder of an aggregate of the column.
+func (t *Table) newAggregateRecordsBuilder(column string, function aggregateFunc) *aggregateRecordsBuilder {
+	b := &aggregateRecordsBuilder{
+		table:    t,
+		column:   column,
+		function: function,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+	b.viewIDProvider = newViewIDProvider(b)
+	b.queryParamProvider = newQueryParamProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	b.filterProvider.rawFilters = append(b.filterProvider.rawFilters, t.defaults.Filters...)
+	b.viewIDProvider.rawViewID = t.defaults.ViewID
+
+	return b
+}
+
+// Sum returns the sum of the values of a numeric column in the records matching the filters,
+// zero if there are none, for quick KPIs such as the revenue of a month.
+//
+// The aggregate is computed by paging through the matching records, fetching only the column,
+// so it doesn't load whole records into memory. Null values are skipped.
+//
+// Example:
+//
+//	revenue, err := orders.Sum("Total").
+//		WhereIsEqualTo("Status", "paid").
+//		WhereIsWithin("PaidAt", "pastMonth").
+//		Execute()
+func (t *Table) Sum(column string) *aggregateRecordsBuilder {
+	return t.newAggregateRecordsBuilder(column, aggregateSum)
+}
+
+// Avg returns the average of the values of a numeric column in the records matching the
+// filters, skipping null values. It returns ErrRecordNotFound if no record has a value.
+//
+// The aggregate is computed like Sum.
+//
+// Example:
+//
+//	averageAge, err := users.Avg("Age").WhereIsEqualTo("Status", "active").Execute()
+func (t *Table) Avg(column string) *aggregateRecordsBuilder {
+	return t.newAggregateRecordsBuilder(column, aggregateAvg)
+}
+
+// Min returns the smallest value of a numeric column in the records matching the filters,
+// skipping null values. It returns ErrRecordNotFound if no record has a value.
+//
+// The aggregate is computed like Sum.
+//
+// Example:
+//
+//	cheapest, err := products.Min("Price").WhereIsTrue("InStock").Execute()
+func (t *Table) Min(column string) *aggregateRecordsBuilder {
+	return t.newAggregateRecordsBuilder(column, aggregateMin)
+}
+
+// Max returns the largest value of a numeric column in the records matching the filters,
+// skipping null values. It returns ErrRecordNotFound if no record has a value.
+//
+// The aggregate is computed like Sum.
+//
+// Example:
+//
+//	biggestOrder, err := orders.Max("Total").WhereIsEqualTo("Customer", customerID).Execute()
+func (t *Table) Max(column string) *aggregateRecordsBuilder {
+	return t.newAggregateRecordsBuilder(column, aggregateMax)
+}
+
+// Execute finalizes and executes the operation.
+func (b *aggregateRecordsBuilder) Execute() (float64, error) {
+	if b.filterProvider.chainErr != nil {
+		return 0, fmt.Errorf("error in the chain of methods: %w", b.filterProvider.chainErr)
+	}
+	if b.column == "" {
+		return 0, fmt.Errorf("%w: the column to aggregate is required", ErrInvalidQuery)
+	}
+
+	// The filters already include the defaults of the table
+	list := b.table.WithDefaults(QueryDefaults{}).ListRecords().
+		WithContext(b.contextProvider.ctx).
+		ReturnFields(b.column).
+		Limit(aggregatePageSize)
+	list.filterProvider.rawFilters = append(list.filterProvider.rawFilters, b.filterProvider.rawFilters...)
+	list.viewIDProvider.rawViewID = b.viewIDProvider.rawViewID
+	list.queryParamProvider.rawParams = maps.Clone(b.queryParamProvider.rawParams)
+	list.headerProvider.rawHeader = b.headerProvider.rawHeader.Clone()
+
+	var result float64
+	count := 0
+	it := list.Iterate()
+	for it.Next() {
+		value, ok, err := toFloat(it.Record()[b.column])
+		if err != nil {
+			return 0, fmt.Errorf("failed to aggregate column %q: %w", b.column, err)
+		}
+		if !ok {
+			continue
+		}
+
+		switch {
+		case count == 0:
+			result = value
+		case b.function == aggregateSum, b.function == aggregateAvg:
+			result += value
+		case b.function == aggregateMin:
+			result = min(result, value)
+		case b.function == aggregateMax:
+			result = max(result, value)
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		return 0, withOperation(fmt.Errorf("failed to aggregate records: %w", err), "Aggregate", b.table.tableID, RecordID{})
+	}
+
+	if count == 0 {
+		if b.function == aggregateSum {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("%w: no record has a value in column %q to compute the %s", ErrRecordNotFound, b.column, b.function)
+	}
+	if b.function == aggregateAvg {
+		result /= float64(count)
+	}
+
+	return result, nil
+}
+
+// toFloat converts a numeric value returned by NocoDB to a float64, reporting false for null
+// values. Decimal and currency values are returned as strings by some database backends.
+func toFloat(value any) (float64, bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false, nil
+	case float64:
+		return v, true, nil
+	case json.Number:
+		number, err := v.Float64()
+		return number, err == nil, err
+	case string:
+		if v == "" {
+			return 0, false, nil
+		}
+		number, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("%w: value %q is not a number", ErrUnsupportedColumnType, v)
+		}
+		return number, true, nil
+	}
+	return 0, false, fmt.Errorf("%w: value of type %T is not a number", ErrUnsupportedColumnType, value)
+}
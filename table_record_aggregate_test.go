@@ -0,0 +1,48 @@
+package nocodbgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAggregates(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("orders",
+		map[string]any{"Status": "paid", "Total": 10.5},
+		map[string]any{"Status": "paid", "Total": "30"},
+		map[string]any{"Status": "paid", "Total": nil},
+		map[string]any{"Status": "open", "Total": 100},
+		map[string]any{"Status": "paid", "Total": 2},
+	)
+	orders := client.Table("orders")
+
+	for _, tt := range []struct {
+		name  string
+		query *aggregateRecordsBuilder
+		want  float64
+	}{
+		{name: "sum", query: orders.Sum("Total").WhereIsEqualTo("Status", "paid"), want: 42.5},
+		{name: "avg", query: orders.Avg("Total").WhereIsEqualTo("Status", "paid"), want: 42.5 / 3},
+		{name: "min", query: orders.Min("Total"), want: 2},
+		{name: "max", query: orders.Max("Total"), want: 100},
+		{name: "table defaults", query: orders.WithDefaults(QueryDefaults{Filters: []string{"(Status,eq,open)"}}).Sum("Total"), want: 100},
+		{name: "sum of no records", query: orders.Sum("Total").WhereIsEqualTo("Status", "void"), want: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Execute()
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Execute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := orders.Max("Total").WhereIsEqualTo("Status", "void").Execute(); !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrRecordNotFound)
+	}
+	if _, err := orders.Sum("Status").Execute(); !errors.Is(err, ErrUnsupportedColumnType) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrUnsupportedColumnType)
+	}
+}
@@ -0,0 +1,281 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// mentionPattern matches the email addresses mentioned in a comment, like "@ana@example.com"
+var mentionPattern = regexp.MustCompile(`(^|\s)@([^\s@]+@[^\s@]+\.[^\s@,;:!?()]*[^\s@,;:!?().])`)
+
+// Comment is a comment on a record
+type Comment struct {
+	// ID is the unique identifier of the comment
+	ID string `json:"id"`
+	// RowID is the identifier of the commented record
+	RowID RecordID `json:"row_id"`
+	// Comment is the text of the comment, with the mentions as "@(id|email|display name)"
+	Comment string `json:"comment"`
+	// CreatedBy is the identifier of the user who wrote the comment
+	CreatedBy string `json:"created_by"`
+	// CreatedByEmail is the email of the user who wrote the comment
+	CreatedByEmail string `json:"created_by_email"`
+	// CreatedAt is when the comment was written
+	CreatedAt Timestamp `json:"created_at"`
+}
+
+// listCommentsBuilder is used to build a query that lists the comments of a record with a fluent API
+type listCommentsBuilder struct {
+	table    *Table
+	recordID RecordID
+	chainErr error // Stores any error in the chain of methods
+
+	contextProvider[*listCommentsBuilder]
+	headerProvider[*listCommentsBuilder]
+}
+
+// ListComments lists the comments of a record, oldest first.
+//
+// Parameters:
+//   - recordID: The identifier of the record, can be a RecordID, any integer type or a string.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Comments/operation/comment-list
+func (t *Table) ListComments(recordID any) *listCommentsBuilder {
+	id, err := parseRecordID(recordID)
+
+	b := &listCommentsBuilder{
+		table:    t,
+		recordID: id,
+		chainErr: err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listCommentsBuilder) Execute() ([]Comment, error) {
+	if b.chainErr != nil {
+		return nil, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
+		return nil, ErrRowIDRequired
+	}
+
+	query := url.Values{"row_id": {b.recordID.String()}, "fk_model_id": {b.table.tableID}}
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, "/api/v2/meta/comments", nil, query, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list comments: %w", err), "ListComments", b.table.tableID, b.recordID)
+	}
+
+	var response struct {
+		List []Comment `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal comments response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// countCommentsBuilder is used to build a query that counts the comments of records with a fluent API
+type countCommentsBuilder struct {
+	table     *Table
+	recordIDs []RecordID
+	chainErr  error // Stores any error in the chain of methods
+
+	contextProvider[*countCommentsBuilder]
+	headerProvider[*countCommentsBuilder]
+}
+
+// CountComments counts the comments of several records with a single request, for example to show
+// the number of comments next to each record of a list.
+//
+// The counts are returned by the string form of the record IDs (see RecordID.String), including
+// the records without comments with a count of 0.
+//
+// Parameters:
+//   - recordIDs: A slice of record IDs, of RecordIDs, integers or strings, or a slice of
+//     map[string]any or structs containing an "Id" field (e.g. previously fetched records).
+//
+// Example:
+//
+//	result, err := table.ListRecords().Execute()
+//	// Handle error
+//	counts, err := table.CountComments(result.List).Execute()
+//	// counts["1"] is the number of comments of the record 1
+func (t *Table) CountComments(recordIDs any) *countCommentsBuilder {
+	ids, err := parseRecordIDs(recordIDs)
+
+	b := &countCommentsBuilder{
+		table:     t,
+		recordIDs: ids,
+		chainErr:  err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *countCommentsBuilder) Execute() (map[string]int, error) {
+	if b.chainErr != nil {
+		return nil, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	counts := make(map[string]int, len(b.recordIDs))
+	if len(b.recordIDs) == 0 {
+		return counts, nil
+	}
+
+	query := url.Values{"fk_model_id": {b.table.tableID}}
+	for _, id := range b.recordIDs {
+		counts[id.String()] = 0
+		query.Add("ids", id.String())
+	}
+
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, "/api/v2/meta/comments/count", nil, query, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to count comments: %w", err), "CountComments", b.table.tableID, RecordID{})
+	}
+
+	// The counts are returned as numbers or strings depending on the database
+	var response []struct {
+		RowID RecordID    `json:"row_id"`
+		Count json.Number `json:"count"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal comment counts response: %w", err)
+	}
+	for _, count := range response {
+		n, err := count.Count.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal comment count of record %s: %w", count.RowID, err)
+		}
+		counts[count.RowID.String()] = int(n)
+	}
+
+	return counts, nil
+}
+
+// createCommentBuilder is used to build the creation of a comment with a fluent API
+type createCommentBuilder struct {
+	table    *Table
+	recordID RecordID
+	text     string
+	chainErr error // Stores any error in the chain of methods
+
+	contextProvider[*createCommentBuilder]
+	headerProvider[*createCommentBuilder]
+}
+
+// CreateComment writes a comment on a record as the user of the API token, so bots can take part
+// in the discussions of the records.
+//
+// Users are mentioned by writing their email after an @, like "@ana@example.com". The mentions are
+// resolved from the users of the base and sent in the format NocoDB uses, so the mentioned users
+// are notified. Mentioning an email that is not a user of the base fails with ErrUserNotFound.
+//
+// Parameters:
+//   - recordID: The identifier of the record, can be a RecordID, any integer type or a string.
+//   - text:     The text of the comment.
+//
+// Example:
+//
+//	comment, err := table.CreateComment(recordID, "The invoice is overdue, @ana@example.com can you check it?").Execute()
+func (t *Table) CreateComment(recordID any, text string) *createCommentBuilder {
+	id, err := parseRecordID(recordID)
+
+	b := &createCommentBuilder{
+		table:    t,
+		recordID: id,
+		text:     text,
+		chainErr: err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// resolveMentions replaces the emails mentioned in the text with the mention format of NocoDB,
+// reading the users of the base only if the text mentions someone.
+func (b *createCommentBuilder) resolveMentions() (string, error) {
+	if !mentionPattern.MatchString(b.text) {
+		return b.text, nil
+	}
+
+	baseID, err := b.table.lookupBaseID(b.contextProvider.ctx)
+	if err != nil {
+		return "", err
+	}
+	users, err := b.table.client.Base(baseID).ListUsers().WithContext(b.contextProvider.ctx).withHeaders(b.headerProvider.rawHeader).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve mentions: %w", err)
+	}
+
+	byEmail := make(map[string]BaseUser, len(users))
+	for _, user := range users {
+		byEmail[strings.ToLower(user.Email)] = user
+	}
+
+	var missing []string
+	text := mentionPattern.ReplaceAllStringFunc(b.text, func(match string) string {
+		groups := mentionPattern.FindStringSubmatch(match)
+		user, ok := byEmail[strings.ToLower(groups[2])]
+		if !ok {
+			missing = append(missing, groups[2])
+			return match
+		}
+		return fmt.Sprintf("%s@(%s|%s|%s)", groups[1], user.ID, user.Email, user.DisplayName)
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: mentioned %s", ErrUserNotFound, strings.Join(missing, ", "))
+	}
+
+	return text, nil
+}
+
+// Execute finalizes and executes the operation.
+func (b *createCommentBuilder) Execute() (Comment, error) {
+	if b.chainErr != nil {
+		return Comment{}, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
+		return Comment{}, ErrRowIDRequired
+	}
+
+	text, err := b.resolveMentions()
+	if err != nil {
+		return Comment{}, err
+	}
+
+	body := map[string]any{
+		"row_id":      b.recordID.String(),
+		"fk_model_id": b.table.tableID,
+		"comment":     text,
+	}
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodPost, "/api/v2/meta/comments", body, nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return Comment{}, withOperation(fmt.Errorf("failed to create comment: %w", err), "CreateComment", b.table.tableID, b.recordID)
+	}
+
+	var comment Comment
+	if err := json.Unmarshal(respBody, &comment); err != nil {
+		return Comment{}, fmt.Errorf("failed to unmarshal comment response: %w", err)
+	}
+
+	return comment, nil
+}
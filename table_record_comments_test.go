@@ -0,0 +1,72 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestComments(t *testing.T) {
+	var created map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/bases/p_base/users", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"users":{"list":[
+			{"id":"us_ana","email":"ana@example.com","display_name":"Ana","roles":"editor"},
+			{"id":"us_bob","email":"Bob@Example.com","display_name":"Bob","roles":"viewer"}
+		]}}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/comments", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&created)
+		_, _ = w.Write([]byte(`{"id":"cm_1","row_id":"7","comment":"done","created_by_email":"bot@example.com"}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/comments", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("row_id") != "7" || r.URL.Query().Get("fk_model_id") != "users" {
+			t.Errorf("query = %v", r.URL.Query())
+		}
+		_, _ = w.Write([]byte(`{"list":[{"id":"cm_1","row_id":7,"comment":"first","created_at":"2024-01-02 03:04:05+00:00"}]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/comments/count", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["ids"]; !reflect.DeepEqual(got, []string{"7", "8", "9"}) {
+			t.Errorf("ids = %v", got)
+		}
+		_, _ = w.Write([]byte(`[{"row_id":"7","count":"2"},{"row_id":8,"count":1}]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	users := client.Base("p_base").Table("users")
+
+	comment, err := users.CreateComment(7, "Hi @ana@example.com and @bob@example.com, email me at bot@example.com.").Execute()
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	want := map[string]any{
+		"row_id":      "7",
+		"fk_model_id": "users",
+		"comment":     "Hi @(us_ana|ana@example.com|Ana) and @(us_bob|Bob@Example.com|Bob), email me at bot@example.com.",
+	}
+	if !reflect.DeepEqual(created, want) || comment.ID != "cm_1" {
+		t.Errorf("CreateComment() sent %v, returned %+v", created, comment)
+	}
+
+	if _, err := users.CreateComment(7, "@cid@example.com please check").Execute(); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("CreateComment() error = %v, want %v", err, ErrUserNotFound)
+	}
+
+	comments, err := users.ListComments(7).Execute()
+	if err != nil || len(comments) != 1 || comments[0].Comment != "first" || comments[0].RowID != IntID(7) {
+		t.Errorf("ListComments() = %+v, %v", comments, err)
+	}
+
+	counts, err := users.CountComments([]int{7, 8, 9}).Execute()
+	if err != nil || !reflect.DeepEqual(counts, map[string]int{"7": 2, "8": 1, "9": 0}) {
+		t.Errorf("CountComments() = %v, %v", counts, err)
+	}
+}
@@ -0,0 +1,182 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"slices"
+)
+
+// copyRecordsBuilder is used to build a copy or a move of records between tables with a fluent API
+type copyRecordsBuilder struct {
+	table            *Table
+	target           *Table
+	columnMapping    map[string]string
+	chunkSize        int
+	deleteFromSource bool
+	onProgress       func(copied int)
+
+	contextProvider[*copyRecordsBuilder]
+	filterProvider[*copyRecordsBuilder]
+}
+
+// CopyRecordsTo initializes a builder that copies the records of this table into the target
+// table, for example to restructure a base.
+//
+// Only the columns of the column mapping are copied. Records are read and created in chunks, so
+// large tables are never fully loaded in memory. Use the Where methods to copy only some records.
+//
+// Parameters:
+//   - target: The table to copy the records into.
+//
+// Example:
+//
+//	ids, err := legacyCustomers.CopyRecordsTo(customers).
+//		WithColumnMapping(map[string]string{"full_name": "Name", "mail": "Email"}).
+//		WhereIsEqualTo("country", "ES").
+//		Execute()
+func (t *Table) CopyRecordsTo(target *Table) *copyRecordsBuilder {
+	b := &copyRecordsBuilder{
+		table:     t,
+		target:    target,
+		chunkSize: defaultImportChunkSize,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+
+	return b
+}
+
+// MoveRecordsTo initializes a builder that moves the records of this table into the target table.
+//
+// It works like CopyRecordsTo, but each chunk of records is deleted from this table once it has
+// been created in the target table.
+//
+// Parameters:
+//   - target: The table to move the records into.
+func (t *Table) MoveRecordsTo(target *Table) *copyRecordsBuilder {
+	return t.CopyRecordsTo(target).DeleteFromSource()
+}
+
+// WithColumnMapping sets the mapping from the columns of this table to the columns of the target
+// table. It is required, and columns mapped to an empty string are ignored.
+func (b *copyRecordsBuilder) WithColumnMapping(mapping map[string]string) *copyRecordsBuilder {
+	b.columnMapping = mapping
+	return b
+}
+
+// WithChunkSize sets the number of records read and created per request.
+//
+// If not set, it defaults to 100.
+func (b *copyRecordsBuilder) WithChunkSize(chunkSize int) *copyRecordsBuilder {
+	if chunkSize > 0 {
+		b.chunkSize = chunkSize
+	}
+	return b
+}
+
+// DeleteFromSource deletes the records from this table once they have been copied, moving them.
+func (b *copyRecordsBuilder) DeleteFromSource() *copyRecordsBuilder {
+	b.deleteFromSource = true
+	return b
+}
+
+// WithProgress sets a callback that is called after each chunk is copied with the total number
+// of records copied so far.
+func (b *copyRecordsBuilder) WithProgress(onProgress func(copied int)) *copyRecordsBuilder {
+	b.onProgress = onProgress
+	return b
+}
+
+// Execute finalizes and executes the operation.
+//
+// It returns the IDs of the records created in the target table. The operation is not atomic,
+// if an error occurs the chunks copied (and deleted when moving) before it are kept and their IDs
+// are returned along with the error.
+func (b *copyRecordsBuilder) Execute() ([]RecordID, error) {
+	if b.filterProvider.chainErr != nil {
+		return nil, fmt.Errorf("error in the chain of methods: %w", b.filterProvider.chainErr)
+	}
+
+	if b.target == nil || b.target.tableID == "" {
+		return nil, fmt.Errorf("failed to copy records: target table is required")
+	}
+
+	sourceColumns := make([]string, 0, len(b.columnMapping))
+	for source, target := range b.columnMapping {
+		if target != "" {
+			sourceColumns = append(sourceColumns, source)
+		}
+	}
+	if len(sourceColumns) == 0 {
+		return nil, fmt.Errorf("failed to copy records: %w: column mapping is required", ErrColumnNotFound)
+	}
+	slices.Sort(sourceColumns)
+
+	ctx := b.contextProvider.ctx
+	var ids []RecordID
+
+	for offset := 0; ; {
+		// Stop between chunks as soon as the context is canceled
+		if err := ctx.Err(); err != nil {
+			return ids, err
+		}
+
+		query := b.table.WithDefaults(QueryDefaults{}).ListRecords().
+			WithContext(ctx).
+			ReturnFields(append([]string{SystemFieldID}, sourceColumns...)...).
+			SortAscBy(SystemFieldID).
+			Limit(b.chunkSize).
+			Offset(offset)
+		for _, filter := range b.filterProvider.rawFilters {
+			query = query.Where(filter)
+		}
+
+		page, err := query.Execute()
+		if err != nil {
+			return ids, fmt.Errorf("failed to read records to copy: %w", err)
+		}
+		if len(page.List) == 0 {
+			return ids, nil
+		}
+
+		chunk := make([]map[string]any, len(page.List))
+		sourceIDs := make([]RecordID, len(page.List))
+		for i, record := range page.List {
+			chunk[i] = map[string]any{}
+			for _, source := range sourceColumns {
+				if value, ok := record[source]; ok {
+					chunk[i][b.columnMapping[source]] = value
+				}
+			}
+
+			id, err := parseRecordID(record[SystemFieldID])
+			if (err != nil || id.IsZero()) && b.deleteFromSource {
+				return ids, fmt.Errorf("failed to move records: %w: %v", ErrInvalidRecordID, record[SystemFieldID])
+			}
+			sourceIDs[i] = id
+		}
+
+		created, err := b.target.CreateRecords(chunk).WithContext(ctx).Execute()
+		if err != nil {
+			return ids, fmt.Errorf("failed to copy records %d to %d: %w", len(ids)+1, len(ids)+len(chunk), err)
+		}
+		ids = append(ids, created...)
+
+		if b.deleteFromSource {
+			// The next chunk starts again from the first record since this one is deleted
+			if err := b.table.DeleteRecords(sourceIDs).WithContext(ctx).Execute(); err != nil {
+				return ids, fmt.Errorf("failed to delete moved records: %w", err)
+			}
+		} else {
+			offset += len(page.List)
+		}
+
+		if b.onProgress != nil {
+			b.onProgress(len(ids))
+		}
+
+		if page.PageInfo.IsLastPage || len(page.List) < b.chunkSize {
+			return ids, nil
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package nocodbgo
+
+import (
+	"testing"
+)
+
+func TestCopyRecordsTo(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("legacy",
+		map[string]any{"full_name": "Ana", "mail": "ana@example.com", "country": "ES"},
+		map[string]any{"full_name": "Bob", "mail": "bob@example.com", "country": "UK"},
+		map[string]any{"full_name": "Cid", "mail": "cid@example.com", "country": "ES"},
+		map[string]any{"full_name": "Dan", "mail": "dan@example.com", "country": "ES"},
+	)
+	legacy := client.Table("legacy")
+	customers := client.Table("customers")
+	mapping := map[string]string{"full_name": "Name", "mail": "Email", "country": ""}
+
+	var progress []int
+	ids, err := legacy.CopyRecordsTo(customers).
+		WithColumnMapping(mapping).
+		WithChunkSize(2).
+		WhereIsEqualTo("country", "ES").
+		WithProgress(func(copied int) { progress = append(progress, copied) }).
+		Execute()
+	if err != nil {
+		t.Fatalf("CopyRecordsTo() error = %v", err)
+	}
+	if len(ids) != 3 || len(progress) != 2 || progress[1] != 3 {
+		t.Errorf("CopyRecordsTo() ids = %v, progress = %v", ids, progress)
+	}
+
+	copied := fake.Records("customers")
+	if len(copied) != 3 || copied[1]["Name"] != "Cid" || copied[1]["Email"] != "cid@example.com" {
+		t.Errorf("customers = %v", copied)
+	}
+	if _, ok := copied[0]["country"]; ok {
+		t.Errorf("customers[0] has unmapped column: %v", copied[0])
+	}
+	if got := len(fake.Records("legacy")); got != 4 {
+		t.Errorf("legacy records = %d, want 4", got)
+	}
+
+	ids, err = legacy.MoveRecordsTo(client.Table("archive")).
+		WithColumnMapping(mapping).
+		WithChunkSize(3).
+		Execute()
+	if err != nil {
+		t.Fatalf("MoveRecordsTo() error = %v", err)
+	}
+	if len(ids) != 4 || len(fake.Records("archive")) != 4 || len(fake.Records("legacy")) != 0 {
+		t.Errorf("MoveRecordsTo() ids = %v, archive = %v, legacy = %v", ids, fake.Records("archive"), fake.Records("legacy"))
+	}
+
+	if _, err := legacy.CopyRecordsTo(customers).Execute(); err == nil {
+		t.Error("CopyRecordsTo() without column mapping error = nil, want error")
+	}
+}
@@ -14,6 +14,8 @@ type countRecordsBuilder struct {
 	contextProvider[*countRecordsBuilder]
 	filterProvider[*countRecordsBuilder]
 	viewIDProvider[*countRecordsBuilder]
+	queryParamProvider[*countRecordsBuilder]
+	headerProvider[*countRecordsBuilder]
 }
 
 // CountRecords counts the number of records in the table.
@@ -25,20 +27,80 @@ func (t *Table) CountRecords() *countRecordsBuilder {
 	b.contextProvider = newContextProvider(b)
 	b.filterProvider = newFilterProvider(b)
 	b.viewIDProvider = newViewIDProvider(b)
+	b.queryParamProvider = newQueryParamProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	b.filterProvider.rawFilters = append(b.filterProvider.rawFilters, t.defaults.Filters...)
+	b.viewIDProvider.rawViewID = t.defaults.ViewID
 
 	return b
 }
 
-// Execute finalizes and executes the operation.
-func (b *countRecordsBuilder) Execute() (int, error) {
+// Clone returns a copy of the builder that can be modified and executed independently of the
+// original, so a base query can be built once and reused concurrently with per-request variations.
+//
+// Example:
+//
+//	base := table.CountRecords().WhereIsEqualTo("Status", "active")
+//
+//	// In each request
+//	query := base.Clone().WhereIsEqualTo("Owner", userID)
+func (b *countRecordsBuilder) Clone() *countRecordsBuilder {
+	c := &countRecordsBuilder{
+		table: b.table,
+	}
+
+	c.contextProvider = b.contextProvider.clone(c)
+	c.filterProvider = b.filterProvider.clone(c)
+	c.viewIDProvider = b.viewIDProvider.clone(c)
+	c.queryParamProvider = b.queryParamProvider.clone(c)
+	c.headerProvider = b.headerProvider.clone(c)
+
+	return c
+}
+
+// Query renders the request the builder sends when executed, without executing it, to assert
+// in tests exactly what the builder sends or to debug its filters.
+//
+// Like Execute, the old titles of the column aliases of the table are replaced by the new ones,
+// which reads the table schema if the table has aliases.
+func (b *countRecordsBuilder) Query() (RenderedQuery, error) {
+	if b.filterProvider.chainErr != nil {
+		return RenderedQuery{}, fmt.Errorf("error in the chain of methods: %w", b.filterProvider.chainErr)
+	}
+
 	query := url.Values{}
 	query = b.filterProvider.apply(query)
 	query = b.viewIDProvider.apply(query)
+	query = b.queryParamProvider.apply(query)
 
-	path := fmt.Sprintf("/api/v2/tables/%s/records/count", b.table.tableID)
-	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, query)
+	path, err := b.table.countPath()
+	if err != nil {
+		return RenderedQuery{}, err
+	}
+	renames, err := b.table.columnRenames(b.contextProvider.ctx)
+	if err != nil {
+		return RenderedQuery{}, err
+	}
+
+	return RenderedQuery{Method: http.MethodGet, Path: path, Query: renames.query(query)}, nil
+}
+
+// String implements the fmt.Stringer interface for countRecordsBuilder, returning the rendered
+// request of the builder.
+func (b *countRecordsBuilder) String() string {
+	return renderedString(b.Query())
+}
+
+// Execute finalizes and executes the operation.
+func (b *countRecordsBuilder) Execute() (int, error) {
+	rendered, err := b.Query()
+	if err != nil {
+		return 0, err
+	}
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, rendered.Method, rendered.Path, nil, rendered.Query, b.headerProvider.rawHeader)
 	if err != nil {
-		return 0, fmt.Errorf("failed to count records: %w", err)
+		return 0, withOperation(fmt.Errorf("failed to count records: %w", err), "CountRecords", b.table.tableID, RecordID{})
 	}
 
 	var response struct {
@@ -1,8 +1,8 @@
 package nocodbgo
 
 import (
-	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 )
 
@@ -13,6 +13,7 @@ type createRecordBuilder struct {
 	chainErr error // Stores any error in the chain of methods
 
 	contextProvider[*createRecordBuilder]
+	headerProvider[*createRecordBuilder]
 }
 
 // CreateRecord creates a single record in the table.
@@ -37,31 +38,46 @@ func (t *Table) CreateRecord(data any) *createRecordBuilder {
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
 // Execute finalizes and executes the operation.
-func (b *createRecordBuilder) Execute() (int, error) {
+func (b *createRecordBuilder) Execute() (RecordID, error) {
 	if b.chainErr != nil {
-		return 0, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+		return RecordID{}, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
 	}
 
 	records, err := b.table.
 		CreateRecords([]map[string]any{b.data}).
 		WithContext(b.contextProvider.ctx).
+		withHeaders(b.headerProvider.rawHeader).
 		Execute()
 	if err != nil {
-		return 0, fmt.Errorf("failed to create record: %w", err)
+		return RecordID{}, withOperation(fmt.Errorf("failed to create record: %w", err), "CreateRecord", b.table.tableID, RecordID{})
 	}
 
 	if len(records) == 0 {
-		return 0, fmt.Errorf("no record created")
+		return RecordID{}, fmt.Errorf("no record created")
 	}
 
 	return records[0], nil
 }
 
+// ExecuteInt finalizes and executes the operation like Execute, returning the ID as an int like
+// the versions before RecordID. It returns an error wrapping ErrInvalidRecordID if the ID isn't
+// an integer or doesn't fit in an int.
+//
+// Deprecated: Use Execute, which returns a RecordID and also supports text primary keys.
+func (b *createRecordBuilder) ExecuteInt() (int, error) {
+	id, err := b.Execute()
+	if err != nil {
+		return 0, err
+	}
+	return intRecordID(id)
+}
+
 // createRecordsBuilder is used to build a bulk create query with a fluent API
 type createRecordsBuilder struct {
 	table    *Table
@@ -69,6 +85,7 @@ type createRecordsBuilder struct {
 	chainErr error // Stores any error in the chain of methods
 
 	contextProvider[*createRecordsBuilder]
+	headerProvider[*createRecordsBuilder]
 }
 
 // CreateRecords creates multiple records in the table.
@@ -93,33 +110,67 @@ func (t *Table) CreateRecords(data any) *createRecordsBuilder {
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
 // Execute finalizes and executes the operation.
-func (b *createRecordsBuilder) Execute() ([]int, error) {
+func (b *createRecordsBuilder) Execute() ([]RecordID, error) {
 	if b.chainErr != nil {
 		return nil, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
 	}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/records", b.table.tableID)
-	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodPost, path, b.data, nil)
+	path, err := b.table.recordsPath(true)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create records: %w", err)
+		return nil, err
+	}
+	renames, err := b.table.columnRenames(b.contextProvider.ctx)
+	if err != nil {
+		return nil, err
+	}
+	records := renames.records(b.table.stampCreated(b.table.prepareCreated(b.data)))
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodPost, path, b.table.encodeRecords(records), nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to create records: %w", err), "CreateRecords", b.table.tableID, RecordID{})
 	}
 
-	var response []map[string]any
-	if err := json.Unmarshal(respBody, &response); err != nil {
+	ids, err := b.table.decodeCreatedIDs(b.contextProvider.ctx, respBody)
+	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal create response: %w", err)
 	}
 
-	var ids []int
-	for _, record := range response {
-		if id, ok := record["Id"].(float64); ok {
-			ids = append(ids, int(id))
+	return ids, nil
+}
+
+// ExecuteInt finalizes and executes the operation like Execute, returning the IDs as ints like
+// the versions before RecordID. It returns an error wrapping ErrInvalidRecordID if an ID isn't
+// an integer or doesn't fit in an int.
+//
+// Deprecated: Use Execute, which returns RecordIDs and also supports text primary keys.
+func (b *createRecordsBuilder) ExecuteInt() ([]int, error) {
+	ids, err := b.Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := make([]int, len(ids))
+	for i, id := range ids {
+		if numbers[i], err = intRecordID(id); err != nil {
+			return nil, err
 		}
 	}
+	return numbers, nil
+}
 
-	return ids, nil
+// intRecordID returns a created record ID as an int, which is 32 bits wide on some platforms.
+func intRecordID(id RecordID) (int, error) {
+	number, ok := id.Int64()
+	if !ok {
+		return 0, fmt.Errorf("%w: %s is not an integer", ErrInvalidRecordID, id)
+	}
+	if number > math.MaxInt || number < math.MinInt {
+		return 0, fmt.Errorf("%w: %s overflows int", ErrInvalidRecordID, id)
+	}
+	return int(number), nil
 }
@@ -0,0 +1,114 @@
+package nocodbgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// createWithChildrenBuilder is used to build a parent-child create operation with a fluent API
+type createWithChildrenBuilder struct {
+	table       *Table
+	parent      *createRecordBuilder
+	linkFieldID string
+	childTable  *Table
+	children    *createRecordsBuilder
+
+	contextProvider[*createWithChildrenBuilder]
+	headerProvider[*createWithChildrenBuilder]
+}
+
+// CreatedWithChildren is the result of CreateWithChildren
+type CreatedWithChildren struct {
+	// ParentID is the ID of the parent record, created in the table
+	ParentID RecordID
+	// ChildIDs are the IDs of the child records, created in the child table in the same order
+	ChildIDs []RecordID
+}
+
+// CreateWithChildren creates a parent record in the table and its child records in the child
+// table, then links the children to the parent through a link field of the table, as one logical
+// operation.
+//
+// If a step fails, the records created by the previous steps are deleted before returning the
+// error. Errors of the rollback are joined to the returned error, so the caller knows when records
+// may have been left behind.
+//
+// Parameters:
+//   - parent: The data of the parent record, can be a map[string]any or a struct with JSON tags that match the table columns.
+//   - linkFieldID: The identifier of the link field of the table that links the parent to the children.
+//   - childTable: The table where the child records are created.
+//   - children: The data of the child records, can be a []map[string]any or a slice of structs with JSON tags that match the child table columns.
+//
+// Example:
+//
+//	created, err := orders.CreateWithChildren(
+//		map[string]any{"Customer": "Ana"},
+//		"linesLinkFieldId",
+//		orderLines,
+//		[]map[string]any{{"Product": "Pen", "Quantity": 2}, {"Product": "Ink", "Quantity": 1}},
+//	).Execute()
+func (t *Table) CreateWithChildren(parent any, linkFieldID string, childTable *Table, children any) *createWithChildrenBuilder {
+	b := &createWithChildrenBuilder{
+		table:       t,
+		parent:      t.CreateRecord(parent),
+		linkFieldID: linkFieldID,
+		childTable:  childTable,
+		children:    childTable.CreateRecords(children),
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createWithChildrenBuilder) Execute() (CreatedWithChildren, error) {
+	if err := errors.Join(b.parent.chainErr, b.children.chainErr); err != nil {
+		return CreatedWithChildren{}, fmt.Errorf("error in the chain of methods: %w", err)
+	}
+
+	if b.linkFieldID == "" {
+		return CreatedWithChildren{}, ErrLinkFieldIDRequired
+	}
+
+	ctx := b.contextProvider.ctx
+	header := b.headerProvider.rawHeader
+
+	parentID, err := b.parent.WithContext(ctx).withHeaders(header).Execute()
+	if err != nil {
+		return CreatedWithChildren{}, fmt.Errorf("failed to create parent record: %w", err)
+	}
+
+	childIDs, err := b.children.WithContext(ctx).withHeaders(header).Execute()
+	if err != nil {
+		err = fmt.Errorf("failed to create child records: %w", err)
+		return CreatedWithChildren{}, errors.Join(err, b.rollback(parentID, nil))
+	}
+
+	err = b.table.CreateLinks(b.linkFieldID, parentID, childIDs).WithContext(ctx).withHeaders(header).Execute()
+	if err != nil {
+		err = fmt.Errorf("failed to link child records: %w", err)
+		return CreatedWithChildren{}, errors.Join(err, b.rollback(parentID, childIDs))
+	}
+
+	return CreatedWithChildren{ParentID: parentID, ChildIDs: childIDs}, nil
+}
+
+// rollback deletes the records created before a failed step. It doesn't use the context of the
+// operation, since the failure may have been its cancellation.
+func (b *createWithChildrenBuilder) rollback(parentID RecordID, childIDs []RecordID) error {
+	ctx := context.WithoutCancel(b.contextProvider.ctx)
+	header := b.headerProvider.rawHeader
+
+	var errs []error
+	if err := b.childTable.DeleteRecords(childIDs).WithContext(ctx).withHeaders(header).Execute(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to roll back child records %v: %w", childIDs, err))
+	}
+	if err := b.table.DeleteRecord(parentID).WithContext(ctx).withHeaders(header).Execute(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to roll back parent record %v: %w", parentID, err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,48 @@
+package nocodbgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateWithChildren(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.DefineLink("orders", "lines", "order_lines")
+	orders := client.Table("orders")
+	lines := client.Table("order_lines")
+
+	created, err := orders.CreateWithChildren(
+		map[string]any{"Customer": "Ana"},
+		"lines",
+		lines,
+		[]map[string]any{{"Product": "Pen"}, {"Product": "Ink"}},
+	).Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if created.ParentID != IntID(1) || !reflect.DeepEqual(created.ChildIDs, []RecordID{IntID(1), IntID(2)}) {
+		t.Errorf("Execute() = %+v, want parent 1 and children [1 2]", created)
+	}
+	if linked := fake.LinkedIDs("orders", "lines", 1); !reflect.DeepEqual(linked, []int64{1, 2}) {
+		t.Errorf("LinkedIDs() = %v, want [1 2]", linked)
+	}
+
+	t.Run("rolls back when linking fails", func(t *testing.T) {
+		_, err := orders.CreateWithChildren(
+			map[string]any{"Customer": "Bob"},
+			"unknown",
+			lines,
+			[]map[string]any{{"Product": "Pad"}},
+		).Execute()
+		if err == nil {
+			t.Fatal("Execute() error = nil, want the link error")
+		}
+
+		if records := fake.Records("orders"); len(records) != 1 {
+			t.Errorf("orders after rollback = %v, want only the first order", records)
+		}
+		if records := fake.Records("order_lines"); len(records) != 2 {
+			t.Errorf("order lines after rollback = %v, want only the first lines", records)
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package nocodbgo
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCreateRecordExecuteInt(t *testing.T) {
+	client, _ := newFakeClient(t)
+	users := client.Table("users")
+
+	id, err := users.CreateRecord(map[string]any{"Name": "Ana"}).ExecuteInt()
+	if err != nil || id != 1 {
+		t.Errorf("CreateRecord().ExecuteInt() = %d, %v, want 1", id, err)
+	}
+
+	ids, err := users.CreateRecords([]map[string]any{{"Name": "Bob"}, {"Name": "Cid"}}).ExecuteInt()
+	if err != nil || !reflect.DeepEqual(ids, []int{2, 3}) {
+		t.Errorf("CreateRecords().ExecuteInt() = %v, %v, want [2 3]", ids, err)
+	}
+
+	if _, err := intRecordID(StringID("d7c5a1e2")); !errors.Is(err, ErrInvalidRecordID) {
+		t.Errorf("intRecordID() of a text ID error = %v, want %v", err, ErrInvalidRecordID)
+	}
+	if math.MaxInt == math.MaxInt32 {
+		if _, err := intRecordID(IntID(math.MaxInt32 + 1)); !errors.Is(err, ErrInvalidRecordID) {
+			t.Errorf("intRecordID() of an ID above MaxInt error = %v, want %v", err, ErrInvalidRecordID)
+		}
+	}
+}
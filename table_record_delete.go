@@ -8,38 +8,49 @@ import (
 // deleteRecordBuilder is used to build a delete query with a fluent API
 type deleteRecordBuilder struct {
 	table    *Table
-	recordID int
+	recordID RecordID
+	chainErr error // Stores any error in the chain of methods
 
 	contextProvider[*deleteRecordBuilder]
+	headerProvider[*deleteRecordBuilder]
 }
 
 // DeleteRecord deletes a single record in the table.
 //
 // Parameters:
-//   - recordID: The identifier of the record to delete.
-func (t *Table) DeleteRecord(recordID int) *deleteRecordBuilder {
+//   - recordID: The identifier of the record to delete, can be a RecordID, any integer type or a string.
+func (t *Table) DeleteRecord(recordID any) *deleteRecordBuilder {
+	id, err := parseRecordID(recordID)
+
 	b := &deleteRecordBuilder{
 		table:    t,
-		recordID: recordID,
+		recordID: id,
+		chainErr: err,
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
 // Execute finalizes and executes the operation.
 func (b *deleteRecordBuilder) Execute() error {
-	if b.recordID == 0 {
+	if b.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
 		return ErrRowIDRequired
 	}
 
 	err := b.table.
-		DeleteRecords([]int{b.recordID}).
+		DeleteRecords([]RecordID{b.recordID}).
 		WithContext(b.contextProvider.ctx).
+		withHeaders(b.headerProvider.rawHeader).
 		Execute()
 	if err != nil {
-		return fmt.Errorf("failed to delete record: %w", err)
+		return withOperation(fmt.Errorf("failed to delete record: %w", err), "DeleteRecord", b.table.tableID, b.recordID)
 	}
 
 	return nil
@@ -48,42 +59,63 @@ func (b *deleteRecordBuilder) Execute() error {
 // deleteRecordsBuilder is used to build a bulk delete query with a fluent API
 type deleteRecordsBuilder struct {
 	table     *Table
-	recordIDs []int
+	recordIDs []RecordID
+	chainErr  error // Stores any error in the chain of methods
+	guarded   bool  // Reports the mutation guard was already called by the operation that started it
 
 	contextProvider[*deleteRecordsBuilder]
+	headerProvider[*deleteRecordsBuilder]
 }
 
 // DeleteRecords deletes multiple records in the table.
 //
 // Parameters:
-//   - recordIDs: A slice of record IDs to identify which records to delete.
-func (t *Table) DeleteRecords(recordIDs []int) *deleteRecordsBuilder {
+//   - recordIDs: A slice of record IDs to identify which records to delete, of RecordIDs, integers or strings, or a slice of
+//     map[string]any or structs containing an "Id" field.
+func (t *Table) DeleteRecords(recordIDs any) *deleteRecordsBuilder {
+	ids, err := parseRecordIDs(recordIDs)
+
 	b := &deleteRecordsBuilder{
 		table:     t,
-		recordIDs: recordIDs,
+		recordIDs: ids,
+		chainErr:  err,
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
 // Execute finalizes and executes the operation.
 func (b *deleteRecordsBuilder) Execute() error {
+	if b.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
 	if len(b.recordIDs) == 0 {
 		return nil
 	}
 
+	if !b.guarded {
+		if err := b.table.client.checkMutation(MutationInfo{Operation: "DeleteRecords", TableID: b.table.tableID, Records: len(b.recordIDs)}); err != nil {
+			return withOperation(fmt.Errorf("failed to delete records: %w", err), "DeleteRecords", b.table.tableID, RecordID{})
+		}
+	}
+
 	// Convert IDs to the format expected by the API
 	ids := make([]map[string]any, len(b.recordIDs))
 	for i, id := range b.recordIDs {
-		ids[i] = map[string]any{"Id": id}
+		ids[i] = map[string]any{b.table.recordIDKey(): id}
 	}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/records", b.table.tableID)
-	_, err := b.table.client.request(b.contextProvider.ctx, http.MethodDelete, path, ids, nil)
+	path, err := b.table.recordsPath(true)
+	if err != nil {
+		return err
+	}
+	_, err = b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodDelete, path, ids, nil, b.headerProvider.rawHeader)
 	if err != nil {
-		return fmt.Errorf("failed to delete records: %w", err)
+		return withOperation(fmt.Errorf("failed to delete records: %w", err), "DeleteRecords", b.table.tableID, RecordID{})
 	}
 
 	return nil
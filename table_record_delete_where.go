@@ -0,0 +1,149 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"slices"
+)
+
+// maxDeleteWhereChunk is the maximum number of records deleted by a single request of
+// DeleteRecordsWhere
+const maxDeleteWhereChunk = 100
+
+// deleteRecordsWhereBuilder is used to build a delete of the records matching filters with a
+// fluent API
+type deleteRecordsWhereBuilder struct {
+	table       *Table
+	maxAffected *int
+	// defaultFilters is the number of filters copied from the defaults of the table, which come
+	// before the ones added to the builder
+	defaultFilters int
+
+	contextProvider[*deleteRecordsWhereBuilder]
+	filterProvider[*deleteRecordsWhereBuilder]
+	headerProvider[*deleteRecordsWhereBuilder]
+}
+
+// DeleteRecordsWhere initializes a builder that deletes the records of the table matching the
+// filters added with the Where methods, combined with the default filters of the table.
+//
+// At least one filter must be added to the builder, since the default filters of the table alone
+// don't count, and DeleteRecords deletes records by ID. Use WithMaxAffected to refuse to delete
+// anything when the filters match more records than expected.
+//
+// The IDs of the matching records are read first and the records are deleted in chunks of 100,
+// so the deletion is not atomic and it can stop halfway if a request fails.
+//
+// Example:
+//
+//	deleted, err := table.DeleteRecordsWhere().
+//		WhereIsEqualTo("Status", "expired").
+//		WhereIsLessThan("ExpiresAt", "2024-01-01").
+//		WithMaxAffected(500).
+//		Execute()
+func (t *Table) DeleteRecordsWhere() *deleteRecordsWhereBuilder {
+	b := &deleteRecordsWhereBuilder{
+		table: t,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	b.filterProvider.rawFilters = append(b.filterProvider.rawFilters, t.defaults.Filters...)
+	b.defaultFilters = len(t.defaults.Filters)
+
+	return b
+}
+
+// WithMaxAffected sets the maximum number of records the deletion can affect. If more records
+// match the filters, nothing is deleted and Execute returns ErrTooManyRecords, preventing a mass
+// deletion caused by a wrong filter.
+func (b *deleteRecordsWhereBuilder) WithMaxAffected(maxAffected int) *deleteRecordsWhereBuilder {
+	if maxAffected < 0 {
+		return b.filterProvider.addErr(fmt.Errorf("%w: the maximum of affected records can't be negative, got %d", ErrInvalidQuery, maxAffected))
+	}
+	b.maxAffected = &maxAffected
+	return b
+}
+
+// checkMaxAffected returns ErrTooManyRecords if the number of records exceeds the maximum.
+func (b *deleteRecordsWhereBuilder) checkMaxAffected(records int) error {
+	if b.maxAffected != nil && records > *b.maxAffected {
+		return fmt.Errorf("%w: %d records match the filters, the maximum is %d", ErrTooManyRecords, records, *b.maxAffected)
+	}
+	return nil
+}
+
+// Execute finalizes and executes the operation, returning the number of deleted records.
+func (b *deleteRecordsWhereBuilder) Execute() (int, error) {
+	if b.filterProvider.chainErr != nil {
+		return 0, fmt.Errorf("error in the chain of methods: %w", b.filterProvider.chainErr)
+	}
+	if len(b.filterProvider.rawFilters) <= b.defaultFilters {
+		return 0, fmt.Errorf("%w: at least one filter is required, use DeleteRecords to delete records by ID", ErrInvalidQuery)
+	}
+
+	// The filters already include the defaults of the table
+	table := b.table.WithDefaults(QueryDefaults{})
+	ctx := b.contextProvider.ctx
+
+	if b.maxAffected != nil {
+		count := table.CountRecords().WithContext(ctx).withHeaders(b.headerProvider.rawHeader)
+		for _, filter := range b.filterProvider.rawFilters {
+			count = count.Where(filter)
+		}
+		matching, err := count.Execute()
+		if err != nil {
+			return 0, fmt.Errorf("failed to count records to delete: %w", err)
+		}
+		if err := b.checkMaxAffected(matching); err != nil {
+			return 0, err
+		}
+	}
+
+	query := table.ListRecords().WithContext(ctx).withHeaders(b.headerProvider.rawHeader).ReturnFields(SystemFieldID)
+	for _, filter := range b.filterProvider.rawFilters {
+		query = query.Where(filter)
+	}
+	response, err := query.ExecuteAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read records to delete: %w", err)
+	}
+
+	ids := make([]RecordID, 0, len(response.List))
+	for _, record := range response.List {
+		id, err := parseRecordID(record[SystemFieldID])
+		if err != nil || id.IsZero() {
+			return 0, fmt.Errorf("failed to delete records: %w: %v", ErrInvalidRecordID, record[SystemFieldID])
+		}
+		ids = append(ids, id)
+	}
+
+	// Records may have been created between the count and the read
+	if err := b.checkMaxAffected(len(ids)); err != nil {
+		return 0, err
+	}
+
+	err = b.table.client.checkMutation(MutationInfo{
+		Operation: "DeleteRecordsWhere",
+		TableID:   b.table.tableID,
+		Records:   len(ids),
+		Filters:   slices.Clone(b.filterProvider.rawFilters),
+	})
+	if err != nil {
+		return 0, withOperation(fmt.Errorf("failed to delete records: %w", err), "DeleteRecordsWhere", b.table.tableID, RecordID{})
+	}
+
+	deleted := 0
+	for start := 0; start < len(ids); start += maxDeleteWhereChunk {
+		chunk := ids[start:min(start+maxDeleteWhereChunk, len(ids))]
+		query := table.DeleteRecords(chunk).WithContext(ctx).withHeaders(b.headerProvider.rawHeader)
+		query.guarded = true
+		if err := query.Execute(); err != nil {
+			return deleted, fmt.Errorf("failed to delete records %d to %d: %w", deleted+1, deleted+len(chunk), err)
+		}
+		deleted += len(chunk)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,56 @@
+package nocodbgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestDeleteRecordsWhere(t *testing.T) {
+	client, fake := newFakeClient(t)
+	var records []map[string]any
+	for i := 0; i < 150; i++ {
+		status := "active"
+		if i%5 != 0 {
+			status = "expired"
+		}
+		records = append(records, map[string]any{"Name": fmt.Sprintf("User %d", i), "Status": status})
+	}
+	fake.Seed("users", records...)
+	users := client.Table("users")
+
+	_, err := users.DeleteRecordsWhere().WhereIsEqualTo("Status", "expired").WithMaxAffected(100).Execute()
+	if !errors.Is(err, ErrTooManyRecords) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrTooManyRecords)
+	}
+	if got := len(fake.Records("users")); got != 150 {
+		t.Errorf("records = %d, want 150 after refusing", got)
+	}
+
+	deleted, err := users.DeleteRecordsWhere().WhereIsEqualTo("Status", "expired").WithMaxAffected(120).Execute()
+	if err != nil || deleted != 120 {
+		t.Fatalf("Execute() = %d, %v, want 120", deleted, err)
+	}
+	for _, record := range fake.Records("users") {
+		if record["Status"] != "active" {
+			t.Fatalf("record %v was not deleted", record)
+		}
+	}
+	if got := len(fake.Records("users")); got != 30 {
+		t.Errorf("records = %d, want 30", got)
+	}
+
+	if _, err := users.DeleteRecordsWhere().Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Execute() without filters error = %v, want %v", err, ErrInvalidQuery)
+	}
+	defaulted := users.WithDefaults(QueryDefaults{Filters: []string{"(Status,eq,active)"}})
+	if _, err := defaulted.DeleteRecordsWhere().Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Execute() with only default filters error = %v, want %v", err, ErrInvalidQuery)
+	}
+	if got := len(fake.Records("users")); got != 30 {
+		t.Errorf("records = %d, want 30 after refusing", got)
+	}
+	if _, err := users.DeleteRecordsWhere().WhereIsEqualTo("Status", "active").WithMaxAffected(-1).Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Execute() with a negative maximum error = %v, want %v", err, ErrInvalidQuery)
+	}
+}
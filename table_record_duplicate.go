@@ -0,0 +1,127 @@
+package nocodbgo
+
+import (
+	"fmt"
+)
+
+// duplicateRecordBuilder is used to build a record duplication with a fluent API
+type duplicateRecordBuilder struct {
+	table     *Table
+	recordID  RecordID
+	overrides map[string]any
+	copyLinks bool
+	chainErr  error // Stores any error in the chain of methods
+
+	contextProvider[*duplicateRecordBuilder]
+}
+
+// DuplicateRecord initializes a builder that creates a copy of a record.
+//
+// The record is read and its writable fields are copied into a new record, leaving out the system
+// fields, the primary key and the fields computed by NocoDB (e.g. formulas, lookups or links).
+// The overrides are applied on top of the copied fields.
+//
+// Parameters:
+//   - recordID:  The identifier of the record to duplicate, can be a RecordID, any integer type or a string.
+//   - overrides: The fields to set in the copy instead of the values of the original record, can be nil.
+//
+// Example:
+//
+//	// Copy a product with a new name, keeping its many to many links
+//	newID, err := table.DuplicateRecord(productID, map[string]any{"Name": "Chair (copy)"}).
+//		CopyLinks().
+//		Execute()
+func (t *Table) DuplicateRecord(recordID any, overrides map[string]any) *duplicateRecordBuilder {
+	id, err := parseRecordID(recordID)
+
+	b := &duplicateRecordBuilder{
+		table:     t,
+		recordID:  id,
+		overrides: overrides,
+		chainErr:  err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// CopyLinks links the copy to the same records the original record is linked to.
+//
+// Only the many to many and belongs to links are copied, since copying has many and one to one
+// links would unlink the records from the original record.
+func (b *duplicateRecordBuilder) CopyLinks() *duplicateRecordBuilder {
+	b.copyLinks = true
+	return b
+}
+
+// Execute finalizes and executes the operation, returning the identifier of the copy.
+func (b *duplicateRecordBuilder) Execute() (RecordID, error) {
+	if b.chainErr != nil {
+		return RecordID{}, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
+		return RecordID{}, ErrRowIDRequired
+	}
+
+	ctx := b.contextProvider.ctx
+
+	schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return RecordID{}, fmt.Errorf("failed to read table schema: %w", err)
+	}
+
+	original, err := b.table.ReadRecord(b.recordID).WithContext(ctx).Execute()
+	if err != nil {
+		return RecordID{}, fmt.Errorf("failed to read record to duplicate: %w", err)
+	}
+
+	data := map[string]any{}
+	for _, column := range schema.Columns {
+		if column.UIDT.IsReadOnly() || column.System || column.PrimaryKey {
+			continue
+		}
+		if value, ok := original.Data[column.Title]; ok {
+			data[column.Title] = value
+		}
+	}
+	for field, value := range b.overrides {
+		data[field] = value
+	}
+
+	newID, err := b.table.CreateRecord(data).WithContext(ctx).Execute()
+	if err != nil {
+		return RecordID{}, fmt.Errorf("failed to create duplicated record: %w", err)
+	}
+
+	if !b.copyLinks {
+		return newID, nil
+	}
+
+	for _, column := range schema.Columns {
+		if !column.UIDT.IsLink() {
+			continue
+		}
+		if relation, _ := column.ColOptions["type"].(string); relation != string(LinkManyToMany) && relation != "bt" {
+			continue
+		}
+
+		linked, err := b.table.WithDefaults(QueryDefaults{}).ListLinks(column.ID, b.recordID).
+			WithContext(ctx).
+			ReturnFields(SystemFieldID).
+			ExecuteAll()
+		if err != nil {
+			return newID, fmt.Errorf("failed to list links of %q: %w", column.Title, err)
+		}
+		if len(linked.List) == 0 {
+			continue
+		}
+
+		if err := b.table.CreateLinks(column.ID, newID, linked.List).WithContext(ctx).Execute(); err != nil {
+			return newID, fmt.Errorf("failed to copy links of %q: %w", column.Title, err)
+		}
+	}
+
+	return newID, nil
+}
@@ -0,0 +1,77 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestDuplicateRecord(t *testing.T) {
+	fake := nocodbgotest.New()
+	fake.DefineLink("products", "cl_tags", "tags")
+	fake.DefineLink("products", "cl_reviews", "reviews")
+	fake.Seed("tags", map[string]any{"Name": "Wood"}, map[string]any{"Name": "Outdoor"})
+	fake.Seed("reviews", map[string]any{"Stars": 5})
+	ids := fake.Seed("products", map[string]any{"Name": "Chair", "Price": 40, "Total": 80})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/products", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"products","title":"Products","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText"},
+			{"id":"cl_price","title":"Price","uidt":"Number"},
+			{"id":"cl_total","title":"Total","uidt":"Formula"},
+			{"id":"cl_created","title":"CreatedAt","uidt":"CreatedTime","system":true},
+			{"id":"cl_tags","title":"Tags","uidt":"Links","colOptions":{"type":"mm"}},
+			{"id":"cl_reviews","title":"Reviews","uidt":"Links","colOptions":{"type":"hm"}}
+		]}`))
+	})
+	mux.Handle("/", fake)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	products := client.Table("products")
+
+	if err := products.CreateLinks("cl_tags", ids[0], []int{1, 2}).Execute(); err != nil {
+		t.Fatalf("CreateLinks() error = %v", err)
+	}
+	if err := products.CreateLinks("cl_reviews", ids[0], []int{1}).Execute(); err != nil {
+		t.Fatalf("CreateLinks() error = %v", err)
+	}
+
+	// The default filters of the table don't hide any of the links to copy
+	created, err := products.WithDefaults(QueryDefaults{Filters: []string{"(Name,eq,Wood)"}}).
+		DuplicateRecord(ids[0], map[string]any{"Name": "Chair (copy)"}).
+		CopyLinks().
+		Execute()
+	if err != nil {
+		t.Fatalf("DuplicateRecord() error = %v", err)
+	}
+	newID, _ := created.Int64()
+
+	records := fake.Records("products")
+	if len(records) != 2 {
+		t.Fatalf("records = %v, want 2", records)
+	}
+	copied := records[1]
+	if copied["Name"] != "Chair (copy)" || copied["Price"] != float64(40) {
+		t.Errorf("copy = %v", copied)
+	}
+	if _, ok := copied["Total"]; ok {
+		t.Errorf("copy has computed field Total: %v", copied)
+	}
+
+	if got := fake.LinkedIDs("products", "cl_tags", newID); !reflect.DeepEqual(got, []int64{1, 2}) {
+		t.Errorf("copied tags = %v, want [1 2]", got)
+	}
+	if got := fake.LinkedIDs("products", "cl_reviews", newID); len(got) != 0 {
+		t.Errorf("copied reviews = %v, want none", got)
+	}
+}
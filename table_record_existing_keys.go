@@ -0,0 +1,114 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// maxExistingKeysChunk is the maximum number of values checked by a single request of ExistingKeys
+const maxExistingKeysChunk = 100
+
+// existingKeysBuilder is used to build a batch existence check with a fluent API
+type existingKeysBuilder struct {
+	table  *Table
+	column string
+	values []string
+
+	contextProvider[*existingKeysBuilder]
+	headerProvider[*existingKeysBuilder]
+}
+
+// ExistingKeys returns the subset of the given values already present in a column of the table,
+// so import pipelines can skip the duplicates of a batch before creating its records.
+//
+// The values are checked in chunks of 100 values per request, fetching only the column, and the
+// result keeps the order of the given values without repeating them. Empty values are ignored,
+// and values are compared exactly with the values of the column, formatted as strings. The
+// defaults of the table are ignored, so the whole table is checked.
+//
+// Parameters:
+//   - column: The column holding the keys, usually an external ID
+//   - values: The keys to check
+//
+// Example:
+//
+//	existing, err := customers.ExistingKeys("ExternalID", externalIDs...).Execute()
+//	skip := map[string]bool{}
+//	for _, id := range existing {
+//		skip[id] = true
+//	}
+func (t *Table) ExistingKeys(column string, values ...string) *existingKeysBuilder {
+	b := &existingKeysBuilder{
+		table:  t,
+		column: column,
+		values: values,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *existingKeysBuilder) Execute() ([]string, error) {
+	if b.column == "" {
+		return nil, fmt.Errorf("%w: the key column is required", ErrInvalidQuery)
+	}
+
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, value := range b.values {
+		if value != "" && !seen[value] {
+			seen[value] = true
+			keys = append(keys, value)
+		}
+	}
+
+	table := b.table.WithDefaults(QueryDefaults{})
+	found := map[string]bool{}
+	for start := 0; start < len(keys); start += maxExistingKeysChunk {
+		chunk := keys[start:min(start+maxExistingKeysChunk, len(keys))]
+
+		query := table.ListRecords().
+			WithContext(b.contextProvider.ctx).
+			WhereIsIn(b.column, chunk...).
+			ReturnFields(b.column)
+		query.headerProvider.rawHeader = b.headerProvider.rawHeader.Clone()
+
+		response, err := query.ExecuteAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing keys: %w", err)
+		}
+		for _, record := range response.List {
+			if key, ok := keyString(record[b.column]); ok {
+				found[key] = true
+			}
+		}
+	}
+
+	existing := []string{}
+	for _, key := range keys {
+		if found[key] {
+			existing = append(existing, key)
+		}
+	}
+
+	return existing, nil
+}
+
+// keyString formats a value of a key column as a string, reporting false for null values.
+func keyString(value any) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		return v.String(), true
+	}
+	return fmt.Sprint(value), true
+}
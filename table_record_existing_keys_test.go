@@ -0,0 +1,42 @@
+package nocodbgo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestExistingKeys(t *testing.T) {
+	client, fake := newFakeClient(t)
+	records := []map[string]any{}
+	for i := 0; i < 250; i += 2 {
+		records = append(records, map[string]any{"ExternalID": fmt.Sprintf("ext-%d", i), "Number": i})
+	}
+	fake.Seed("customers", records...)
+	customers := client.Table("customers").WithDefaults(QueryDefaults{Filters: []string{"(Number,lt,10)"}})
+
+	values := []string{"ext-200", "", "ext-201", "ext-4", "ext-200"}
+	for i := 0; i < 250; i++ {
+		values = append(values, fmt.Sprintf("missing-%d", i))
+	}
+	got, err := customers.ExistingKeys("ExternalID", values...).Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if want := []string{"ext-200", "ext-4"}; !slices.Equal(got, want) {
+		t.Errorf("Execute() = %v, want %v", got, want)
+	}
+
+	got, err = customers.ExistingKeys("Number", "3", "4", "248").Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if want := []string{"4", "248"}; !slices.Equal(got, want) {
+		t.Errorf("Execute() = %v, want %v", got, want)
+	}
+
+	if _, err := customers.ExistingKeys("", "ext-1").Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrInvalidQuery)
+	}
+}
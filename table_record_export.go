@@ -0,0 +1,42 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ExportJSONL finalizes the operation and streams all the matching records to w in JSON Lines
+// format (one JSON object per line), fetching them page by page.
+//
+// Records are written as soon as each page arrives, so the whole result set is never held in
+// memory, which makes it suitable for feeding data pipelines and bulk loads.
+//
+// If a limit has been set it is used as the page size, and if an offset has been set the
+// export starts from it.
+//
+// Example:
+//
+//	file, err := os.Create("users.jsonl")
+//	// Handle error
+//	defer file.Close()
+//
+//	err = table.ListRecords().
+//		WhereIsEqualTo("Active", "true").
+//		ExportJSONL(file)
+func (b *listRecordsBuilder) ExportJSONL(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	it := b.Iterate()
+	for it.Next() {
+		if err := encoder.Encode(it.Record()); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("failed to export records: %w", err)
+	}
+
+	return nil
+}
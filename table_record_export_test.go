@@ -0,0 +1,96 @@
+package nocodbgo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+// newFakeClient returns a client connected to a new in-memory nocodbgotest fake
+func newFakeClient(t *testing.T) (*Client, *nocodbgotest.Fake) {
+	t.Helper()
+
+	fake := nocodbgotest.New()
+	client, err := NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return client, fake
+}
+
+func TestExportJSONL(t *testing.T) {
+	client, fake := newFakeClient(t)
+	for i := 0; i < 5; i++ {
+		fake.Seed("users", map[string]any{"Name": "User", "Index": i})
+	}
+
+	var buf bytes.Buffer
+	err := client.Table("users").ListRecords().
+		WhereIsGreaterThan("Index", "0").
+		ReturnFields("Id", "Index").
+		Limit(2).
+		ExportJSONL(&buf)
+	if err != nil {
+		t.Fatalf("ExportJSONL() error = %v", err)
+	}
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var record map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("line %q is not valid JSON: %v", scanner.Text(), err)
+		}
+		lines = append(lines, record)
+	}
+
+	if len(lines) != 4 {
+		t.Fatalf("ExportJSONL() wrote %d lines, want 4", len(lines))
+	}
+	if lines[0]["Index"] != float64(1) || lines[3]["Index"] != float64(4) {
+		t.Errorf("ExportJSONL() lines = %v", lines)
+	}
+	if _, ok := lines[0]["Name"]; ok {
+		t.Errorf("ExportJSONL() included a field that was not requested: %v", lines[0])
+	}
+}
+
+// cancelingWriter cancels a context after the first write
+type cancelingWriter struct {
+	bytes.Buffer
+	cancel context.CancelFunc
+}
+
+// Write implements the io.Writer interface for cancelingWriter.
+func (w *cancelingWriter) Write(p []byte) (int, error) {
+	defer w.cancel()
+	return w.Buffer.Write(p)
+}
+
+func TestExportJSONLCanceled(t *testing.T) {
+	client, fake := newFakeClient(t)
+	for i := 0; i < 5; i++ {
+		fake.Seed("users", map[string]any{"Index": i})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &cancelingWriter{cancel: cancel}
+	err := client.Table("users").ListRecords().WithContext(ctx).Limit(2).ExportJSONL(w)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ExportJSONL() error = %v, want %v", err, context.Canceled)
+	}
+	if lines := bytes.Count(w.Bytes(), []byte("\n")); lines != 1 {
+		t.Errorf("ExportJSONL() wrote %d records after the cancellation, want 1", lines)
+	}
+}
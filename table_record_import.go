@@ -0,0 +1,189 @@
+package nocodbgo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// defaultImportChunkSize is the default number of records created per request when importing
+	defaultImportChunkSize = 100
+)
+
+// importCSVBuilder is used to build a CSV import with a fluent API
+type importCSVBuilder struct {
+	table           *Table
+	reader          io.Reader
+	columnMapping   map[string]string
+	chunkSize       int
+	validateColumns bool
+	onProgress      func(imported int)
+
+	contextProvider[*importCSVBuilder]
+}
+
+// ImportCSV imports the rows of a CSV document into the table.
+//
+// The first row of the document must contain the headers, which are used as the column titles
+// unless a column mapping is provided. Rows are created in chunks as they are read, so large
+// documents are never fully loaded in memory. Empty cells are omitted so the column default applies.
+//
+// Parameters:
+//   - r: The reader to read the CSV document from.
+//
+// Example:
+//
+//	ids, err := table.ImportCSV(file).
+//		WithColumnMapping(map[string]string{"full_name": "Name", "internal_id": ""}).
+//		WithSchemaValidation().
+//		WithProgress(func(imported int) { log.Printf("%d rows imported", imported) }).
+//		Execute()
+func (t *Table) ImportCSV(r io.Reader) *importCSVBuilder {
+	b := &importCSVBuilder{
+		table:     t,
+		reader:    r,
+		chunkSize: defaultImportChunkSize,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// WithColumnMapping sets the mapping from CSV headers to column titles.
+//
+// Headers not present in the mapping are used as the column title, and headers mapped to an
+// empty string are ignored.
+func (b *importCSVBuilder) WithColumnMapping(mapping map[string]string) *importCSVBuilder {
+	b.columnMapping = mapping
+	return b
+}
+
+// WithChunkSize sets the number of records created per request.
+//
+// If not set, it defaults to 100.
+func (b *importCSVBuilder) WithChunkSize(chunkSize int) *importCSVBuilder {
+	if chunkSize > 0 {
+		b.chunkSize = chunkSize
+	}
+	return b
+}
+
+// WithSchemaValidation enables the validation of the mapped columns against the table schema
+// before importing any row, failing if a column doesn't exist or can't be written.
+func (b *importCSVBuilder) WithSchemaValidation() *importCSVBuilder {
+	b.validateColumns = true
+	return b
+}
+
+// WithProgress sets a callback that is called after each chunk is created with the total number
+// of rows imported so far.
+func (b *importCSVBuilder) WithProgress(onProgress func(imported int)) *importCSVBuilder {
+	b.onProgress = onProgress
+	return b
+}
+
+// Execute finalizes and executes the operation.
+//
+// It returns the IDs of the created records. If an error occurs, the records created in the
+// previous chunks are kept and their IDs are returned along with the error.
+func (b *importCSVBuilder) Execute() ([]RecordID, error) {
+	reader := csv.NewReader(b.reader)
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV headers: %w", err)
+	}
+
+	columns := make([]string, len(headers))
+	for i, header := range headers {
+		columns[i] = header
+		if mapped, ok := b.columnMapping[header]; ok {
+			columns[i] = mapped
+		}
+	}
+
+	if b.validateColumns {
+		if err := b.validate(columns); err != nil {
+			return nil, err
+		}
+	}
+
+	var ids []RecordID
+	chunk := make([]map[string]any, 0, b.chunkSize)
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+
+		created, err := b.table.CreateRecords(chunk).WithContext(b.contextProvider.ctx).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to import rows %d to %d: %w", len(ids)+1, len(ids)+len(chunk), err)
+		}
+
+		ids = append(ids, created...)
+		chunk = make([]map[string]any, 0, b.chunkSize)
+		if b.onProgress != nil {
+			b.onProgress(len(ids))
+		}
+		return nil
+	}
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return ids, fmt.Errorf("failed to read CSV line %d: %w", line, err)
+		}
+
+		record := map[string]any{}
+		for i, value := range row {
+			if i < len(columns) && columns[i] != "" && value != "" {
+				record[columns[i]] = value
+			}
+		}
+		chunk = append(chunk, record)
+
+		if len(chunk) >= b.chunkSize {
+			if err := flush(); err != nil {
+				return ids, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return ids, err
+	}
+
+	return ids, nil
+}
+
+// validate checks that all the given columns exist in the table schema and can be written.
+func (b *importCSVBuilder) validate(columns []string) error {
+	schema, err := b.table.ReadSchema().WithContext(b.contextProvider.ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to validate columns: %w", err)
+	}
+
+	for _, title := range columns {
+		if title == "" {
+			continue
+		}
+
+		column, ok := schema.Column(title)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrColumnNotFound, title)
+		}
+
+		if column.UIDT.IsReadOnly() || column.System {
+			return fmt.Errorf("%w: %q", ErrColumnReadOnly, title)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package nocodbgo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImportCSV(t *testing.T) {
+	client, fake := newFakeClient(t)
+
+	csvData := strings.Join([]string{
+		"full_name,age,internal",
+		"John,30,x",
+		"Jane,,y",
+		"Bob,40,z",
+		"Alice,22,w",
+		"Eve,35,v",
+	}, "\n")
+
+	var progress []int
+	ids, err := client.Table("users").
+		ImportCSV(strings.NewReader(csvData)).
+		WithColumnMapping(map[string]string{"full_name": "Name", "age": "Age", "internal": ""}).
+		WithChunkSize(2).
+		WithProgress(func(imported int) { progress = append(progress, imported) }).
+		Execute()
+	if err != nil {
+		t.Fatalf("ImportCSV() error = %v", err)
+	}
+
+	if len(ids) != 5 {
+		t.Errorf("ImportCSV() ids = %v, want 5 ids", ids)
+	}
+	if len(progress) != 3 || progress[2] != 5 {
+		t.Errorf("ImportCSV() progress = %v, want [2 4 5]", progress)
+	}
+
+	records := fake.Records("users")
+	if records[0]["Name"] != "John" || records[0]["Age"] != "30" {
+		t.Errorf("ImportCSV() first record = %v", records[0])
+	}
+	if _, ok := records[1]["Age"]; ok {
+		t.Errorf("ImportCSV() stored an empty cell: %v", records[1])
+	}
+	if _, ok := records[0]["internal"]; ok {
+		t.Errorf("ImportCSV() stored an ignored column: %v", records[0])
+	}
+}
@@ -1,10 +1,13 @@
 package nocodbgo
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -12,6 +15,15 @@ import (
 type listRecordsBuilder struct {
 	table *Table
 
+	// schemaValidation enables the validation of the filtered and sorted columns against the schema
+	schemaValidation bool
+
+	// search is the search of SearchAllFields, nil when not used
+	search *recordSearch
+
+	// unstableOrder disables the primary key sort added by ExecuteAll and Iterate
+	unstableOrder bool
+
 	contextProvider[*listRecordsBuilder]
 	filterProvider[*listRecordsBuilder]
 	sortProvider[*listRecordsBuilder]
@@ -19,6 +31,9 @@ type listRecordsBuilder struct {
 	fieldProvider[*listRecordsBuilder]
 	shuffleProvider[*listRecordsBuilder]
 	viewIDProvider[*listRecordsBuilder]
+	queryParamProvider[*listRecordsBuilder]
+	headerProvider[*listRecordsBuilder]
+	paginationDriftProvider[*listRecordsBuilder]
 }
 
 // ListRecords lists records from the table.
@@ -34,10 +49,51 @@ func (t *Table) ListRecords() *listRecordsBuilder {
 	b.fieldProvider = newFieldProvider(b)
 	b.shuffleProvider = newShuffleProvider(b)
 	b.viewIDProvider = newViewIDProvider(b)
+	b.queryParamProvider = newQueryParamProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+	b.paginationDriftProvider = newPaginationDriftProvider(b)
+
+	b.filterProvider.rawFilters = append(b.filterProvider.rawFilters, t.defaults.Filters...)
+	b.fieldProvider.rawFields = append(b.fieldProvider.rawFields, t.defaults.Fields...)
+	b.paginationProvider.rawLimit = t.defaults.PageSize
+	b.viewIDProvider.rawViewID = t.defaults.ViewID
 
 	return b
 }
 
+// Clone returns a copy of the builder that can be modified and executed independently of the
+// original, so a base query can be built once and reused concurrently with per-request variations.
+//
+// Example:
+//
+//	base := table.ListRecords().ReturnFields("Name", "Status").WhereIsEqualTo("Status", "active")
+//
+//	// In each request
+//	query := base.Clone().WhereIsEqualTo("Owner", userID)
+func (b *listRecordsBuilder) Clone() *listRecordsBuilder {
+	c := &listRecordsBuilder{
+		table:            b.table,
+		schemaValidation: b.schemaValidation,
+		unstableOrder:    b.unstableOrder,
+	}
+	if b.search != nil {
+		c.search = &recordSearch{term: b.search.term, columns: slices.Clone(b.search.columns)}
+	}
+
+	c.contextProvider = b.contextProvider.clone(c)
+	c.filterProvider = b.filterProvider.clone(c)
+	c.sortProvider = b.sortProvider.clone(c)
+	c.paginationProvider = b.paginationProvider.clone(c)
+	c.fieldProvider = b.fieldProvider.clone(c)
+	c.shuffleProvider = b.shuffleProvider.clone(c)
+	c.viewIDProvider = b.viewIDProvider.clone(c)
+	c.queryParamProvider = b.queryParamProvider.clone(c)
+	c.headerProvider = b.headerProvider.clone(c)
+	c.paginationDriftProvider = b.paginationDriftProvider.clone(c)
+
+	return c
+}
+
 // ListResponse is the response from a list query with pagination information
 type ListResponse struct {
 	// List contains the records returned by the query
@@ -61,7 +117,8 @@ type PageInfo struct {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for ListResponse.
-// It handles both list responses with pagination and single object responses.
+// It handles both list responses with pagination and single object responses, and keeps the
+// numbers of the records that a float64 can't hold exactly as json.Number.
 func (r *ListResponse) UnmarshalJSON(data []byte) error {
 	if r == nil {
 		r = &ListResponse{}
@@ -80,7 +137,7 @@ func (r *ListResponse) UnmarshalJSON(data []byte) error {
 	}
 
 	var rawMap map[string]any
-	if err := json.Unmarshal(data, &rawMap); err != nil {
+	if err := unmarshalRecords(data, &rawMap); err != nil {
 		return fmt.Errorf("failed to unmarshal list response: %w", err)
 	}
 
@@ -91,16 +148,19 @@ func (r *ListResponse) UnmarshalJSON(data []byte) error {
 		type Alias ListResponse
 		var aux Alias
 
-		if err := json.Unmarshal(data, &aux); err != nil {
+		if err := unmarshalRecords(data, &aux); err != nil {
 			return fmt.Errorf("failed to unmarshal list response: %w", err)
 		}
+		for _, record := range aux.List {
+			exactNumbers(record)
+		}
 
 		// Copy the data back to r
 		*r = ListResponse(aux)
 		return nil
 	}
 
-	r.List = []map[string]any{rawMap}
+	r.List = []map[string]any{exactNumbers(rawMap).(map[string]any)}
 	r.PageInfo = PageInfo{
 		TotalRows:   1,
 		Page:        1,
@@ -120,24 +180,151 @@ func (r ListResponse) DecodeInto(dest any) error {
 
 // Execute finalizes and executes the operation.
 func (b *listRecordsBuilder) Execute() (ListResponse, error) {
+	if err := b.validateSchema(b.contextProvider.ctx); err != nil {
+		return ListResponse{}, err
+	}
+	if err := b.resolveSearch(b.contextProvider.ctx); err != nil {
+		return ListResponse{}, err
+	}
+	return b.fetch(b.contextProvider.ctx, b.buildQuery())
+}
+
+// ExecuteAll finalizes and executes the operation fetching all the matching records page by page.
+//
+// If a limit has been set it is used as the page size, and if an offset has been set the
+// iteration starts from it. Queries without a sort are sorted by the primary key, see
+// WithoutStableOrder.
+func (b *listRecordsBuilder) ExecuteAll() (ListResponse, error) {
+	return collectAll(b.Iterate())
+}
+
+// Iterate finalizes the operation and returns an iterator over all the matching records,
+// fetching them page by page as the iteration advances.
+//
+// If a limit has been set it is used as the page size, and if an offset has been set the
+// iteration starts from it. Queries without a sort are sorted by the primary key, see
+// WithoutStableOrder.
+func (b *listRecordsBuilder) Iterate() *RecordIterator {
+	validated := false
+	stableSort := ""
+	fetch := func(ctx context.Context, limit int, offset int) (ListResponse, error) {
+		if !validated {
+			if err := b.validateSchema(ctx); err != nil {
+				return ListResponse{}, err
+			}
+			if err := b.resolveSearch(ctx); err != nil {
+				return ListResponse{}, err
+			}
+			var err error
+			if stableSort, err = b.resolveStableSort(ctx, b.buildQuery()); err != nil {
+				return ListResponse{}, err
+			}
+			validated = true
+		}
+
+		query := b.buildQuery()
+		if stableSort != "" {
+			query.Set("sort", stableSort)
+		}
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("offset", strconv.Itoa(offset))
+		return b.fetch(ctx, query)
+	}
+
+	it := newRecordIterator(b.contextProvider.ctx, fetch, b.table.client.pageSize(b.paginationProvider.rawLimit, defaultIteratorPageSize), b.paginationProvider.rawOffset)
+	return b.paginationDriftProvider.apply(it)
+}
+
+// buildQuery builds the query parameters for the request from all the providers.
+func (b *listRecordsBuilder) buildQuery() url.Values {
 	query := url.Values{}
 	query = b.filterProvider.apply(query)
+	query = b.search.apply(query)
 	query = b.sortProvider.apply(query)
 	query = b.paginationProvider.apply(query)
+	query = b.table.client.applyPageSize(query, b.paginationProvider.rawLimit)
 	query = b.fieldProvider.apply(query)
 	query = b.shuffleProvider.apply(query)
 	query = b.viewIDProvider.apply(query)
+	query = b.queryParamProvider.apply(query)
+	return query
+}
+
+// Query renders the request the builder sends when executed, without executing it, to assert
+// in tests exactly what the builder sends or to debug its filters.
+//
+// It renders the single request of Execute. Like Execute, it reads the table schema to replace
+// the old titles of the column aliases of the table and to find the text columns of a
+// SearchAllFields without columns. ExecuteAll and Iterate send a request like this one per page,
+// with the limit and offset of the page, and sorted by the primary key if the query has no sort.
+//
+// Example:
+//
+//	rendered, err := table.ListRecords().WhereIsEqualTo("Name", "Ana").Query()
+//	// rendered.String() is "GET /api/v2/tables/{tableID}/records?where=%28Name%2Ceq%2CAna%29"
+func (b *listRecordsBuilder) Query() (RenderedQuery, error) {
+	if err := b.resolveSearch(b.contextProvider.ctx); err != nil {
+		return RenderedQuery{}, err
+	}
+	rendered, _, err := b.prepare(b.contextProvider.ctx, b.buildQuery())
+	return rendered, err
+}
+
+// String implements the fmt.Stringer interface for listRecordsBuilder, returning the rendered
+// request of the builder.
+func (b *listRecordsBuilder) String() string {
+	return renderedString(b.Query())
+}
+
+// render renders the request with the given query parameters for the API version of the client.
+func (b *listRecordsBuilder) render(query url.Values) (RenderedQuery, error) {
+	if err := errors.Join(b.filterProvider.chainErr, b.sortProvider.chainErr, b.paginationProvider.chainErr); err != nil {
+		return RenderedQuery{}, fmt.Errorf("error in the chain of methods: %w", err)
+	}
+
+	path, err := b.table.recordsPath(false)
+	if err != nil {
+		return RenderedQuery{}, err
+	}
+	query, err = b.table.listQuery(query)
+	if err != nil {
+		return RenderedQuery{}, err
+	}
+
+	return RenderedQuery{Method: http.MethodGet, Path: path, Query: query}, nil
+}
+
+// prepare renders the request with the given query parameters and the column aliases of the
+// table, returning the renames to apply to the response.
+func (b *listRecordsBuilder) prepare(ctx context.Context, query url.Values) (RenderedQuery, columnRenames, error) {
+	renames, err := b.table.columnRenames(ctx)
+	if err != nil {
+		return RenderedQuery{}, nil, err
+	}
+	rendered, err := b.render(renames.query(query))
+	if err != nil {
+		return RenderedQuery{}, nil, err
+	}
+	return rendered, renames, nil
+}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/records", b.table.tableID)
-	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, query)
+// fetch sends the request with the given query parameters and decodes the response.
+func (b *listRecordsBuilder) fetch(ctx context.Context, query url.Values) (ListResponse, error) {
+	rendered, renames, err := b.prepare(ctx, query)
 	if err != nil {
-		return ListResponse{}, fmt.Errorf("failed to list records: %w", err)
+		return ListResponse{}, err
+	}
+	respBody, err := b.table.client.requestWithHeader(ctx, rendered.Method, rendered.Path, nil, rendered.Query, b.headerProvider.rawHeader)
+	if err != nil {
+		return ListResponse{}, withOperation(fmt.Errorf("failed to list records: %w", err), "ListRecords", b.table.tableID, RecordID{})
 	}
 
-	var response ListResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
+	response, err := b.table.decodeList(respBody, rendered.Query)
+	if err != nil {
 		return ListResponse{}, fmt.Errorf("failed to unmarshal list response: %w", err)
 	}
+	b.fieldProvider.stripSystemFields(response.List...)
+	renames.addOldTitles(response.List...)
 
 	return response, nil
 }
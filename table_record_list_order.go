@@ -0,0 +1,46 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WithoutStableOrder stops ExecuteAll and Iterate from sorting by the primary key the queries
+// without a sort.
+//
+// Offset pagination needs a deterministic order, since the database can return the records of an
+// unsorted query in a different order on each request, skipping some records and repeating others
+// across pages. So when a query has no sort, no view and no shuffle, ExecuteAll and Iterate read
+// the table schema once and sort it by the primary key. Use WithoutStableOrder to save the schema
+// request when the order doesn't matter or the default order of the table is known to be stable.
+//
+// Example:
+//
+//	all, err := table.ListRecords().WithoutStableOrder().ExecuteAll()
+func (b *listRecordsBuilder) WithoutStableOrder() *listRecordsBuilder {
+	b.unstableOrder = true
+	return b
+}
+
+// resolveStableSort returns the sort that makes the pages of the query deterministic, which is the
+// primary key of the table, or an empty string if the query doesn't need one.
+func (b *listRecordsBuilder) resolveStableSort(ctx context.Context, query url.Values) (string, error) {
+	// The views and the custom sort parameters have their own order, which is kept
+	if b.unstableOrder || b.shuffleProvider.rawShuffle || query.Has("sort") || query.Has("viewId") {
+		return "", nil
+	}
+
+	schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the primary key for a stable order: %w", err)
+	}
+
+	primaryKey, ok := schema.PrimaryKey()
+	if !ok || primaryKey.Title == "" || strings.Contains(primaryKey.Title, ",") {
+		return "", nil
+	}
+
+	return primaryKey.Title, nil
+}
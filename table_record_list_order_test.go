@@ -0,0 +1,87 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStableOrder(t *testing.T) {
+	var requests []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/orders", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "schema")
+		fmt.Fprint(w, `{"id":"orders","columns":[{"title":"Name","uidt":"SingleLineText"},{"title":"OrderNo","uidt":"Number","pk":1}]}`)
+	})
+	mux.HandleFunc("GET /api/v2/tables/orders/records", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "sort="+r.URL.Query().Get("sort"))
+		fmt.Fprint(w, `{"list":[{"OrderNo":1},{"OrderNo":2}],"pageInfo":{"totalRows":3,"isLastPage":false}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	orders := client.Table("orders")
+
+	tests := []struct {
+		name  string
+		query *listRecordsBuilder
+		want  []string
+	}{
+		{
+			name:  "sorts by the primary key",
+			query: orders.ListRecords(),
+			want:  []string{"schema", "sort=OrderNo", "sort=OrderNo"},
+		},
+		{
+			name:  "keeps the sort of the query",
+			query: orders.ListRecords().SortDescBy("Name"),
+			want:  []string{"sort=-Name", "sort=-Name"},
+		},
+		{
+			name:  "keeps the order of the view",
+			query: orders.ListRecords().WithViewId("vw_xxxxxxxxxxxxxx"),
+			want:  []string{"sort=", "sort="},
+		},
+		{
+			name:  "can be disabled",
+			query: orders.ListRecords().WithoutStableOrder(),
+			want:  []string{"sort=", "sort="},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = nil
+
+			it := tt.query.Limit(2).Iterate()
+			for i := 0; i < 3 && it.Next(); i++ {
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+
+			if fmt.Sprint(requests) != fmt.Sprint(tt.want) {
+				t.Errorf("requests = %v, want %v", requests, tt.want)
+			}
+		})
+	}
+}
+
+func TestStableOrderQuery(t *testing.T) {
+	client, err := NewClient().WithBaseURL("http://localhost").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Query renders the request of Execute, which is not sorted by the primary key
+	rendered, err := client.Table("orders").ListRecords().Query()
+	if err != nil || rendered.Query.Has("sort") {
+		t.Errorf("Query() = %v, %v, want no sort", rendered, err)
+	}
+}
@@ -0,0 +1,95 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// filterComparisonPattern matches the column and the operator of each comparison of a filter
+// expression, including the ones nested in groups
+var filterComparisonPattern = regexp.MustCompile(`\(([^(),~]+),([a-zA-Z]+)`)
+
+// linkFilterOperators are the comparison operators NocoDB supports on link columns, which compare
+// the number of linked records
+var linkFilterOperators = []string{"eq", "neq", "gt", "ge", "gte", "lt", "le", "lte", "blank", "notblank"}
+
+// WithSchemaValidation enables the validation of the filtered and sorted columns against the table
+// schema before sending the query, so the queries NocoDB can't run fail with a clear error instead
+// of an error response or a silently ignored sort:
+//
+//   - Columns that don't exist fail with ErrColumnNotFound.
+//   - Sorting by link columns with many records (has-many and many-to-many) or by lookups through
+//     them fails with ErrUnsupportedColumnType, since they have many values per record. Lookups
+//     through single record links and rollups, which aggregate the values, can be sorted.
+//   - Filtering link columns with operators other than the count comparisons (eq, neq, gt, ge, lt,
+//     le, blank and notblank) fails with ErrUnsupportedColumnType.
+//
+// The schema is read once per execution, so it adds a request to each query.
+//
+// Example:
+//
+//	// Sort companies by the name of their owner, a lookup through a belongs-to link
+//	result, err := companies.ListRecords().
+//		WhereIsGreaterThan("Contacts", "2").
+//		SortAscBy("Owner Name").
+//		WithSchemaValidation().
+//		Execute()
+func (b *listRecordsBuilder) WithSchemaValidation() *listRecordsBuilder {
+	b.schemaValidation = true
+	return b
+}
+
+// validateSchema checks the filtered and sorted columns against the table schema, if enabled.
+func (b *listRecordsBuilder) validateSchema(ctx context.Context) error {
+	if !b.schemaValidation || (len(b.filterProvider.rawFilters) == 0 && len(b.sortProvider.rawSorts) == 0) {
+		return nil
+	}
+
+	schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to read table schema: %w", err)
+	}
+
+	for _, filter := range b.filterProvider.rawFilters {
+		for _, match := range filterComparisonPattern.FindAllStringSubmatch(filter, -1) {
+			title, operator := strings.TrimSpace(match[1]), match[2]
+			column, ok := schema.Column(title)
+			if !ok {
+				return fmt.Errorf("%w: filter on %q", ErrColumnNotFound, title)
+			}
+			if column.UIDT.IsLink() && !slices.Contains(linkFilterOperators, operator) {
+				return fmt.Errorf("%w: link column %q can only be filtered by the number of linked records, not with %q", ErrUnsupportedColumnType, title, operator)
+			}
+		}
+	}
+
+	for _, sort := range b.sortProvider.rawSorts {
+		title := strings.TrimPrefix(sort, "-")
+		column, ok := schema.Column(title)
+		if !ok {
+			return fmt.Errorf("%w: sort by %q", ErrColumnNotFound, title)
+		}
+
+		relation := column
+		if column.UIDT == ColumnTypeLookup {
+			relationID, _ := column.ColOptions["fk_relation_column_id"].(string)
+			if relation, ok = schema.columnByID(relationID); !ok {
+				continue
+			}
+		}
+		if relation.UIDT.IsLink() && hasManyLinkedRecords(relation) {
+			return fmt.Errorf("%w: %s column %q has many values per record and can't be sorted by", ErrUnsupportedColumnType, column.UIDT, title)
+		}
+	}
+
+	return nil
+}
+
+// hasManyLinkedRecords reports if a link column can link a record to many records.
+func hasManyLinkedRecords(column Column) bool {
+	relation, _ := column.ColOptions["type"].(string)
+	return relation == string(LinkHasMany) || relation == string(LinkManyToMany)
+}
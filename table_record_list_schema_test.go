@@ -0,0 +1,70 @@
+package nocodbgo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestListRecordsSchemaValidation(t *testing.T) {
+	fake := nocodbgotest.New()
+	fake.Seed("companies", map[string]any{"Name": "Acme", "Owner Name": "Ana", "Contacts": 3})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/companies", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"companies","title":"Companies","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText"},
+			{"id":"cl_owner","title":"Owner","uidt":"LinkToAnotherRecord","colOptions":{"type":"bt"}},
+			{"id":"cl_owner_name","title":"Owner Name","uidt":"Lookup","colOptions":{"fk_relation_column_id":"cl_owner"}},
+			{"id":"cl_contacts","title":"Contacts","uidt":"Links","colOptions":{"type":"hm"}},
+			{"id":"cl_contact_emails","title":"Contact Emails","uidt":"Lookup","colOptions":{"fk_relation_column_id":"cl_contacts"}},
+			{"id":"cl_contact_count","title":"Contact Count","uidt":"Rollup","colOptions":{"fk_relation_column_id":"cl_contacts"}}
+		]}`))
+	})
+	mux.Handle("/", fake)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	companies := client.Table("companies")
+
+	result, err := companies.ListRecords().
+		WhereIsGreaterThan("Contacts", "2").
+		Where("(Name,eq,Acme)~or((Owner Name,like,%A%))").
+		SortAscBy("Owner Name").
+		SortDescBy("Contact Count").
+		WithSchemaValidation().
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(result.List) != 1 {
+		t.Errorf("Execute() = %v, want Acme", result.List)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		query *listRecordsBuilder
+		want  error
+	}{
+		{name: "unknown filter column", query: companies.ListRecords().WhereIsEqualTo("Nmae", "Acme"), want: ErrColumnNotFound},
+		{name: "unknown sort column", query: companies.ListRecords().SortDescBy("Nmae"), want: ErrColumnNotFound},
+		{name: "sort by has-many link", query: companies.ListRecords().SortAscBy("Contacts"), want: ErrUnsupportedColumnType},
+		{name: "sort by lookup through has-many link", query: companies.ListRecords().SortAscBy("Contact Emails"), want: ErrUnsupportedColumnType},
+		{name: "text filter on link", query: companies.ListRecords().WhereIsLike("Contacts", "%Bob%"), want: ErrUnsupportedColumnType},
+		{name: "comma in column name", query: companies.ListRecords().SortAscBy("Name,Id"), want: ErrInvalidQuery},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.query.WithSchemaValidation().ExecuteAll(); !errors.Is(err, tt.want) {
+				t.Errorf("ExecuteAll() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
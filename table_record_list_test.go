@@ -2,6 +2,11 @@ package nocodbgo
 
 import (
 	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -222,3 +227,179 @@ func TestListResponseUnmarshalJSON(t *testing.T) {
 		}
 	})
 }
+
+func TestListRecordsClone(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("users",
+		map[string]any{"Name": "Ana", "Status": "active", "Team": "red"},
+		map[string]any{"Name": "Bob", "Status": "active", "Team": "blue"},
+		map[string]any{"Name": "Cid", "Status": "inactive", "Team": "red"},
+	)
+
+	base := client.Table("users").ListRecords().WhereIsEqualTo("Status", "active").ReturnFields("Name")
+
+	var wg sync.WaitGroup
+	results := make([]ListResponse, 2)
+	for i, team := range []string{"red", "blue"} {
+		wg.Add(1)
+		go func(i int, team string) {
+			defer wg.Done()
+			results[i], _ = base.Clone().WhereIsEqualTo("Team", team).Execute()
+		}(i, team)
+	}
+	wg.Wait()
+
+	if len(results[0].List) != 1 || results[0].List[0]["Name"] != "Ana" {
+		t.Errorf("red = %v, want [Ana]", results[0].List)
+	}
+	if len(results[1].List) != 1 || results[1].List[0]["Name"] != "Bob" {
+		t.Errorf("blue = %v, want [Bob]", results[1].List)
+	}
+
+	all, err := base.Execute()
+	if err != nil || len(all.List) != 2 {
+		t.Errorf("base = %v, %v, want 2 records", all.List, err)
+	}
+
+	count, err := client.Table("users").CountRecords().WhereIsEqualTo("Team", "red").Clone().WhereIsEqualTo("Status", "active").Execute()
+	if err != nil || count != 1 {
+		t.Errorf("CountRecords().Clone() = %v, %v, want 1", count, err)
+	}
+}
+
+func TestListRecordsValidation(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("users", map[string]any{"Name": "Ana"})
+	table := client.Table("users")
+
+	tests := []struct {
+		name    string
+		builder *listRecordsBuilder
+	}{
+		{name: "empty filter column", builder: table.ListRecords().WhereIsEqualTo("", "Ana")},
+		{name: "missing between bound", builder: table.ListRecords().WhereIsBetween("Age", "18", "")},
+		{name: "missing within sub-operation", builder: table.ListRecords().WhereIsWithin("CreatedAt", "")},
+		{name: "missing within value", builder: table.ListRecords().WhereIsWithin("CreatedAt", "pastNumberOfDays")},
+		{name: "too many within values", builder: table.ListRecords().WhereIsWithin("CreatedAt", "pastNumberOfDays", "1", "2")},
+		{name: "empty sort column", builder: table.ListRecords().SortDescBy(" ")},
+		{name: "negative limit", builder: table.ListRecords().Limit(-1)},
+		{name: "negative offset", builder: table.ListRecords().Offset(-10)},
+		{name: "invalid page", builder: table.ListRecords().Page(0, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.builder.Execute(); !errors.Is(err, ErrInvalidQuery) {
+				t.Errorf("Execute() error = %v, want %v", err, ErrInvalidQuery)
+			}
+			if _, err := tt.builder.ExecuteAll(); !errors.Is(err, ErrInvalidQuery) {
+				t.Errorf("ExecuteAll() error = %v, want %v", err, ErrInvalidQuery)
+			}
+		})
+	}
+
+	if _, err := table.CountRecords().WhereIsLike("", "A%").Execute(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("CountRecords().Execute() error = %v, want %v", err, ErrInvalidQuery)
+	}
+
+	_, err := table.ListRecords().Limit(-1).WhereIsNull("").Clone().Execute()
+	if err == nil || !strings.Contains(err.Error(), "negative limit") || !strings.Contains(err.Error(), "(,is,null)") {
+		t.Errorf("Clone().Execute() error = %v, want both errors", err)
+	}
+}
+
+func TestListRecordsQueryParam(t *testing.T) {
+	client, _ := newFakeClient(t)
+
+	base := client.Table("users").ListRecords().
+		Limit(10).
+		WithQueryParam("nestedPage", "2").
+		WithQueryParam("limit", "50").
+		WithQueryParam("", "ignored")
+
+	query := base.Clone().WithQueryParam("nestedPage", "3").buildQuery()
+	if got := query.Get("nestedPage"); got != "3" {
+		t.Errorf("clone nestedPage = %q, want 3", got)
+	}
+
+	query = base.buildQuery()
+	if got := query.Get("nestedPage"); got != "2" {
+		t.Errorf("nestedPage = %q, want 2", got)
+	}
+	if got := query.Get("limit"); got != "50" {
+		t.Errorf("limit = %q, want 50", got)
+	}
+	if query.Has("") {
+		t.Error("query has a parameter with an empty key")
+	}
+
+	links := client.Table("users").ListLinks("cl_tasks", 1).WithQueryParam("nestedPage", "4").buildQuery()
+	if got := links.Get("nestedPage"); got != "4" {
+		t.Errorf("links nestedPage = %q, want 4", got)
+	}
+}
+
+func TestBuilderQuery(t *testing.T) {
+	client, err := NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("users")
+
+	list := table.ListRecords().WhereIsEqualTo("Name", "Ana").SortDescBy("Age").Limit(10)
+	rendered, err := list.Query()
+	if err != nil {
+		t.Fatalf("ListRecords().Query() error = %v", err)
+	}
+	if rendered.Method != "GET" || rendered.Path != "/api/v2/tables/users/records" || rendered.Query.Get("where") != "(Name,eq,Ana)" || rendered.Query.Get("sort") != "-Age" || rendered.Query.Get("limit") != "10" {
+		t.Errorf("ListRecords().Query() = %+v", rendered)
+	}
+	if got, want := list.String(), "GET /api/v2/tables/users/records?limit=10&sort=-Age&where=%28Name%2Ceq%2CAna%29"; got != want {
+		t.Errorf("ListRecords().String() = %q, want %q", got, want)
+	}
+
+	if got, want := table.CountRecords().WhereIsNull("Email").String(), "GET /api/v2/tables/users/records/count?where=%28Email%2Cis%2Cnull%29"; got != want {
+		t.Errorf("CountRecords().String() = %q, want %q", got, want)
+	}
+
+	if got, want := table.ListLinks("cl_teams", 1).Offset(5).String(), "GET /api/v2/tables/users/links/cl_teams/records/1?offset=5"; got != want {
+		t.Errorf("ListLinks().String() = %q, want %q", got, want)
+	}
+
+	if _, err := table.ListRecords().Limit(-1).Query(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("ListRecords().Query() with an invalid limit error = %v, want %v", err, ErrInvalidQuery)
+	}
+	if got := table.ListLinks("", 1).String(); !strings.HasPrefix(got, "invalid query: ") {
+		t.Errorf("ListLinks().String() without link field = %q, want an invalid query", got)
+	}
+}
+
+func TestNestedFields(t *testing.T) {
+	client, err := NewClient().WithBaseURL("https://example.com").WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("companies")
+
+	rendered, err := table.ListRecords().
+		WithNestedFields("Contacts", "Name", "Email").
+		WithNestedLimit("Contacts", 5).
+		WithNestedFields("Deals").
+		WithNestedLimit("Deals", 0).
+		Query()
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	want := url.Values{
+		"nested[Contacts][fields]": {"Name,Email"},
+		"nested[Contacts][limit]":  {"5"},
+	}
+	if !reflect.DeepEqual(rendered.Query, want) {
+		t.Errorf("Query() = %v, want %v", rendered.Query, want)
+	}
+
+	got := table.ListLinks("cl_contacts", 1).WithNestedFields("Company", "Name").WithNestedLimit("Company", 1).String()
+	if want := "GET /api/v2/tables/companies/links/cl_contacts/records/1?nested%5BCompany%5D%5Bfields%5D=Name&nested%5BCompany%5D%5Blimit%5D=1"; got != want {
+		t.Errorf("ListLinks().String() = %q, want %q", got, want)
+	}
+}
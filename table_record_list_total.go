@@ -0,0 +1,190 @@
+package nocodbgo
+
+import (
+	"context"
+	"slices"
+)
+
+// ExecuteWithTotal finalizes and executes the operation like Execute, guaranteeing that
+// PageInfo.TotalRows holds the number of records matching the query, to show the number of pages
+// in a pagination UI.
+//
+// The v3 API doesn't return the total, so it's counted with a count request sent in parallel with
+// the list request. With the other versions the total of the response is used, and the count
+// request is only sent if the response has no total, which is detected when it's smaller than the
+// records returned up to that page.
+//
+// Example:
+//
+//	result, err := table.ListRecords().
+//		WhereIsEqualTo("Status", "active").
+//		Page(3, 25).
+//		ExecuteWithTotal()
+//	// Handle error
+//	pages := (result.PageInfo.TotalRows + 24) / 25
+func (b *listRecordsBuilder) ExecuteWithTotal() (ListResponse, error) {
+	ctx := b.contextProvider.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := b.validateSchema(ctx); err != nil {
+		return ListResponse{}, err
+	}
+	if err := b.resolveSearch(ctx); err != nil {
+		return ListResponse{}, err
+	}
+
+	if b.table.client.apiVersion != APIVersionV3 {
+		response, err := b.fetch(ctx, b.buildQuery())
+		if err != nil {
+			return ListResponse{}, err
+		}
+		if response.PageInfo.TotalRows >= b.paginationProvider.rawOffset+len(response.List) {
+			return response, nil
+		}
+
+		total, err := b.countQuery(ctx).Execute()
+		if err != nil {
+			return ListResponse{}, err
+		}
+		response.PageInfo.TotalRows = total
+		return response, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type countResult struct {
+		total int
+		err   error
+	}
+	counted := make(chan countResult, 1)
+	go func() {
+		total, err := b.countQuery(ctx).Execute()
+		counted <- countResult{total: total, err: err}
+	}()
+
+	response, err := b.fetch(ctx, b.buildQuery())
+	if err != nil {
+		return ListResponse{}, err
+	}
+
+	count := <-counted
+	if count.err != nil {
+		return ListResponse{}, count.err
+	}
+	response.PageInfo.TotalRows = count.total
+
+	return response, nil
+}
+
+// countQuery returns a count query matching the same records as the list query, with its filters,
+// search, view, query parameters and headers.
+func (b *listRecordsBuilder) countQuery(ctx context.Context) *countRecordsBuilder {
+	c := b.table.CountRecords().WithContext(ctx)
+
+	// The filters of the list query already include the default filters of the table
+	c.filterProvider.rawFilters = slices.Clone(b.filterProvider.rawFilters)
+	c.filterProvider.chainErr = b.filterProvider.chainErr
+	if b.search != nil && len(b.search.columns) > 0 {
+		c.filterProvider.rawFilters = append(c.filterProvider.rawFilters, b.search.filter())
+	}
+
+	c.viewIDProvider.rawViewID = b.viewIDProvider.rawViewID
+	for key, values := range b.queryParamProvider.rawParams {
+		c.queryParamProvider.rawParams[key] = slices.Clone(values)
+	}
+	c.headerProvider.rawHeader = b.headerProvider.rawHeader.Clone()
+
+	return c
+}
+
+// ExecuteWithTotal finalizes and executes the operation like Execute, guaranteeing that
+// PageInfo.TotalRows holds the number of linked records matching the query, to show the number of
+// pages in a pagination UI.
+//
+// The links API has no count endpoint, so when the response has no total, detected like in the
+// ExecuteWithTotal of ListRecords, the linked records are counted by paging through them. With
+// the v3 API, which never returns the total, they are counted in parallel with the list request.
+//
+// Example:
+//
+//	result, err := table.ListLinks("cl_xxxxxxxxxxxxxx", recordID).
+//		Page(2, 10).
+//		ExecuteWithTotal()
+//	// Handle error
+//	pages := (result.PageInfo.TotalRows + 9) / 10
+func (b *listLinksBuilder) ExecuteWithTotal() (ListResponse, error) {
+	if err := b.validate(); err != nil {
+		return ListResponse{}, err
+	}
+
+	ctx := b.contextProvider.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if b.table.client.apiVersion != APIVersionV3 {
+		response, err := b.fetch(ctx, b.buildQuery())
+		if err != nil {
+			return ListResponse{}, err
+		}
+		if response.PageInfo.TotalRows >= b.paginationProvider.rawOffset+len(response.List) {
+			return response, nil
+		}
+
+		total, err := b.count(ctx)
+		if err != nil {
+			return ListResponse{}, err
+		}
+		response.PageInfo.TotalRows = total
+		return response, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type countResult struct {
+		total int
+		err   error
+	}
+	counted := make(chan countResult, 1)
+	go func() {
+		total, err := b.count(ctx)
+		counted <- countResult{total: total, err: err}
+	}()
+
+	response, err := b.fetch(ctx, b.buildQuery())
+	if err != nil {
+		return ListResponse{}, err
+	}
+
+	count := <-counted
+	if count.err != nil {
+		return ListResponse{}, count.err
+	}
+	response.PageInfo.TotalRows = count.total
+
+	return response, nil
+}
+
+// count counts the linked records matching the query by paging through all of them, from the
+// first one and without sorting them.
+func (b *listLinksBuilder) count(ctx context.Context) (int, error) {
+	c := b.Clone().WithContext(ctx)
+	c.sortProvider.rawSorts = nil
+	c.paginationProvider.rawLimit = 0
+	c.paginationProvider.rawOffset = 0
+
+	total := 0
+	it := c.Iterate()
+	for it.Next() {
+		total++
+	}
+	if err := it.Err(); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
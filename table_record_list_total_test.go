@@ -0,0 +1,142 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestExecuteWithTotal(t *testing.T) {
+	var mu sync.Mutex
+	counts := []string{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/complete/records", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"list":[{"Id":3}],"pageInfo":{"totalRows":40,"page":2,"pageSize":2}}`)
+	})
+	mux.HandleFunc("GET /api/v2/tables/partial/records", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"list":[{"Id":3}],"pageInfo":{"page":2,"pageSize":2}}`)
+	})
+	mux.HandleFunc("GET /api/v2/tables/{table}/records/count", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		counts = append(counts, r.PathValue("table")+" "+r.URL.Query().Get("where"))
+		mu.Unlock()
+		fmt.Fprint(w, `{"count":3}`)
+	})
+	mux.HandleFunc("GET /api/v2/tables/orders/links/cl_items/records/1", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		counts = append(counts, "links "+r.URL.RawQuery)
+		mu.Unlock()
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		items := []string{}
+		for id := offset + 1; id <= min(offset+limit, 5); id++ {
+			items = append(items, fmt.Sprintf(`{"Id":%d}`, id))
+		}
+		fmt.Fprintf(w, `{"list":[%s],"pageInfo":{}}`, strings.Join(items, ","))
+	})
+	mux.HandleFunc("GET /api/v3/data/p_base/users/records", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"records":[{"id":1,"fields":{"Name":"Ana"}}],"next":""}`)
+	})
+	mux.HandleFunc("GET /api/v3/data/p_base/users/count", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		counts = append(counts, "users "+r.URL.Query().Get("where"))
+		mu.Unlock()
+		fmt.Fprint(w, `{"count":21}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	t.Run("uses the total of the response", func(t *testing.T) {
+		counts = counts[:0]
+
+		result, err := client.Table("complete").ListRecords().Limit(2).Offset(2).ExecuteWithTotal()
+		if err != nil {
+			t.Fatalf("ExecuteWithTotal() error = %v", err)
+		}
+		if result.PageInfo.TotalRows != 40 {
+			t.Errorf("TotalRows = %d, want 40", result.PageInfo.TotalRows)
+		}
+		if len(counts) != 0 {
+			t.Errorf("count requests = %v, want none", counts)
+		}
+	})
+
+	t.Run("counts the records when the response has no total", func(t *testing.T) {
+		counts = counts[:0]
+
+		result, err := client.Table("partial").ListRecords().
+			WhereIsEqualTo("Status", "active").
+			SearchAllFields("ana", "Name").
+			Limit(2).
+			Offset(2).
+			ExecuteWithTotal()
+		if err != nil {
+			t.Fatalf("ExecuteWithTotal() error = %v", err)
+		}
+		if result.PageInfo.TotalRows != 3 || len(result.List) != 1 {
+			t.Errorf("ExecuteWithTotal() = %+v, want 1 record of 3", result)
+		}
+
+		want := "partial (Status,eq,active)~and((Name,like,%ana%))"
+		if len(counts) != 1 || counts[0] != want {
+			t.Errorf("count requests = %v, want [%s]", counts, want)
+		}
+	})
+
+	t.Run("counts the linked records by paging through them", func(t *testing.T) {
+		counts = counts[:0]
+
+		result, err := client.Table("orders").ListLinks("cl_items", 1).
+			SortDescBy("Id").
+			Limit(2).
+			Offset(2).
+			ExecuteWithTotal()
+		if err != nil {
+			t.Fatalf("ExecuteWithTotal() error = %v", err)
+		}
+		if result.PageInfo.TotalRows != 5 || len(result.List) != 2 {
+			t.Errorf("ExecuteWithTotal() = %+v, want 2 records of 5", result)
+		}
+
+		want := []string{"links limit=2&offset=2&sort=-Id", "links limit=100&offset=0"}
+		if !slices.Equal(counts, want) {
+			t.Errorf("requests = %v, want %v", counts, want)
+		}
+	})
+
+	t.Run("counts the records in parallel with the v3 API", func(t *testing.T) {
+		counts = counts[:0]
+
+		v3, err := NewClient().
+			WithBaseURL(server.URL).
+			WithAPIToken("test-token").
+			WithAPIVersion(APIVersionV3).
+			WithDefaultBaseID("p_base").
+			Create()
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		result, err := v3.Table("users").ListRecords().WhereIsEqualTo("Name", "Ana").ExecuteWithTotal()
+		if err != nil {
+			t.Fatalf("ExecuteWithTotal() error = %v", err)
+		}
+		if result.PageInfo.TotalRows != 21 || len(result.List) != 1 {
+			t.Errorf("ExecuteWithTotal() = %+v, want 1 record of 21", result)
+		}
+		if len(counts) != 1 || counts[0] != "users (Name,eq,Ana)" {
+			t.Errorf("count requests = %v, want [users (Name,eq,Ana)]", counts)
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package nocodbgo
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// planBuilder is used to build a reconciliation plan with a fluent API
+type planBuilder struct {
+	table       *Table
+	desired     []map[string]any
+	keyColumn   string
+	keepMissing bool
+	chainErr    error // Stores any error in the chain of methods
+
+	contextProvider[*planBuilder]
+	filterProvider[*planBuilder]
+}
+
+// Plan compares the desired records against the current contents of the table and computes the
+// creates, updates and deletes needed to make the table match them, without changing anything.
+//
+// Records are matched by the value of the key column. Desired records without a match are created,
+// matched records with different values are updated (only the changed fields are sent) and current
+// records without a desired counterpart are deleted, unless KeepMissing is used. Filters can be used
+// to restrict the current records taken into account.
+//
+// Parameters:
+//   - records:   The desired records, can be a []map[string]any or a slice of structs with JSON tags that match the table columns.
+//   - keyColumn: The column that uniquely identifies each record (e.g. a code or slug).
+//
+// Example:
+//
+//	plan, err := table.Plan(countries, "Code").Execute()
+//	// Handle error
+//	fmt.Printf("%d to create, %d to update, %d to delete\n", len(plan.Creates), len(plan.Updates), len(plan.Deletes))
+//	err = plan.Apply().Execute()
+func (t *Table) Plan(records any, keyColumn string) *planBuilder {
+	var dataMaps []map[string]any
+	var err error
+
+	switch v := records.(type) {
+	case []map[string]any:
+		dataMaps = v
+	default:
+		dataMaps, err = structsToMaps(records)
+	}
+
+	b := &planBuilder{
+		table:     t,
+		desired:   dataMaps,
+		keyColumn: keyColumn,
+		chainErr:  err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+
+	return b
+}
+
+// KeepMissing prevents the plan from deleting current records that are not in the desired records.
+func (b *planBuilder) KeepMissing() *planBuilder {
+	b.keepMissing = true
+	return b
+}
+
+// Plan is the set of changes needed to make a table match a set of desired records
+type Plan struct {
+	table *Table
+
+	// Creates contains the records that will be created
+	Creates []map[string]any
+	// Updates contains the "Id" and the changed fields of the records that will be updated
+	Updates []map[string]any
+	// Deletes contains the IDs of the records that will be deleted
+	Deletes []RecordID
+}
+
+// IsEmpty reports whether the plan has no changes.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Creates) == 0 && len(p.Updates) == 0 && len(p.Deletes) == 0
+}
+
+// Execute finalizes and executes the operation.
+func (b *planBuilder) Execute() (*Plan, error) {
+	if err := errors.Join(b.chainErr, b.filterProvider.chainErr); err != nil {
+		return nil, fmt.Errorf("error in the chain of methods: %w", err)
+	}
+
+	if b.keyColumn == "" {
+		return nil, fmt.Errorf("%w: key column is required", ErrColumnNotFound)
+	}
+
+	desired, err := normalizeRecords(b.desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize desired records: %w", err)
+	}
+
+	query := b.table.WithDefaults(QueryDefaults{}).ListRecords().WithContext(b.contextProvider.ctx)
+	for _, filter := range b.filterProvider.rawFilters {
+		query = query.Where(filter)
+	}
+
+	current, err := query.ExecuteAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch current records: %w", err)
+	}
+
+	currentByKey := make(map[string]map[string]any, len(current.List))
+	for _, record := range current.List {
+		if key, ok := record[b.keyColumn]; ok && key != nil {
+			currentByKey[fmt.Sprint(key)] = record
+		}
+	}
+
+	plan := &Plan{table: b.table}
+	seen := make(map[string]struct{}, len(desired))
+
+	for i, record := range desired {
+		key, ok := record[b.keyColumn]
+		if !ok || key == nil {
+			return nil, fmt.Errorf("desired record %d has no value for key column %q", i, b.keyColumn)
+		}
+
+		keyStr := fmt.Sprint(key)
+		if _, duplicated := seen[keyStr]; duplicated {
+			return nil, fmt.Errorf("desired record %d has a duplicated key %q", i, keyStr)
+		}
+		seen[keyStr] = struct{}{}
+
+		existing, ok := currentByKey[keyStr]
+		if !ok {
+			create := copyMap(record)
+			delete(create, "Id")
+			plan.Creates = append(plan.Creates, create)
+			continue
+		}
+
+		changes := map[string]any{}
+		for field, value := range record {
+			if field == "Id" {
+				continue
+			}
+			if !recordValuesEqual(existing[field], value) {
+				changes[field] = value
+			}
+		}
+
+		if len(changes) > 0 {
+			changes["Id"] = existing["Id"]
+			plan.Updates = append(plan.Updates, changes)
+		}
+	}
+
+	if !b.keepMissing {
+		for _, record := range current.List {
+			if _, ok := seen[fmt.Sprint(record[b.keyColumn])]; ok {
+				continue
+			}
+			if id, err := parseRecordID(record["Id"]); err == nil && !id.IsZero() {
+				plan.Deletes = append(plan.Deletes, id)
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// applyPlanBuilder is used to apply a reconciliation plan with a fluent API
+type applyPlanBuilder struct {
+	plan *Plan
+
+	contextProvider[*applyPlanBuilder]
+}
+
+// Apply initializes a builder that applies the changes of the plan to the table.
+//
+// Creates are applied first, then updates and finally deletes. The operation is not atomic, if a
+// step fails the previous steps are not reverted.
+func (p *Plan) Apply() *applyPlanBuilder {
+	b := &applyPlanBuilder{
+		plan: p,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *applyPlanBuilder) Execute() error {
+	table := b.plan.table
+	ctx := b.contextProvider.ctx
+
+	if len(b.plan.Creates) > 0 {
+		if _, err := table.CreateRecords(b.plan.Creates).WithContext(ctx).Execute(); err != nil {
+			return fmt.Errorf("failed to apply creates: %w", err)
+		}
+	}
+
+	if len(b.plan.Updates) > 0 {
+		if err := table.UpdateRecords(b.plan.Updates).WithContext(ctx).Execute(); err != nil {
+			return fmt.Errorf("failed to apply updates: %w", err)
+		}
+	}
+
+	if len(b.plan.Deletes) > 0 {
+		if err := table.DeleteRecords(b.plan.Deletes).WithContext(ctx).Execute(); err != nil {
+			return fmt.Errorf("failed to apply deletes: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// recordValuesEqual reports whether a current value of a record is equal to a desired value,
+// considering equal the different representations of checked and unchecked Checkbox fields, where
+// null means unchecked.
+func recordValuesEqual(current any, desired any) bool {
+	if desiredBool, ok := desired.(bool); ok {
+		if current == nil {
+			return !desiredBool
+		}
+		if currentBool, ok := checkboxValue(current); ok {
+			return currentBool == desiredBool
+		}
+	}
+	return reflect.DeepEqual(current, desired)
+}
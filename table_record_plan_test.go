@@ -0,0 +1,68 @@
+package nocodbgo
+
+import (
+	"testing"
+)
+
+func TestPlan(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("countries",
+		map[string]any{"Code": "AR", "Name": "Argentina"},
+		map[string]any{"Code": "BR", "Name": "Brasil"},
+		map[string]any{"Code": "XX", "Name": "Unknown"},
+	)
+	table := client.Table("countries")
+
+	type Country struct {
+		Code string `json:"Code"`
+		Name string `json:"Name"`
+	}
+
+	desired := []Country{
+		{Code: "AR", Name: "Argentina"},
+		{Code: "BR", Name: "Brazil"},
+		{Code: "CL", Name: "Chile"},
+	}
+
+	plan, err := table.Plan(desired, "Code").Execute()
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	if len(plan.Creates) != 1 || plan.Creates[0]["Code"] != "CL" {
+		t.Errorf("Plan() creates = %v, want CL", plan.Creates)
+	}
+	if len(plan.Updates) != 1 || plan.Updates[0]["Name"] != "Brazil" || plan.Updates[0]["Id"] != float64(2) {
+		t.Errorf("Plan() updates = %v, want Brazil with Id 2", plan.Updates)
+	}
+	if _, ok := plan.Updates[0]["Code"]; ok {
+		t.Errorf("Plan() updates include unchanged fields: %v", plan.Updates[0])
+	}
+	if len(plan.Deletes) != 1 || plan.Deletes[0] != IntID(3) {
+		t.Errorf("Plan() deletes = %v, want [3]", plan.Deletes)
+	}
+
+	keepPlan, err := table.Plan(desired, "Code").KeepMissing().Execute()
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if len(keepPlan.Deletes) != 0 {
+		t.Errorf("Plan() with KeepMissing deletes = %v, want none", keepPlan.Deletes)
+	}
+
+	if err := plan.Apply().Execute(); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	replan, err := table.Plan(desired, "Code").Execute()
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if !replan.IsEmpty() {
+		t.Errorf("Plan() after Apply() = %+v, want empty plan", replan)
+	}
+
+	if _, err := table.Plan([]map[string]any{{"Name": "No code"}}, "Code").Execute(); err == nil {
+		t.Error("Plan() with a record without key error = nil, want error")
+	}
+}
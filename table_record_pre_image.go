@@ -0,0 +1,168 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// preImageBuilder is used to build a mutation that returns the state of the records before it,
+// with a fluent API
+type preImageBuilder[T any] struct {
+	read   func(ctx context.Context, header http.Header) (T, error)
+	mutate func(ctx context.Context, header http.Header) error
+
+	contextProvider[*preImageBuilder[T]]
+	headerProvider[*preImageBuilder[T]]
+}
+
+// newPreImageBuilder creates a preImageBuilder that starts with the context and headers of the
+// builder of the mutation.
+func newPreImageBuilder[T any](
+	ctx context.Context,
+	header http.Header,
+	read func(ctx context.Context, header http.Header) (T, error),
+	mutate func(ctx context.Context, header http.Header) error,
+) *preImageBuilder[T] {
+	b := &preImageBuilder[T]{
+		read:   read,
+		mutate: mutate,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.contextProvider.ctx = ctx
+	b.headerProvider = newHeaderProvider(b)
+	b.headerProvider.withHeaders(header)
+
+	return b
+}
+
+// Execute reads the records, then executes the mutation and returns the records as they were
+// before it.
+//
+// The read and the mutation are separate requests, so a change made by someone else between them
+// is not included in the returned records.
+func (b *preImageBuilder[T]) Execute() (T, error) {
+	ctx := b.contextProvider.ctx
+	header := b.headerProvider.rawHeader
+
+	before, err := b.read(ctx, header)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("failed to read pre-image: %w", err)
+	}
+
+	if err := b.mutate(ctx, header); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return before, nil
+}
+
+// WithPreImage makes the update read the record before updating it, so Execute returns the record
+// as it was before the update, for example for audit trails or to undo the update.
+//
+// Example:
+//
+//	before, err := table.UpdateRecord(map[string]any{"Id": 1, "Status": "closed"}).WithPreImage().Execute()
+//	audit.Log("status changed", "from", before.Data["Status"], "to", "closed")
+func (b *updateRecordBuilder) WithPreImage() *preImageBuilder[ReadResponse] {
+	return newPreImageBuilder(b.contextProvider.ctx, b.headerProvider.rawHeader,
+		func(ctx context.Context, header http.Header) (ReadResponse, error) {
+			if b.chainErr != nil {
+				return ReadResponse{}, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+			}
+			recordID := b.recordID()
+			if recordID.IsZero() {
+				return ReadResponse{}, ErrRowIDRequired
+			}
+			return b.table.ReadRecord(recordID).WithContext(ctx).withHeaders(header).Execute()
+		},
+		func(ctx context.Context, header http.Header) error {
+			return b.WithContext(ctx).withHeaders(header).Execute()
+		},
+	)
+}
+
+// WithPreImage makes the update read the records before updating them, so Execute returns the
+// records as they were before the update. Records that don't exist are not included.
+func (b *updateRecordsBuilder) WithPreImage() *preImageBuilder[[]map[string]any] {
+	return newPreImageBuilder(b.contextProvider.ctx, b.headerProvider.rawHeader,
+		func(ctx context.Context, header http.Header) ([]map[string]any, error) {
+			if b.chainErr != nil {
+				return nil, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+			}
+			recordIDs, err := toRecordIDs(b.data)
+			if err != nil {
+				return nil, err
+			}
+			return b.table.readPreImages(ctx, header, recordIDs)
+		},
+		func(ctx context.Context, header http.Header) error {
+			return b.WithContext(ctx).withHeaders(header).Execute()
+		},
+	)
+}
+
+// WithPreImage makes the delete read the record before deleting it, so Execute returns the deleted
+// record.
+//
+// Example:
+//
+//	deleted, err := table.DeleteRecord(1).WithPreImage().Execute()
+//	trash.Save(deleted.Data)
+func (b *deleteRecordBuilder) WithPreImage() *preImageBuilder[ReadResponse] {
+	return newPreImageBuilder(b.contextProvider.ctx, b.headerProvider.rawHeader,
+		func(ctx context.Context, header http.Header) (ReadResponse, error) {
+			if b.recordID.IsZero() {
+				return ReadResponse{}, ErrRowIDRequired
+			}
+			return b.table.ReadRecord(b.recordID).WithContext(ctx).withHeaders(header).Execute()
+		},
+		func(ctx context.Context, header http.Header) error {
+			return b.WithContext(ctx).withHeaders(header).Execute()
+		},
+	)
+}
+
+// WithPreImage makes the delete read the records before deleting them, so Execute returns the
+// deleted records. Records that don't exist are not included.
+func (b *deleteRecordsBuilder) WithPreImage() *preImageBuilder[[]map[string]any] {
+	return newPreImageBuilder(b.contextProvider.ctx, b.headerProvider.rawHeader,
+		func(ctx context.Context, header http.Header) ([]map[string]any, error) {
+			recordIDs, err := toRecordIDs(b.recordIDs)
+			if err != nil {
+				return nil, err
+			}
+			return b.table.readPreImages(ctx, header, recordIDs)
+		},
+		func(ctx context.Context, header http.Header) error {
+			return b.WithContext(ctx).withHeaders(header).Execute()
+		},
+	)
+}
+
+// readPreImages lists the records with the given IDs, with all their fields and ignoring the
+// defaults of the table.
+func (t *Table) readPreImages(ctx context.Context, header http.Header, recordIDs []any) ([]map[string]any, error) {
+	if len(recordIDs) == 0 {
+		return []map[string]any{}, nil
+	}
+
+	ids := make([]string, len(recordIDs))
+	for i, id := range recordIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+
+	response, err := t.WithDefaults(QueryDefaults{}).ListRecords().
+		WithContext(ctx).
+		withHeaders(header).
+		WhereIsIn(SystemFieldID, ids...).
+		ExecuteAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return response.List, nil
+}
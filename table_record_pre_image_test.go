@@ -0,0 +1,55 @@
+package nocodbgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithPreImage(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("users",
+		map[string]any{"Name": "Ana", "Status": "open"},
+		map[string]any{"Name": "Bob", "Status": "open"},
+		map[string]any{"Name": "Cid", "Status": "open"},
+	)
+	table := client.Table("users").WithDefaults(QueryDefaults{Fields: []string{"Name"}})
+
+	before, err := table.UpdateRecord(map[string]any{"Id": 1, "Status": "closed"}).WithPreImage().Execute()
+	if err != nil {
+		t.Fatalf("UpdateRecord().WithPreImage() error = %v", err)
+	}
+	if before.Data["Status"] != "open" || fake.Records("users")[0]["Status"] != "closed" {
+		t.Errorf("UpdateRecord().WithPreImage() = %v, want the record before the update", before.Data)
+	}
+
+	updated, err := table.UpdateRecords([]map[string]any{{"Id": 1, "Status": "open"}, {"Id": 2, "Status": "closed"}}).WithPreImage().Execute()
+	if err != nil {
+		t.Fatalf("UpdateRecords().WithPreImage() error = %v", err)
+	}
+	if len(updated) != 2 || updated[0]["Status"] != "closed" || updated[1]["Status"] != "open" {
+		t.Errorf("UpdateRecords().WithPreImage() = %v, want the records before the update", updated)
+	}
+
+	deleted, err := table.DeleteRecord(3).WithPreImage().Execute()
+	if err != nil {
+		t.Fatalf("DeleteRecord().WithPreImage() error = %v", err)
+	}
+	if deleted.Data["Name"] != "Cid" || len(fake.Records("users")) != 2 {
+		t.Errorf("DeleteRecord().WithPreImage() = %v, want the deleted record", deleted.Data)
+	}
+
+	deletedAll, err := table.DeleteRecords([]int{1, 2}).WithPreImage().Execute()
+	if err != nil {
+		t.Fatalf("DeleteRecords().WithPreImage() error = %v", err)
+	}
+	if len(deletedAll) != 2 || deletedAll[0]["Status"] != "open" || len(fake.Records("users")) != 0 {
+		t.Errorf("DeleteRecords().WithPreImage() = %v, want the two deleted records", deletedAll)
+	}
+
+	if _, err := table.DeleteRecord(3).WithPreImage().Execute(); err == nil {
+		t.Error("DeleteRecord().WithPreImage() of a missing record error = nil, want an error")
+	}
+	if _, err := table.UpdateRecord(map[string]any{"Status": "open"}).WithPreImage().Execute(); !errors.Is(err, ErrRowIDRequired) {
+		t.Errorf("UpdateRecord().WithPreImage() without Id error = %v, want %v", err, ErrRowIDRequired)
+	}
+}
@@ -1,7 +1,6 @@
 package nocodbgo
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -10,24 +9,30 @@ import (
 // readRecordBuilder is used to build a read query with a fluent API
 type readRecordBuilder struct {
 	table    *Table
-	recordID int
+	recordID RecordID
+	chainErr error // Stores any error in the chain of methods
 
 	contextProvider[*readRecordBuilder]
 	fieldProvider[*readRecordBuilder]
+	headerProvider[*readRecordBuilder]
 }
 
 // ReadRecord reads a single record from the table.
 //
 // Parameters:
-//   - recordID: The identifier of the record to read.
-func (t *Table) ReadRecord(recordID int) *readRecordBuilder {
+//   - recordID: The identifier of the record to read, can be a RecordID, any integer type or a string.
+func (t *Table) ReadRecord(recordID any) *readRecordBuilder {
+	id, err := parseRecordID(recordID)
+
 	b := &readRecordBuilder{
 		table:    t,
-		recordID: recordID,
+		recordID: id,
+		chainErr: err,
 	}
 
 	b.contextProvider = newContextProvider(b)
 	b.fieldProvider = newFieldProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
@@ -47,23 +52,50 @@ func (r ReadResponse) DecodeInto(dest any) error {
 
 // Execute finalizes and executes the operation.
 func (b *readRecordBuilder) Execute() (ReadResponse, error) {
-	if b.recordID == 0 {
+	if b.chainErr != nil {
+		return ReadResponse{}, fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
 		return ReadResponse{}, ErrRowIDRequired
 	}
 
+	// Reads with their own headers can't share a batched request
+	if b.table.readLoader != nil && len(b.headerProvider.rawHeader) == 0 {
+		data, err := b.table.readLoader.load(b.contextProvider.ctx, b.recordID, b.fieldProvider.fields())
+		if err != nil {
+			return ReadResponse{}, withOperation(fmt.Errorf("failed to read record: %w", err), "ReadRecord", b.table.tableID, b.recordID)
+		}
+		if b.fieldProvider.excludeSystemFields {
+			// The loader shares the record between all the reads of the same ID
+			data = copyMap(data)
+			b.fieldProvider.stripSystemFields(data)
+		}
+		return ReadResponse{Data: data}, nil
+	}
+
+	renames, err := b.table.columnRenames(b.contextProvider.ctx)
+	if err != nil {
+		return ReadResponse{}, err
+	}
 	query := url.Values{}
-	query = b.fieldProvider.apply(query)
+	query = renames.query(b.fieldProvider.apply(query))
 
-	path := fmt.Sprintf("/api/v2/tables/%s/records/%d", b.table.tableID, b.recordID)
-	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, query)
+	path, err := b.table.recordPath(b.recordID)
 	if err != nil {
-		return ReadResponse{}, fmt.Errorf("failed to read record: %w", err)
+		return ReadResponse{}, err
+	}
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, path, nil, query, b.headerProvider.rawHeader)
+	if err != nil {
+		return ReadResponse{}, withOperation(fmt.Errorf("failed to read record: %w", err), "ReadRecord", b.table.tableID, b.recordID)
 	}
 
-	var response map[string]any
-	if err := json.Unmarshal(respBody, &response); err != nil {
+	response, err := b.table.decodeRecord(respBody)
+	if err != nil {
 		return ReadResponse{}, fmt.Errorf("failed to unmarshal read response: %w", err)
 	}
+	b.fieldProvider.stripSystemFields(response)
+	renames.addOldTitles(response)
 
 	return ReadResponse{Data: response}, nil
 }
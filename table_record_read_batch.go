@@ -0,0 +1,167 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultReadBatchWindow is the default time a read waits for other reads to join its batch
+	defaultReadBatchWindow = 5 * time.Millisecond
+
+	// maxReadBatchSize is the maximum number of records fetched by a single batched read
+	maxReadBatchSize = 100
+)
+
+// WithReadBatching returns a copy of the table where concurrent ReadRecord calls are coalesced.
+//
+// Reads made within the given window are grouped into a single list request filtered by the record
+// IDs and the results are fanned back out to each caller, which avoids N+1 request storms when many
+// goroutines (e.g. web handlers) read records from the same table at the same time. Batches are sent
+// earlier when they reach 100 records, and reads that return different fields are batched separately.
+//
+// When a batched read doesn't find its record, it returns an error that wraps ErrRecordNotFound. Reads
+// with headers set with WithHeader are not batched.
+//
+// Parameters:
+//   - window: The time a read waits for other reads to join its batch. If zero or negative, it defaults to 5ms.
+//
+// Example:
+//
+//	users := client.Table("users").WithReadBatching(10 * time.Millisecond)
+//	// Concurrent reads are sent as a single request
+//	result, err := users.ReadRecord(1).Execute()
+func (t *Table) WithReadBatching(window time.Duration) *Table {
+	if window <= 0 {
+		window = defaultReadBatchWindow
+	}
+
+	// Batched reads must find the same records as single reads, which ignore the defaults
+	unbatched := *t
+	unbatched.readLoader = nil
+	unbatched.defaults = QueryDefaults{}
+
+	batched := *t
+	batched.readLoader = &recordLoader{
+		table:   &unbatched,
+		window:  window,
+		batches: map[string]*recordBatch{},
+	}
+
+	return &batched
+}
+
+// recordLoader coalesces concurrent record reads into batched list requests
+type recordLoader struct {
+	table  *Table
+	window time.Duration
+
+	mu sync.Mutex
+	// batches holds the pending batches by the fields they return
+	batches map[string]*recordBatch
+}
+
+// recordBatch is a pending group of reads that return the same fields
+type recordBatch struct {
+	fields  []string
+	waiters map[string][]chan recordLoadResult // Keyed by the string form of the record ID
+}
+
+// recordLoadResult is the result of a batched read delivered to a waiting caller
+type recordLoadResult struct {
+	data map[string]any
+	err  error
+}
+
+// load adds the read of the record to a pending batch and waits for its result or for the context
+// to be done.
+func (l *recordLoader) load(ctx context.Context, recordID RecordID, fields []string) (map[string]any, error) {
+	key := strings.Join(fields, ",")
+	result := make(chan recordLoadResult, 1)
+
+	l.mu.Lock()
+	batch, ok := l.batches[key]
+	if !ok {
+		batch = &recordBatch{
+			fields:  fields,
+			waiters: map[string][]chan recordLoadResult{},
+		}
+		l.batches[key] = batch
+		go func() {
+			<-l.table.client.clock.After(l.window)
+			l.dispatch(key, batch)
+		}()
+	}
+	batch.waiters[recordID.String()] = append(batch.waiters[recordID.String()], result)
+	full := len(batch.waiters) >= maxReadBatchSize
+	l.mu.Unlock()
+
+	if full {
+		go l.dispatch(key, batch)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-result:
+		return r.data, r.err
+	}
+}
+
+// dispatch removes the batch from the pending batches and fetches its records. It does nothing if
+// the batch has already been dispatched.
+func (l *recordLoader) dispatch(key string, batch *recordBatch) {
+	l.mu.Lock()
+	if l.batches[key] != batch {
+		l.mu.Unlock()
+		return
+	}
+	delete(l.batches, key)
+	l.mu.Unlock()
+
+	ids := make([]string, 0, len(batch.waiters))
+	for id := range batch.waiters {
+		ids = append(ids, id)
+	}
+
+	// The batch is shared by several callers, so it isn't bound to any of their contexts. It's
+	// sorted by the filtered Id, which makes the pages stable without reading the schema
+	query := l.table.WithDefaults(QueryDefaults{}).ListRecords().WhereIsIn("Id", ids...).SortAscBy("Id")
+	if len(batch.fields) > 0 {
+		query = query.ReturnFields(append([]string{"Id"}, batch.fields...)...)
+	}
+
+	response, err := query.ExecuteAll()
+	if err != nil {
+		for _, waiters := range batch.waiters {
+			for _, waiter := range waiters {
+				waiter <- recordLoadResult{err: err}
+			}
+		}
+		return
+	}
+
+	records := make(map[string]map[string]any, len(response.List))
+	for _, record := range response.List {
+		if id, err := parseRecordID(record["Id"]); err == nil {
+			records[id.String()] = record
+		}
+	}
+
+	for id, waiters := range batch.waiters {
+		record, ok := records[id]
+		for i, waiter := range waiters {
+			switch {
+			case !ok:
+				waiter <- recordLoadResult{err: fmt.Errorf("%w: record %s", ErrRecordNotFound, id)}
+			case i == 0:
+				waiter <- recordLoadResult{data: record}
+			default:
+				waiter <- recordLoadResult{data: copyMap(record)}
+			}
+		}
+	}
+}
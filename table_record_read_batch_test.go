@@ -0,0 +1,79 @@
+package nocodbgo
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+// countingTransport counts the requests sent through it
+type countingTransport struct {
+	next     http.RoundTripper
+	requests atomic.Int32
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+	return c.next.RoundTrip(req)
+}
+
+func TestWithReadBatching(t *testing.T) {
+	fake := nocodbgotest.New()
+	ids := fake.Seed("users",
+		map[string]any{"Name": "Ana"},
+		map[string]any{"Name": "Bob"},
+		map[string]any{"Name": "Cid"},
+	)
+
+	transport := &countingTransport{next: fake.HTTPClient().Transport}
+	client, err := NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(&http.Client{Transport: transport}).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	users := client.Table("users").WithReadBatching(50 * time.Millisecond)
+	readIDs := []int64{ids[0], ids[1], ids[2], ids[1], 999}
+	results := make([]ReadResponse, len(readIDs))
+	errs := make([]error, len(readIDs))
+
+	var wg sync.WaitGroup
+	for i, id := range readIDs {
+		wg.Add(1)
+		go func(i int, id int64) {
+			defer wg.Done()
+			results[i], errs[i] = users.ReadRecord(id).Execute()
+		}(i, id)
+	}
+	wg.Wait()
+
+	if got := transport.requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+
+	wantNames := []string{"Ana", "Bob", "Cid", "Bob"}
+	for i, want := range wantNames {
+		if errs[i] != nil {
+			t.Fatalf("ReadRecord(%d) error = %v", readIDs[i], errs[i])
+		}
+		if results[i].Data["Name"] != want {
+			t.Errorf("ReadRecord(%d) Name = %v, want %v", readIDs[i], results[i].Data["Name"], want)
+		}
+	}
+
+	if !errors.Is(errs[4], ErrRecordNotFound) {
+		t.Errorf("ReadRecord(999) error = %v, want ErrRecordNotFound", errs[4])
+	}
+
+	if client.Table("users").readLoader != nil {
+		t.Error("WithReadBatching() modified the original table")
+	}
+}
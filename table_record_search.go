@@ -0,0 +1,113 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// searchableColumnTypes are the column UI data types searched by SearchAllFields when no columns
+// are given
+var searchableColumnTypes = []ColumnType{ColumnTypeSingleLineText, ColumnTypeLongText, ColumnTypeEmail, ColumnTypeURL, ColumnTypePhoneNumber, "RichText"}
+
+// recordSearch is the search of SearchAllFields
+type recordSearch struct {
+	term string
+	// columns are the searched columns, read from the schema when executed if empty
+	columns []string
+}
+
+// filter returns the search as a filter expression of the like comparisons combined with OR.
+func (s *recordSearch) filter() string {
+	pattern := "%" + likeEscaper.Replace(s.term) + "%"
+	comparisons := make([]string, len(s.columns))
+	for i, column := range s.columns {
+		comparisons[i] = fmt.Sprintf("(%s,like,%s)", column, pattern)
+	}
+	return "(" + strings.Join(comparisons, "~or") + ")"
+}
+
+// apply adds the search to the "where" query parameter, combined with the other filters.
+func (s *recordSearch) apply(query url.Values) url.Values {
+	if s == nil || query == nil || len(s.columns) == 0 {
+		return query
+	}
+
+	filter := s.filter()
+	if where := query.Get("where"); where != "" {
+		filter = where + "~and" + filter
+	}
+	query.Set("where", filter)
+	return query
+}
+
+// SearchAllFields matches the records where any of the given columns contains the search term,
+// giving a simple search box over a table. It's combined with the other filters of the query.
+//
+// The comparison uses the like operator, which is case-insensitive in NocoDB, and the "%" and "_"
+// wildcards in the term match literally like in WhereContains, which doesn't work on bases stored
+// in SQLite. An empty term matches all records, and a term with any of the "(", ")", "," and "~"
+// characters of the filter syntax is an ErrInvalidQuery, because NocoDB can't escape them.
+//
+// If no columns are given, the text columns of the table (single line and long text, email, URL
+// and phone number) are read from the table schema when the query is executed or rendered by
+// Query.
+//
+// Example:
+//
+//	// Search the term typed by the user in the name and email of the customers
+//	result, err := customers.ListRecords().
+//		SearchAllFields(searchBox, "Name", "Email").
+//		WhereIsEqualTo("Status", "active").
+//		Execute()
+//
+//	// Search in all the text columns of the table
+//	result, err := customers.ListRecords().SearchAllFields(searchBox).Execute()
+func (b *listRecordsBuilder) SearchAllFields(term string, columns ...string) *listRecordsBuilder {
+	if term == "" {
+		b.search = nil
+		return b
+	}
+
+	// NocoDB has no way to escape the characters that delimit the filter syntax, and a term with
+	// them would end the comparison early or add other ones
+	if strings.ContainsAny(term, "(),~") {
+		return b.filterProvider.addErr(fmt.Errorf("%w: search term %q contains characters of the filter syntax", ErrInvalidQuery, term))
+	}
+
+	for _, column := range columns {
+		if strings.TrimSpace(column) == "" || strings.Contains(column, ",") {
+			return b.filterProvider.addErr(fmt.Errorf("%w: invalid column name %q in search", ErrInvalidQuery, column))
+		}
+	}
+
+	b.search = &recordSearch{term: term, columns: slices.Clone(columns)}
+	return b
+}
+
+// resolveSearch reads the text columns of the table for a search without columns.
+func (b *listRecordsBuilder) resolveSearch(ctx context.Context) error {
+	if b.search == nil || len(b.search.columns) > 0 {
+		return nil
+	}
+
+	schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to read table schema: %w", err)
+	}
+
+	columns := []string{}
+	for _, column := range schema.Columns {
+		if slices.Contains(searchableColumnTypes, column.UIDT) && !strings.Contains(column.Title, ",") {
+			columns = append(columns, column.Title)
+		}
+	}
+	if len(columns) == 0 {
+		return fmt.Errorf("%w: the table has no text columns to search", ErrColumnNotFound)
+	}
+
+	b.search.columns = columns
+	return nil
+}
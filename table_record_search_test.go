@@ -0,0 +1,97 @@
+package nocodbgo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestSearchAllFields(t *testing.T) {
+	fake := nocodbgotest.New()
+	fake.Seed("customers",
+		map[string]any{"Name": "Ana", "Email": "ana@acme.com", "Notes": "100% loyal", "Age": 30},
+		map[string]any{"Name": "Bob", "Email": "bob@example.com", "Notes": "new", "Age": 40},
+		map[string]any{"Name": "Cid", "Email": "cid@example.com", "Notes": "likes Acme", "Age": 50},
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/customers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"customers","title":"Customers","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":true},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText"},
+			{"id":"cl_email","title":"Email","uidt":"Email"},
+			{"id":"cl_notes","title":"Notes","uidt":"LongText"},
+			{"id":"cl_age","title":"Age","uidt":"Number"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/numbers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"numbers","title":"Numbers","columns":[{"id":"cl_id","title":"Id","uidt":"ID","pk":true}]}`))
+	})
+	mux.Handle("/", fake)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	customers := client.Table("customers")
+
+	names := func(t *testing.T, query *listRecordsBuilder) []string {
+		t.Helper()
+		result, err := query.SortAscBy("Name").Execute()
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		names := []string{}
+		for _, record := range result.List {
+			names = append(names, record["Name"].(string))
+		}
+		return names
+	}
+
+	// The rendered query has the columns read from the schema
+	rendered, err := customers.ListRecords().SearchAllFields("acme").Query()
+	if want := "((Name,like,%acme%)~or(Email,like,%acme%)~or(Notes,like,%acme%))"; err != nil || rendered.Query.Get("where") != want {
+		t.Errorf("Query() where = %q, %v, want %q", rendered.Query.Get("where"), err, want)
+	}
+
+	query := customers.ListRecords().SearchAllFields("acme", "Name", "Email").WhereIsLessThan("Age", "45")
+	if got, want := query.buildQuery().Get("where"), "(Age,lt,45)~and((Name,like,%acme%)~or(Email,like,%acme%))"; got != want {
+		t.Errorf("where = %q, want %q", got, want)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		query *listRecordsBuilder
+		want  []string
+	}{
+		{name: "given columns", query: customers.ListRecords().SearchAllFields("ACME", "Name", "Email"), want: []string{"Ana"}},
+		{name: "schema columns", query: customers.ListRecords().SearchAllFields("acme"), want: []string{"Ana", "Cid"}},
+		{name: "combined with filters", query: customers.ListRecords().SearchAllFields("acme").WhereIsGreaterThan("Age", "45"), want: []string{"Cid"}},
+		{name: "literal wildcards", query: customers.ListRecords().SearchAllFields("0%"), want: []string{"Ana"}},
+		{name: "empty term", query: customers.ListRecords().SearchAllFields(""), want: []string{"Ana", "Bob", "Cid"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(t, tt.query); !slices.Equal(got, tt.want) {
+				t.Errorf("Execute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := client.Table("numbers").ListRecords().SearchAllFields("acme").Execute(); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrColumnNotFound)
+	}
+	for _, term := range []string{"a)~or(Age,gt,0", "Smith, John", "(acme"} {
+		if _, err := customers.ListRecords().SearchAllFields(term, "Name").Execute(); !errors.Is(err, ErrInvalidQuery) {
+			t.Errorf("SearchAllFields(%q) error = %v, want %v", term, err, ErrInvalidQuery)
+		}
+	}
+	if _, err := customers.ListRecords().SearchAllFields("acme").Spec(); !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("Spec() error = %v, want %v", err, ErrInvalidQuery)
+	}
+}
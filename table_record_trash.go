@@ -0,0 +1,189 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TrashedRecord is a deleted record kept in the trash of the table, which can be restored
+type TrashedRecord struct {
+	// ID is the identifier the record had, and has again once restored
+	ID RecordID `json:"id"`
+	// DeletedAt is when the record was deleted
+	DeletedAt Timestamp `json:"deleted_at"`
+	// DeletedBy is the email of the user who deleted the record, if known
+	DeletedBy string `json:"deleted_by"`
+	// Data are the fields the record had when it was deleted
+	Data map[string]any `json:"data"`
+}
+
+// trashPath returns the path of the endpoint of the record trash of the table. The trash is only
+// available through the v2 data API.
+//
+// The trash endpoints are not part of the documented NocoDB API, so the servers that don't have
+// them answer 404 to every request, which is reported as ErrTrashNotSupported.
+func (t *Table) trashPath() (string, error) {
+	if t.client.apiVersion != APIVersionV2 {
+		return "", fmt.Errorf("%w: the record trash requires the v2 data API", ErrTrashNotSupported)
+	}
+	return fmt.Sprintf("/api/v2/tables/%s/trash", t.tableID), nil
+}
+
+// trashNotSupported converts the errors of the servers without the record trash, which don't
+// know its endpoints, into ErrTrashNotSupported.
+func trashNotSupported(err error) error {
+	var opErr *OperationError
+	if errors.As(err, &opErr) && (opErr.StatusCode == http.StatusMethodNotAllowed || opErr.StatusCode == http.StatusNotImplemented) {
+		return fmt.Errorf("%w: %w", ErrTrashNotSupported, err)
+	}
+	return err
+}
+
+// listTrashBuilder is used to build a query of the records in the trash with a fluent API
+type listTrashBuilder struct {
+	table *Table
+
+	contextProvider[*listTrashBuilder]
+	paginationProvider[*listTrashBuilder]
+	headerProvider[*listTrashBuilder]
+}
+
+// ListTrash initializes a builder that lists the deleted records kept in the trash of the table,
+// most recently deleted first, on the NocoDB versions that keep deleted records.
+//
+// On servers without the record trash, Execute returns an error that wraps ErrTrashNotSupported.
+//
+// Example:
+//
+//	trashed, err := table.ListTrash().Limit(50).Execute()
+//	// Handle error
+//	for _, record := range trashed {
+//		fmt.Println(record.ID, record.DeletedAt, record.Data["Name"])
+//	}
+func (t *Table) ListTrash() *listTrashBuilder {
+	b := &listTrashBuilder{
+		table: t,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.paginationProvider = newPaginationProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listTrashBuilder) Execute() ([]TrashedRecord, error) {
+	if b.paginationProvider.chainErr != nil {
+		return nil, fmt.Errorf("error in the chain of methods: %w", b.paginationProvider.chainErr)
+	}
+
+	path, err := b.table.trashPath()
+	if err != nil {
+		return nil, err
+	}
+
+	query := b.paginationProvider.apply(url.Values{})
+	respBody, err := b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, path, nil, query, b.headerProvider.rawHeader)
+	if err != nil {
+		var opErr *OperationError
+		if errors.As(err, &opErr) && opErr.StatusCode == http.StatusNotFound {
+			err = fmt.Errorf("%w: %w", ErrTrashNotSupported, err)
+		}
+		return nil, withOperation(fmt.Errorf("failed to list trash: %w", trashNotSupported(err)), "ListTrash", b.table.tableID, RecordID{})
+	}
+
+	var response struct {
+		List []TrashedRecord `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trash response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// restoreRecordBuilder is used to build the restore of a deleted record with a fluent API
+type restoreRecordBuilder struct {
+	table    *Table
+	recordID RecordID
+	chainErr error // Stores any error in the chain of methods
+
+	contextProvider[*restoreRecordBuilder]
+	headerProvider[*restoreRecordBuilder]
+}
+
+// RestoreRecord initializes a builder that restores a deleted record from the trash of the table,
+// with its ID and fields, on the NocoDB versions that keep deleted records, so an accidental
+// deletion can be undone.
+//
+// It returns an error that wraps ErrTrashNotSupported on servers without the record trash, and
+// one that wraps ErrRecordNotFound if the trash exists but the record is not in it. Since both
+// are answered with a 404, the trash is listed to tell them apart.
+//
+// Parameters:
+//   - recordID: The identifier of the deleted record, can be a RecordID, any integer type or a string.
+//
+// Example:
+//
+//	err := table.DeleteRecord(recordID).Execute()
+//	// Undo the deletion
+//	err = table.RestoreRecord(recordID).Execute()
+func (t *Table) RestoreRecord(recordID any) *restoreRecordBuilder {
+	id, err := parseRecordID(recordID)
+
+	b := &restoreRecordBuilder{
+		table:    t,
+		recordID: id,
+		chainErr: err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *restoreRecordBuilder) Execute() error {
+	if b.chainErr != nil {
+		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
+		return ErrRowIDRequired
+	}
+
+	path, err := b.table.trashPath()
+	if err != nil {
+		return err
+	}
+
+	path = fmt.Sprintf("%s/%s/restore", path, url.PathEscape(b.recordID.String()))
+	_, err = b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodPost, path, nil, nil, b.headerProvider.rawHeader)
+	if err != nil {
+		var opErr *OperationError
+		if errors.As(err, &opErr) && opErr.StatusCode == http.StatusNotFound {
+			err = b.notFound(err)
+		}
+		return withOperation(fmt.Errorf("failed to restore record: %w", trashNotSupported(err)), "RestoreRecord", b.table.tableID, b.recordID)
+	}
+
+	return nil
+}
+
+// notFound tells whether a 404 of the restore endpoint is because the record is not in the trash
+// or because the server has no trash, by listing the trash.
+func (b *restoreRecordBuilder) notFound(err error) error {
+	_, listErr := b.table.ListTrash().WithContext(b.contextProvider.ctx).withHeaders(b.headerProvider.rawHeader).Limit(1).Execute()
+	switch {
+	case listErr == nil:
+		return fmt.Errorf("%w: record %s is not in the trash: %w", ErrRecordNotFound, b.recordID, err)
+	case errors.Is(listErr, ErrTrashNotSupported):
+		return fmt.Errorf("%w: %w", ErrTrashNotSupported, err)
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package nocodbgo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTrash(t *testing.T) {
+	var restored []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/tables/users/trash", func(w http.ResponseWriter, r *http.Request) {
+		// RestoreRecord lists a single record to check that the trash exists
+		if limit := r.URL.Query().Get("limit"); limit != "10" && limit != "1" {
+			t.Errorf("limit = %q, want 10 or 1", limit)
+		}
+		_, _ = w.Write([]byte(`{"list":[{"id":7,"deleted_at":"2024-03-04 05:06:07+00:00","deleted_by":"ana@example.com","data":{"Id":7,"Name":"Bob"}}]}`))
+	})
+	mux.HandleFunc("POST /api/v2/tables/users/trash/{recordId}/restore", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("recordId") != "7" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"msg":"Record not found"}`))
+			return
+		}
+		restored = append(restored, r.PathValue("recordId"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	trashed, err := client.Table("users").ListTrash().Limit(10).Execute()
+	if err != nil {
+		t.Fatalf("ListTrash() error = %v", err)
+	}
+	if len(trashed) != 1 || trashed[0].ID != IntID(7) || trashed[0].DeletedBy != "ana@example.com" || trashed[0].Data["Name"] != "Bob" || trashed[0].DeletedAt.Year() != 2024 {
+		t.Errorf("ListTrash() = %+v", trashed)
+	}
+
+	if err := client.Table("users").RestoreRecord(7).Execute(); err != nil || len(restored) != 1 {
+		t.Errorf("RestoreRecord() error = %v, restored = %v", err, restored)
+	}
+	if err := client.Table("users").RestoreRecord(8).Execute(); !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("RestoreRecord() error = %v, want %v", err, ErrRecordNotFound)
+	}
+
+	if _, err := client.Table("orders").ListTrash().Execute(); !errors.Is(err, ErrTrashNotSupported) {
+		t.Errorf("ListTrash() error = %v, want %v", err, ErrTrashNotSupported)
+	}
+	// Without the trash endpoints the 404 of the restore is not a missing record
+	if err := client.Table("orders").RestoreRecord(7).Execute(); !errors.Is(err, ErrTrashNotSupported) || errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("RestoreRecord() error = %v, want %v", err, ErrTrashNotSupported)
+	}
+
+	v1Client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").WithV1DataAPI("p_base").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := v1Client.Table("users").RestoreRecord(7).Execute(); !errors.Is(err, ErrTrashNotSupported) {
+		t.Errorf("RestoreRecord() error = %v, want %v", err, ErrTrashNotSupported)
+	}
+}
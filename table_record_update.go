@@ -3,15 +3,18 @@ package nocodbgo
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // updateRecordBuilder is used to build an update query with a fluent API
 type updateRecordBuilder struct {
-	table    *Table
-	data     map[string]any
-	chainErr error // Stores any error in the chain of methods
+	table             *Table
+	data              map[string]any
+	ifUnmodifiedSince *time.Time
+	chainErr          error // Stores any error in the chain of methods
 
 	contextProvider[*updateRecordBuilder]
+	headerProvider[*updateRecordBuilder]
 }
 
 // UpdateRecord updates a single record in the table.
@@ -21,7 +24,7 @@ type updateRecordBuilder struct {
 //
 // Notes:
 //   - The "data" parameter must contain an "Id" field to identify which record to update.
-//   - It will update the fields that are present in the "data" parameter even if they are zero values, so if you want to update a single field, you can use a map.
+//   - It will update the fields that are present in the "data" parameter even if they are zero values, so if you want to update a single field, you can use a map or a struct with Optional fields.
 func (t *Table) UpdateRecord(data any) *updateRecordBuilder {
 	var dataMap map[string]any
 	var err error
@@ -40,22 +43,49 @@ func (t *Table) UpdateRecord(data any) *updateRecordBuilder {
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
 
+// IfUnmodifiedSince makes the update fail with ErrConflict if the record has been modified after
+// the given time, to prevent overwriting the changes of concurrent editors.
+//
+// The "UpdatedAt" system field of the record is read before updating it, so the check is not
+// atomic and a concurrent change made between the read and the update is not detected. Records
+// that were never updated are considered unmodified.
+//
+// Example:
+//
+//	// updatedAt is the UpdatedAt value of the record when it was read to be edited
+//	err := table.UpdateRecord(record).IfUnmodifiedSince(updatedAt).Execute()
+//	if errors.Is(err, nocodbgo.ErrConflict) {
+//		// Reload the record and ask the user to apply the changes again
+//	}
+func (b *updateRecordBuilder) IfUnmodifiedSince(updatedAt time.Time) *updateRecordBuilder {
+	b.ifUnmodifiedSince = &updatedAt
+	return b
+}
+
 // Execute finalizes and executes the operation.
 func (b *updateRecordBuilder) Execute() error {
 	if b.chainErr != nil {
 		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
 	}
 
+	if b.ifUnmodifiedSince != nil {
+		if err := b.checkUnmodified(); err != nil {
+			return withOperation(fmt.Errorf("failed to update record: %w", err), "UpdateRecord", b.table.tableID, b.recordID())
+		}
+	}
+
 	err := b.table.
 		UpdateRecords([]map[string]any{b.data}).
 		WithContext(b.contextProvider.ctx).
+		withHeaders(b.headerProvider.rawHeader).
 		Execute()
 	if err != nil {
-		return fmt.Errorf("failed to update record: %w", err)
+		return withOperation(fmt.Errorf("failed to update record: %w", err), "UpdateRecord", b.table.tableID, b.recordID())
 	}
 
 	return nil
@@ -68,6 +98,7 @@ type updateRecordsBuilder struct {
 	chainErr error // Stores any error in the chain of methods
 
 	contextProvider[*updateRecordsBuilder]
+	headerProvider[*updateRecordsBuilder]
 }
 
 // UpdateRecords updates multiple records in the table.
@@ -77,7 +108,7 @@ type updateRecordsBuilder struct {
 //
 // Notes:
 //   - Each record in the "data" parameter must have an "Id" field to identify which record to update.
-//   - It will update the fields that are present in the "data" parameter even if they are zero values, so if you want to update a single field, you can use a map.
+//   - It will update the fields that are present in the "data" parameter even if they are zero values, so if you want to update a single field, you can use a map or a struct with Optional fields.
 func (t *Table) UpdateRecords(data any) *updateRecordsBuilder {
 	var dataMaps []map[string]any
 	var err error
@@ -96,6 +127,7 @@ func (t *Table) UpdateRecords(data any) *updateRecordsBuilder {
 	}
 
 	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
 
 	return b
 }
@@ -106,10 +138,59 @@ func (b *updateRecordsBuilder) Execute() error {
 		return fmt.Errorf("error in the chain of methods: %w", b.chainErr)
 	}
 
-	path := fmt.Sprintf("/api/v2/tables/%s/records", b.table.tableID)
-	_, err := b.table.client.request(b.contextProvider.ctx, http.MethodPatch, path, b.data, nil)
+	if err := b.table.client.checkMutation(MutationInfo{Operation: "UpdateRecords", TableID: b.table.tableID, Records: len(b.data)}); err != nil {
+		return withOperation(fmt.Errorf("failed to update records: %w", err), "UpdateRecords", b.table.tableID, RecordID{})
+	}
+
+	path, err := b.table.recordsPath(true)
+	if err != nil {
+		return err
+	}
+	renames, err := b.table.columnRenames(b.contextProvider.ctx)
+	if err != nil {
+		return err
+	}
+	records := renames.records(b.table.stampUpdated(b.data))
+	_, err = b.table.client.requestWithHeader(b.contextProvider.ctx, http.MethodPatch, path, b.table.encodeRecords(records), nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return withOperation(fmt.Errorf("failed to update records: %w", err), "UpdateRecords", b.table.tableID, RecordID{})
+	}
+
+	return nil
+}
+
+// recordID returns the identifier of the record to update, 0 if it's missing or not an integer.
+func (b *updateRecordBuilder) recordID() RecordID {
+	id, _ := parseRecordID(b.data)
+	return id
+}
+
+// checkUnmodified reads the "UpdatedAt" system field of the record and returns ErrConflict if the
+// record has been modified after the expected time.
+func (b *updateRecordBuilder) checkUnmodified() error {
+	id, err := parseRecordID(b.data)
 	if err != nil {
-		return fmt.Errorf("failed to update records: %w", err)
+		return err
+	}
+
+	current, err := b.table.ReadRecord(id).
+		WithContext(b.contextProvider.ctx).
+		withHeaders(b.headerProvider.rawHeader).
+		ReturnFields(SystemFieldID, SystemFieldUpdatedAt).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	var record struct {
+		UpdatedAt Timestamp `json:"UpdatedAt"`
+	}
+	if err := current.DecodeInto(&record); err != nil {
+		return err
+	}
+
+	if record.UpdatedAt.After(*b.ifUnmodifiedSince) {
+		return fmt.Errorf("%w: record %v was updated at %s", ErrConflict, id, record.UpdatedAt.Format(time.RFC3339))
 	}
 
 	return nil
@@ -0,0 +1,31 @@
+package nocodbgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUpdateRecordIfUnmodifiedSince(t *testing.T) {
+	client, fake := newFakeClient(t)
+	ids := fake.Seed("users", map[string]any{"Name": "Ana"})
+	users := client.Table("users")
+
+	err := users.UpdateRecord(map[string]any{"Id": ids[0], "Name": "Bea"}).
+		IfUnmodifiedSince(time.Now().Add(time.Hour)).
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	err = users.UpdateRecord(map[string]any{"Id": ids[0], "Name": "Cid"}).
+		IfUnmodifiedSince(time.Now().Add(-time.Hour)).
+		Execute()
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Execute() error = %v, want ErrConflict", err)
+	}
+
+	if name := fake.Records("users")[0]["Name"]; name != "Bea" {
+		t.Errorf("Name = %v, want Bea", name)
+	}
+}
@@ -0,0 +1,96 @@
+package nocodbgo
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// defaultWorkspaceID is the workspace identifier used in the user interface URLs of self-hosted
+// NocoDB instances, which don't have workspaces
+const defaultWorkspaceID = "nc"
+
+// recordURLBuilder is used to build the user interface URL of a record with a fluent API
+type recordURLBuilder struct {
+	table       *Table
+	recordID    RecordID
+	viewID      string
+	baseID      string
+	workspaceID string
+	chainErr    error // Stores any error in the chain of methods
+
+	contextProvider[*recordURLBuilder]
+}
+
+// RecordURL initializes a builder that returns the URL that opens a record in the NocoDB user
+// interface, for example to link users to the record from notifications.
+//
+// The base of the table is read from the meta API unless it is set with WithBaseID or the table
+// was obtained with Base.Table.
+//
+// Parameters:
+//   - recordID: The identifier of the record, can be a RecordID, any integer type or a string.
+//   - viewID:   The identifier of the view to open the record in, empty for the default view.
+//
+// Example:
+//
+//	link, err := table.RecordURL(orderID, "vw_xxxxxxxxxxxxxx").Execute()
+//	// Handle error
+//	notify(user, "New order: "+link)
+func (t *Table) RecordURL(recordID any, viewID string) *recordURLBuilder {
+	id, err := parseRecordID(recordID)
+
+	b := &recordURLBuilder{
+		table:       t,
+		recordID:    id,
+		viewID:      viewID,
+		workspaceID: defaultWorkspaceID,
+		chainErr:    err,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// WithBaseID sets the identifier of the base of the table, avoiding reading it from the meta API.
+func (b *recordURLBuilder) WithBaseID(baseID string) *recordURLBuilder {
+	b.baseID = baseID
+	return b
+}
+
+// WithWorkspaceID sets the identifier of the workspace of the base, required for NocoDB cloud.
+//
+// If not set, it defaults to "nc", used by self-hosted instances.
+func (b *recordURLBuilder) WithWorkspaceID(workspaceID string) *recordURLBuilder {
+	if workspaceID != "" {
+		b.workspaceID = workspaceID
+	}
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *recordURLBuilder) Execute() (string, error) {
+	if b.chainErr != nil {
+		return "", fmt.Errorf("error in the chain of methods: %w", b.chainErr)
+	}
+
+	if b.recordID.IsZero() {
+		return "", ErrRowIDRequired
+	}
+
+	baseID := b.baseID
+	if baseID == "" {
+		var err error
+		if baseID, err = b.table.lookupBaseID(b.contextProvider.ctx); err != nil {
+			return "", err
+		}
+	}
+
+	fragment := fmt.Sprintf("/%s/%s/%s", b.workspaceID, baseID, b.table.tableID)
+	if b.viewID != "" {
+		fragment += "/" + b.viewID
+	}
+	fragment += "?" + url.Values{"rowId": {b.recordID.String()}}.Encode()
+
+	return fmt.Sprintf("%s/dashboard/#%s", b.table.client.baseURL, fragment), nil
+}
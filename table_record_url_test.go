@@ -0,0 +1,38 @@
+package nocodbgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRecordURL(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/md_orders", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"md_orders","base_id":"p_shop","title":"Orders","columns":[]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	orders := client.Table("md_orders")
+
+	link, err := orders.RecordURL(42, "vw_grid").Execute()
+	if err != nil {
+		t.Fatalf("RecordURL() error = %v", err)
+	}
+	if want := server.URL + "/dashboard/#/nc/p_shop/md_orders/vw_grid?rowId=42"; link != want {
+		t.Errorf("RecordURL() = %q, want %q", link, want)
+	}
+
+	link, err = orders.RecordURL(7, "").WithBaseID("p_other").WithWorkspaceID("w_team").Execute()
+	if err != nil {
+		t.Fatalf("RecordURL() error = %v", err)
+	}
+	if want := server.URL + "/dashboard/#/w_team/p_other/md_orders?rowId=7"; link != want {
+		t.Errorf("RecordURL() = %q, want %q", link, want)
+	}
+}
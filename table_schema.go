@@ -0,0 +1,210 @@
+package nocodbgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// readSchemaBuilder is used to build a query that reads the schema of a table with a fluent API
+type readSchemaBuilder struct {
+	table *Table
+
+	contextProvider[*readSchemaBuilder]
+}
+
+// ReadSchema reads the schema of the table, including its columns, using the meta API.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table/operation/db-table-read
+func (t *Table) ReadSchema() *readSchemaBuilder {
+	b := &readSchemaBuilder{
+		table: t,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// TableSchema describes a table and its columns as returned by the meta API
+type TableSchema struct {
+	// ID is the unique identifier of the table
+	ID string `json:"id"`
+	// BaseID is the identifier of the base the table belongs to
+	BaseID string `json:"base_id"`
+	// SourceID is the identifier of the data source the table belongs to
+	SourceID string `json:"source_id"`
+	// Title is the title of the table shown in the user interface
+	Title string `json:"title"`
+	// TableName is the name of the table in the database
+	TableName string `json:"table_name"`
+	// Columns are the columns of the table
+	Columns []Column `json:"columns"`
+}
+
+// Column returns the column with the given title and whether it was found.
+func (s TableSchema) Column(title string) (Column, bool) {
+	for _, column := range s.Columns {
+		if column.Title == title {
+			return column, true
+		}
+	}
+	return Column{}, false
+}
+
+// columnByID returns the column with the given identifier and whether it was found.
+func (s TableSchema) columnByID(columnID string) (Column, bool) {
+	for _, column := range s.Columns {
+		if column.ID == columnID {
+			return column, true
+		}
+	}
+	return Column{}, false
+}
+
+// PrimaryKey returns the primary key column of the table and whether it was found.
+func (s TableSchema) PrimaryKey() (Column, bool) {
+	for _, column := range s.Columns {
+		if column.PrimaryKey {
+			return column, true
+		}
+	}
+	return Column{}, false
+}
+
+// Column describes a column of a table as returned by the meta API
+type Column struct {
+	// ID is the unique identifier of the column
+	ID string
+	// Title is the title of the column, used as the field name in the data API
+	Title string
+	// ColumnName is the name of the column in the database
+	ColumnName string
+	// UIDT is the data type of the column in the user interface (e.g. ColumnTypeSingleLineText)
+	UIDT ColumnType
+	// PrimaryKey indicates if the column is the primary key of the table
+	PrimaryKey bool
+	// PrimaryValue indicates if the column is the display value of the table
+	PrimaryValue bool
+	// Required indicates if the column requires a value
+	Required bool
+	// System indicates if the column is managed by NocoDB
+	System bool
+	// AutoIncrement indicates if the column value is auto-incremented
+	AutoIncrement bool
+	// Meta contains additional column type specific settings
+	Meta map[string]any
+	// ColOptions contains the options of virtual and select columns (e.g. select options or link relations)
+	ColOptions map[string]any
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Column.
+// It handles the boolean flags being returned either as booleans or as 0/1 integers, and the meta
+// being returned either as an object or as a JSON encoded string.
+func (c *Column) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID            string         `json:"id"`
+		Title         string         `json:"title"`
+		ColumnName    string         `json:"column_name"`
+		UIDT          string         `json:"uidt"`
+		PrimaryKey    any            `json:"pk"`
+		PrimaryValue  any            `json:"pv"`
+		Required      any            `json:"rqd"`
+		System        any            `json:"system"`
+		AutoIncrement any            `json:"ai"`
+		Meta          any            `json:"meta"`
+		ColOptions    map[string]any `json:"colOptions"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal column: %w", err)
+	}
+
+	*c = Column{
+		ID:            raw.ID,
+		Title:         raw.Title,
+		ColumnName:    raw.ColumnName,
+		UIDT:          ColumnType(raw.UIDT),
+		PrimaryKey:    metaBool(raw.PrimaryKey),
+		PrimaryValue:  metaBool(raw.PrimaryValue),
+		Required:      metaBool(raw.Required),
+		System:        metaBool(raw.System),
+		AutoIncrement: metaBool(raw.AutoIncrement),
+		Meta:          metaObject(raw.Meta),
+		ColOptions:    raw.ColOptions,
+	}
+
+	return nil
+}
+
+// Execute finalizes and executes the operation.
+func (b *readSchemaBuilder) Execute() (TableSchema, error) {
+	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodGet, b.table.schemaPath(), nil, nil)
+	if err != nil {
+		return TableSchema{}, withOperation(fmt.Errorf("failed to read table schema: %w", err), "ReadSchema", b.table.tableID, RecordID{})
+	}
+
+	var response TableSchema
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return TableSchema{}, fmt.Errorf("failed to unmarshal table schema response: %w", err)
+	}
+
+	return response, nil
+}
+
+// metaBool converts a boolean flag of the meta API, which can be a boolean, a 0/1 number or null.
+func metaBool(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	}
+	return false
+}
+
+// metaObject converts a meta value of the meta API, which can be an object, a JSON encoded string or null.
+func metaObject(value any) map[string]any {
+	switch v := value.(type) {
+	case map[string]any:
+		return v
+	case string:
+		var object map[string]any
+		if err := json.Unmarshal([]byte(v), &object); err == nil {
+			return object
+		}
+	}
+	return nil
+}
+
+// updateColumn reads a column, lets the change function modify its raw representation and sends it
+// back. The whole column is sent because the meta API replaces the column settings on update.
+func updateColumn(ctx context.Context, client *Client, columnID string, change func(raw map[string]any, column Column) error) error {
+	path := fmt.Sprintf("/api/v2/meta/columns/%s", columnID)
+	respBody, err := client.request(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read column: %w", err)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(respBody, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal column response: %w", err)
+	}
+
+	var column Column
+	if err := json.Unmarshal(respBody, &column); err != nil {
+		return fmt.Errorf("failed to unmarshal column response: %w", err)
+	}
+
+	if err := change(raw, column); err != nil {
+		return err
+	}
+
+	if _, err := client.request(ctx, http.MethodPatch, path, raw, nil); err != nil {
+		return fmt.Errorf("failed to update column: %w", err)
+	}
+
+	return nil
+}
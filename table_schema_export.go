@@ -0,0 +1,277 @@
+package nocodbgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// SchemaFormat is the format of an exported schema
+type SchemaFormat string
+
+const (
+	// SchemaFormatJSON writes the schema as indented JSON
+	SchemaFormatJSON SchemaFormat = "json"
+	// SchemaFormatYAML writes the schema as YAML
+	SchemaFormatYAML SchemaFormat = "yaml"
+)
+
+// schemaDocumentVersion is the version of the format of SchemaDocument
+const schemaDocumentVersion = 1
+
+// SchemaDocument is the exported schema of a table. It refers to columns by title and leaves out
+// the identifiers assigned by NocoDB, so the same schema exported from different bases is equal.
+type SchemaDocument struct {
+	// Version is the version of the format of the document
+	Version int `json:"version"`
+	// Table is the title of the table
+	Table string `json:"table"`
+	// Columns are the columns of the table, in the order of the schema, without the system columns
+	Columns []SchemaDocumentColumn `json:"columns"`
+	// Views are the views of the table, in the order of the table
+	Views []SchemaDocumentView `json:"views,omitempty"`
+}
+
+// SchemaDocumentColumn is a column of an exported schema
+type SchemaDocumentColumn struct {
+	// Title is the title of the column
+	Title string `json:"title"`
+	// Type is the type of the column
+	Type ColumnType `json:"type"`
+	// PrimaryKey indicates if the column is the primary key of the table
+	PrimaryKey bool `json:"primaryKey,omitempty"`
+	// PrimaryValue indicates if the column is the display value of the table
+	PrimaryValue bool `json:"primaryValue,omitempty"`
+	// Required indicates if the column requires a value
+	Required bool `json:"required,omitempty"`
+	// Formula is the formula of a Formula column
+	Formula string `json:"formula,omitempty"`
+	// Options are the options of a select column
+	Options []SchemaDocumentOption `json:"options,omitempty"`
+	// Meta contains the type specific settings of the column
+	Meta map[string]any `json:"meta,omitempty"`
+}
+
+// SchemaDocumentOption is an option of a select column of an exported schema
+type SchemaDocumentOption struct {
+	// Title is the value of the option
+	Title string `json:"title"`
+	// Color is the color of the option
+	Color string `json:"color,omitempty"`
+}
+
+// SchemaDocumentView is a view of an exported schema
+type SchemaDocumentView struct {
+	// Title is the title of the view
+	Title string `json:"title"`
+	// Type is the type of the view ("grid", "form", "gallery", "kanban", "map" or "calendar")
+	Type string `json:"type"`
+	// Filters are the filters persisted in the view
+	Filters []SchemaDocumentFilter `json:"filters,omitempty"`
+	// Sorts are the sorts persisted in the view
+	Sorts []SchemaDocumentSort `json:"sorts,omitempty"`
+}
+
+// SchemaDocumentFilter is a filter of a view of an exported schema
+type SchemaDocumentFilter struct {
+	// Column is the title of the column, empty for groups
+	Column string `json:"column,omitempty"`
+	// ComparisonOp is the comparison operator (e.g. "eq")
+	ComparisonOp string `json:"comparisonOp,omitempty"`
+	// ComparisonSubOp is the comparison sub operator of date filters (e.g. "today")
+	ComparisonSubOp string `json:"comparisonSubOp,omitempty"`
+	// LogicalOp is the logical operator used to combine the filter with its siblings
+	LogicalOp string `json:"logicalOp,omitempty"`
+	// Value is the value compared against
+	Value any `json:"value,omitempty"`
+	// Children are the filters of a group
+	Children []SchemaDocumentFilter `json:"children,omitempty"`
+}
+
+// SchemaDocumentSort is a sort of a view of an exported schema
+type SchemaDocumentSort struct {
+	// Column is the title of the column
+	Column string `json:"column"`
+	// Direction is the sort direction, "asc" or "desc"
+	Direction string `json:"direction"`
+}
+
+// exportSchemaBuilder is used to build a schema export of a table with a fluent API
+type exportSchemaBuilder struct {
+	table  *Table
+	format SchemaFormat
+
+	contextProvider[*exportSchemaBuilder]
+}
+
+// ExportSchema initializes a builder that writes the schema of the table, with its columns, select
+// options and views, in the given format.
+//
+// The document has a stable order and no identifiers assigned by NocoDB, so it can be versioned
+// in git and compared between bases. Columns, filters and sorts refer to the columns by title.
+//
+// The library only reads JSON documents back, into a SchemaDocument for schema.FromDocument, so
+// YAML is an export-only format for reading and reviewing the schema.
+//
+// Parameters:
+//   - format: The format of the document, SchemaFormatJSON or SchemaFormatYAML.
+//
+// Example:
+//
+//	file, err := os.Create("products.schema.yaml")
+//	// Handle error
+//	defer file.Close()
+//
+//	err = table.ExportSchema(nocodbgo.SchemaFormatYAML).WithContext(ctx).Execute(file)
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table/operation/db-table-read
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-list
+func (t *Table) ExportSchema(format SchemaFormat) *exportSchemaBuilder {
+	b := &exportSchemaBuilder{
+		table:  t,
+		format: format,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation, writing the schema to w.
+func (b *exportSchemaBuilder) Execute(w io.Writer) error {
+	if b.format != SchemaFormatJSON && b.format != SchemaFormatYAML {
+		return fmt.Errorf("unsupported schema format %q", b.format)
+	}
+
+	document, err := b.table.schemaDocument(b.contextProvider.ctx)
+	if err != nil {
+		return withOperation(err, "ExportSchema", b.table.tableID, RecordID{})
+	}
+
+	return writeSchema(w, b.format, document)
+}
+
+// writeSchema writes an exported schema to w in the given format.
+func writeSchema(w io.Writer, format SchemaFormat, document any) error {
+	if format == SchemaFormatYAML {
+		if err := encodeYAML(w, document); err != nil {
+			return fmt.Errorf("failed to write schema: %w", err)
+		}
+		return nil
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	return nil
+}
+
+// schemaDocument reads the schema and the views of the table and builds its SchemaDocument.
+func (t *Table) schemaDocument(ctx context.Context) (SchemaDocument, error) {
+	schema, err := t.ReadSchema().WithContext(ctx).Execute()
+	if err != nil {
+		return SchemaDocument{}, fmt.Errorf("failed to read table schema: %w", err)
+	}
+
+	document := SchemaDocument{
+		Version: schemaDocumentVersion,
+		Table:   schema.Title,
+		Columns: []SchemaDocumentColumn{},
+	}
+
+	for _, column := range schema.Columns {
+		if column.System && !column.PrimaryKey {
+			continue
+		}
+
+		exported := SchemaDocumentColumn{
+			Title:        column.Title,
+			Type:         column.UIDT,
+			PrimaryKey:   column.PrimaryKey,
+			PrimaryValue: column.PrimaryValue,
+			Required:     column.Required,
+		}
+		if len(column.Meta) > 0 {
+			exported.Meta = column.Meta
+		}
+		if column.UIDT == ColumnTypeFormula {
+			exported.Formula, _ = column.ColOptions["formula_raw"].(string)
+		}
+		for _, option := range column.SelectOptions() {
+			exported.Options = append(exported.Options, SchemaDocumentOption{Title: option.Title, Color: option.Color})
+		}
+
+		document.Columns = append(document.Columns, exported)
+	}
+
+	views, err := t.ListViews().WithContext(ctx).Execute()
+	if err != nil {
+		return SchemaDocument{}, err
+	}
+
+	titleOf := func(columnID string) string {
+		column, _ := schema.columnByID(columnID)
+		return column.Title
+	}
+
+	for _, v := range views {
+		view := t.client.View(v.ID)
+		exported := SchemaDocumentView{Title: v.Title, Type: v.Type}
+
+		filters, err := view.ListFilters().WithContext(ctx).Execute()
+		if err != nil {
+			return SchemaDocument{}, err
+		}
+		if exported.Filters, err = exportFilters(ctx, view, filters, titleOf); err != nil {
+			return SchemaDocument{}, err
+		}
+
+		sorts, err := view.ListSorts().WithContext(ctx).Execute()
+		if err != nil {
+			return SchemaDocument{}, err
+		}
+		for _, sort := range sorts {
+			exported.Sorts = append(exported.Sorts, SchemaDocumentSort{Column: titleOf(sort.ColumnID), Direction: sort.Direction})
+		}
+
+		document.Views = append(document.Views, exported)
+	}
+
+	return document, nil
+}
+
+// exportFilters converts the filters of a view to the filters of a SchemaDocument, reading the
+// filters of the groups.
+func exportFilters(ctx context.Context, view *View, filters []ViewFilter, titleOf func(columnID string) string) ([]SchemaDocumentFilter, error) {
+	var exported []SchemaDocumentFilter
+
+	for _, filter := range filters {
+		f := SchemaDocumentFilter{
+			ComparisonOp:    filter.ComparisonOp,
+			ComparisonSubOp: filter.ComparisonSubOp,
+			LogicalOp:       filter.LogicalOp,
+			Value:           filter.Value,
+		}
+
+		if filter.IsGroup {
+			children, err := view.ListFilterChildren(filter.ID).WithContext(ctx).Execute()
+			if err != nil {
+				return nil, err
+			}
+			if f.Children, err = exportFilters(ctx, view, children, titleOf); err != nil {
+				return nil, err
+			}
+		} else {
+			f.Column = titleOf(filter.ColumnID)
+		}
+
+		exported = append(exported, f)
+	}
+
+	return exported, nil
+}
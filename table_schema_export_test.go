@@ -0,0 +1,129 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestExportSchema(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/md_tasks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"md_tasks","title":"Tasks","columns":[
+			{"id":"cl_id","title":"Id","uidt":"ID","pk":1,"system":0},
+			{"id":"cl_name","title":"Name","uidt":"SingleLineText","pv":true,"rqd":1},
+			{"id":"cl_status","title":"Status","uidt":"SingleSelect","colOptions":{"options":[
+				{"id":"sl_1","title":"Todo","color":"#aaaaaa"},{"id":"sl_2","title":"Done: yes","color":"#bbbbbb"}
+			]}},
+			{"id":"cl_score","title":"Score","uidt":"Formula","colOptions":{"formula_raw":"{Points} * 2"}},
+			{"id":"cl_created","title":"CreatedAt","uidt":"CreatedTime","system":true}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/tables/md_tasks/views", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"id":"vw_grid","title":"Open","type":3}]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/views/vw_grid/filters", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[
+			{"id":"fi_1","fk_column_id":"cl_status","comparison_op":"neq","logical_op":"and","value":"Done: yes"},
+			{"id":"fi_2","is_group":1,"logical_op":"and"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/filters/fi_2/children", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"id":"fi_3","fk_column_id":"cl_name","comparison_op":"notblank","logical_op":"or"}]}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/views/vw_grid/sorts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"id":"so_1","fk_column_id":"cl_name","direction":"asc"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Table("md_tasks")
+
+	var jsonOutput bytes.Buffer
+	if err := table.ExportSchema(SchemaFormatJSON).Execute(&jsonOutput); err != nil {
+		t.Fatalf("ExportSchema() error = %v", err)
+	}
+	var document SchemaDocument
+	if err := json.Unmarshal(jsonOutput.Bytes(), &document); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	want := SchemaDocument{
+		Version: 1,
+		Table:   "Tasks",
+		Columns: []SchemaDocumentColumn{
+			{Title: "Id", Type: ColumnTypeID, PrimaryKey: true},
+			{Title: "Name", Type: ColumnTypeSingleLineText, PrimaryValue: true, Required: true},
+			{Title: "Status", Type: ColumnTypeSingleSelect, Options: []SchemaDocumentOption{{Title: "Todo", Color: "#aaaaaa"}, {Title: "Done: yes", Color: "#bbbbbb"}}},
+			{Title: "Score", Type: ColumnTypeFormula, Formula: "{Points} * 2"},
+		},
+		Views: []SchemaDocumentView{{
+			Title: "Open",
+			Type:  "grid",
+			Filters: []SchemaDocumentFilter{
+				{Column: "Status", ComparisonOp: "neq", LogicalOp: "and", Value: "Done: yes"},
+				{LogicalOp: "and", Children: []SchemaDocumentFilter{{Column: "Name", ComparisonOp: "notblank", LogicalOp: "or"}}},
+			},
+			Sorts: []SchemaDocumentSort{{Column: "Name", Direction: "asc"}},
+		}},
+	}
+	if !reflect.DeepEqual(document, want) {
+		t.Errorf("ExportSchema() JSON =\n%s", jsonOutput.String())
+	}
+
+	var yamlOutput bytes.Buffer
+	if err := table.ExportSchema(SchemaFormatYAML).WithContext(context.Background()).Execute(&yamlOutput); err != nil {
+		t.Fatalf("ExportSchema() error = %v", err)
+	}
+	wantYAML := `version: 1
+table: Tasks
+columns:
+  - title: Id
+    type: ID
+    primaryKey: true
+  - title: Name
+    type: SingleLineText
+    primaryValue: true
+    required: true
+  - title: Status
+    type: SingleSelect
+    options:
+      - title: Todo
+        color: "#aaaaaa"
+      - title: "Done: yes"
+        color: "#bbbbbb"
+  - title: Score
+    type: Formula
+    formula: "{Points} * 2"
+views:
+  - title: Open
+    type: grid
+    filters:
+      - column: Status
+        comparisonOp: neq
+        logicalOp: and
+        value: "Done: yes"
+      - logicalOp: and
+        children:
+          - column: Name
+            comparisonOp: notblank
+            logicalOp: or
+    sorts:
+      - column: Name
+        direction: asc
+`
+	if yamlOutput.String() != wantYAML {
+		t.Errorf("ExportSchema() YAML =\n%s\nwant\n%s", yamlOutput.String(), wantYAML)
+	}
+
+	if err := table.ExportSchema("xml").Execute(&yamlOutput); err == nil {
+		t.Errorf("ExportSchema() with unknown format error = nil")
+	}
+}
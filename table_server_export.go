@@ -0,0 +1,144 @@
+package nocodbgo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ExportFormat is the format of a file generated by the export endpoints of NocoDB
+type ExportFormat string
+
+const (
+	// ExportFormatCSV exports the records as comma separated values
+	ExportFormatCSV ExportFormat = "csv"
+	// ExportFormatExcel exports the records as an Excel workbook
+	ExportFormatExcel ExportFormat = "excel"
+)
+
+// exportOffsetHeader is the header with the offset of the next chunk of an export, -1 after the last one
+const exportOffsetHeader = "nc-export-offset"
+
+// serverExportBuilder is used to build an export generated by the server with a fluent API
+type serverExportBuilder struct {
+	table  *Table
+	format ExportFormat
+
+	contextProvider[*serverExportBuilder]
+	viewIDProvider[*serverExportBuilder]
+}
+
+// ServerExport initializes a builder that exports the records of the table, or of one of its
+// views with WithViewId, to a file generated by NocoDB, with the fields, filters and sorts of the
+// view, like the Download option of the user interface.
+//
+// The server generates the file, so large tables are exported without paging through the records
+// in the client, and the file is streamed to the writer as it's received.
+//
+// NocoDB generates large exports in chunks. CSV chunks are joined into a single file, while an
+// Excel export that doesn't fit in a single chunk fails with ErrTooManyRecords without writing
+// anything, use CSV for them.
+//
+// Parameters:
+//   - format: The format of the file, ExportFormatCSV or ExportFormatExcel.
+//
+// Example:
+//
+//	file, err := os.Create("orders.csv")
+//	// Handle error
+//	defer file.Close()
+//
+//	err = table.ServerExport(nocodbgo.ExportFormatCSV).
+//		WithViewId("vw_xxxxxxxxxxxxxx").
+//		WithContext(ctx).
+//		Execute(file)
+//
+// Documentation:
+//   - https://docs.nocodb.com/views/view-actions/download
+func (t *Table) ServerExport(format ExportFormat) *serverExportBuilder {
+	b := &serverExportBuilder{
+		table:  t,
+		format: format,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.viewIDProvider = newViewIDProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation, writing the file to w.
+func (b *serverExportBuilder) Execute(w io.Writer) error {
+	if b.format != ExportFormatCSV && b.format != ExportFormatExcel {
+		return fmt.Errorf("unsupported export format %q", b.format)
+	}
+
+	ctx := b.contextProvider.ctx
+
+	baseID, err := b.table.lookupBaseID(ctx)
+	if err != nil {
+		return withOperation(err, "ServerExport", b.table.tableID, RecordID{})
+	}
+
+	path := fmt.Sprintf("/api/v1/db/data/%s/%s/%s", v1DataOrg, url.PathEscape(baseID), url.PathEscape(b.table.tableID))
+	if viewID := b.viewIDProvider.rawViewID; viewID != "" {
+		path += "/views/" + url.PathEscape(viewID)
+	}
+	path += "/export/" + string(b.format)
+
+	offset := 0
+	for chunk := 0; ; chunk++ {
+		query := url.Values{}
+		query.Set("offset", strconv.Itoa(offset))
+
+		next, err := b.writeChunk(ctx, w, path, query, chunk)
+		if err != nil {
+			return withOperation(err, "ServerExport", b.table.tableID, RecordID{})
+		}
+		if next < 0 {
+			return nil
+		}
+		offset = next
+	}
+}
+
+// writeChunk downloads a chunk of the export and writes it to w, leaving out the header row of
+// the CSV chunks after the first one. It returns the offset of the next chunk, or -1 after the
+// last one.
+//
+// Excel exports can't be joined, so an Excel chunk followed by another one returns an error
+// wrapping ErrTooManyRecords before anything is written to w.
+func (b *serverExportBuilder) writeChunk(ctx context.Context, w io.Writer, path string, query url.Values, chunk int) (int, error) {
+	resp, err := b.table.client.openStream(ctx, http.MethodGet, path, query, nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to export records: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The offset of the next chunk comes in a header, so it's known before writing the chunk
+	next, err := strconv.Atoi(strings.TrimSpace(resp.Header.Get(exportOffsetHeader)))
+	if err != nil || next <= 0 {
+		next = -1
+	}
+	if next >= 0 && b.format == ExportFormatExcel {
+		return 0, fmt.Errorf("%w: the Excel export doesn't fit in a single file, export it as CSV", ErrTooManyRecords)
+	}
+
+	body := bufio.NewReader(resp.Body)
+	if chunk > 0 {
+		if _, err := body.ReadString('\n'); err != nil && err != io.EOF {
+			return 0, fmt.Errorf("failed to read export: %w", err)
+		}
+	}
+
+	if _, err := io.Copy(w, body); err != nil {
+		return 0, fmt.Errorf("failed to write export: %w", err)
+	}
+
+	return next, nil
+}
@@ -0,0 +1,87 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServerExport(t *testing.T) {
+	chunks := []string{"Id,Name\n1,Ada\n2,Grace\n", "Id,Name\n3,Linus\n"}
+
+	var offsets []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/db/data/noco/p1/t1/views/vw1/export/{format}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("xc-token") != "test-token" {
+			t.Errorf("xc-token = %q, want %q", r.Header.Get("xc-token"), "test-token")
+		}
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Header().Set(exportOffsetHeader, "2")
+			fmt.Fprint(w, chunks[0])
+		default:
+			w.Header().Set(exportOffsetHeader, "-1")
+			fmt.Fprint(w, chunks[1])
+		}
+	})
+	mux.HandleFunc("GET /api/v1/db/data/noco/p1/missing/export/csv", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"msg":"Table not found"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	table := client.Base("p1").Table("t1")
+
+	t.Run("joins the CSV chunks", func(t *testing.T) {
+		offsets = nil
+
+		var buf bytes.Buffer
+		if err := table.ServerExport(ExportFormatCSV).WithViewId("vw1").Execute(&buf); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		want := "Id,Name\n1,Ada\n2,Grace\n3,Linus\n"
+		if buf.String() != want {
+			t.Errorf("Execute() wrote %q, want %q", buf.String(), want)
+		}
+		if len(offsets) != 2 || offsets[0] != "0" || offsets[1] != "2" {
+			t.Errorf("offsets = %v, want [0 2]", offsets)
+		}
+	})
+
+	t.Run("fails when the Excel export has several chunks", func(t *testing.T) {
+		var out bytes.Buffer
+		err := table.ServerExport(ExportFormatExcel).WithViewId("vw1").Execute(&out)
+		if !errors.Is(err, ErrTooManyRecords) {
+			t.Errorf("Execute() error = %v, want %v", err, ErrTooManyRecords)
+		}
+		if out.Len() != 0 {
+			t.Errorf("Execute() wrote %d bytes, want none", out.Len())
+		}
+	})
+
+	t.Run("returns the API error", func(t *testing.T) {
+		err := client.Base("p1").Table("missing").ServerExport(ExportFormatCSV).Execute(&bytes.Buffer{})
+
+		var opErr *OperationError
+		if !errors.As(err, &opErr) || opErr.StatusCode != http.StatusNotFound {
+			t.Errorf("Execute() error = %v, want an *OperationError with status code 404", err)
+		}
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		if err := table.ServerExport("pdf").Execute(&bytes.Buffer{}); err == nil {
+			t.Error("Execute() error = nil, want an error")
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package nocodbgo
+
+import "time"
+
+// tableTimestamps are the caller-managed timestamp columns stamped by the create and update
+// operations of a table
+type tableTimestamps struct {
+	createdAt string
+	updatedAt string
+}
+
+// WithTimestamps returns a copy of the table whose create and update operations stamp the given
+// DateTime columns with the current time of the client clock, for tables whose NocoDB system
+// timestamp columns are disabled or not available, like tables of external data sources.
+//
+// Created records get both columns and updated records get the updated at column. Values set by
+// the caller are kept, so imports can preserve the original timestamps. An empty column name
+// disables that column.
+//
+// The original table is not modified, so the same table can be used with and without timestamps.
+//
+// Example:
+//
+//	users := client.Table("m_xxxxxxxxxxxxxx").WithTimestamps("Created", "Modified")
+//
+//	// Created and Modified are set to the current time
+//	id, err := users.CreateRecord(map[string]any{"Name": "Ana"}).Execute()
+func (t *Table) WithTimestamps(createdAtColumn string, updatedAtColumn string) *Table {
+	table := *t
+	table.timestamps = tableTimestamps{
+		createdAt: createdAtColumn,
+		updatedAt: updatedAtColumn,
+	}
+	return &table
+}
+
+// stampCreated returns the records with the timestamp columns of the table set, copying the
+// records that are changed.
+func (t *Table) stampCreated(records []map[string]any) []map[string]any {
+	return t.stamp(records, t.timestamps.createdAt, t.timestamps.updatedAt)
+}
+
+// stampUpdated returns the records with the updated at column of the table set, copying the
+// records that are changed.
+func (t *Table) stampUpdated(records []map[string]any) []map[string]any {
+	return t.stamp(records, t.timestamps.updatedAt)
+}
+
+// stamp returns the records with the current time set in the given columns that are not set
+// already, without modifying the original records.
+func (t *Table) stamp(records []map[string]any, columns ...string) []map[string]any {
+	if t.timestamps == (tableTimestamps{}) {
+		return records
+	}
+
+	now := t.client.clock.Now().UTC().Format(time.RFC3339)
+	stamped := make([]map[string]any, len(records))
+	for i, record := range records {
+		stamped[i] = record
+		copied := false
+		for _, column := range columns {
+			if _, ok := record[column]; ok || column == "" {
+				continue
+			}
+			if !copied {
+				stamped[i] = copyMap(record)
+				copied = true
+			}
+			stamped[i][column] = now
+		}
+	}
+
+	return stamped
+}
@@ -0,0 +1,54 @@
+package nocodbgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eduardolat/nocodbgo/nocodbgotest"
+)
+
+func TestWithTimestamps(t *testing.T) {
+	clock := nocodbgotest.NewClock(time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC))
+	fake := nocodbgotest.New()
+	client, err := NewClient().
+		WithBaseURL(fake.BaseURL()).
+		WithAPIToken("test-token").
+		WithHTTPClient(fake.HTTPClient()).
+		WithClock(clock).
+		Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	users := client.Table("users").WithTimestamps("Created", "Modified")
+
+	data := map[string]any{"Name": "Ana"}
+	if _, err := users.CreateRecord(data).Execute(); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if _, err := users.CreateRecords([]map[string]any{{"Name": "Bob", "Created": "2020-01-01T00:00:00Z"}}).Execute(); err != nil {
+		t.Fatalf("CreateRecords() error = %v", err)
+	}
+	if _, err := client.Table("users").CreateRecord(map[string]any{"Name": "Cid"}).Execute(); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if len(data) != 1 {
+		t.Errorf("CreateRecord() modified the data of the caller: %v", data)
+	}
+
+	clock.Advance(time.Hour)
+	if err := users.UpdateRecord(map[string]any{"Id": 1, "Name": "Ana Doe"}).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+
+	records := fake.Records("users")
+	want := []struct{ created, modified any }{
+		{"2024-03-05T10:00:00Z", "2024-03-05T11:00:00Z"},
+		{"2020-01-01T00:00:00Z", "2024-03-05T10:00:00Z"},
+		{nil, nil},
+	}
+	for i, w := range want {
+		if records[i]["Created"] != w.created || records[i]["Modified"] != w.modified {
+			t.Errorf("record %d timestamps = %v, %v, want %v, %v", i+1, records[i]["Created"], records[i]["Modified"], w.created, w.modified)
+		}
+	}
+}
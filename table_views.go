@@ -0,0 +1,157 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// viewTypeNames are the names of the view types of the meta API
+var viewTypeNames = map[int]string{
+	1: "form",
+	2: "gallery",
+	3: "grid",
+	4: "kanban",
+	5: "map",
+	6: "calendar",
+}
+
+// viewCreatePaths are the path segments of the meta API endpoints that create each type of view
+var viewCreatePaths = map[string]string{
+	"form":     "forms",
+	"gallery":  "galleries",
+	"grid":     "grids",
+	"kanban":   "kanbans",
+	"map":      "maps",
+	"calendar": "calendars",
+}
+
+// ViewInfo describes a view of a table
+type ViewInfo struct {
+	// ID is the unique identifier of the view
+	ID string
+	// Title is the title of the view
+	Title string
+	// Type is the type of the view ("grid", "form", "gallery", "kanban", "map" or "calendar")
+	Type string
+}
+
+// listViewsBuilder is used to build a query that lists the views of a table with a fluent API
+type listViewsBuilder struct {
+	table *Table
+
+	contextProvider[*listViewsBuilder]
+}
+
+// ListViews lists the views of the table, in the order they are shown in the user interface.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-list
+func (t *Table) ListViews() *listViewsBuilder {
+	b := &listViewsBuilder{
+		table: t,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listViewsBuilder) Execute() ([]ViewInfo, error) {
+	path := fmt.Sprintf("/api/v2/meta/tables/%s/views", b.table.tableID)
+	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list views: %w", err), "ListViews", b.table.tableID, RecordID{})
+	}
+
+	var response struct {
+		List []struct {
+			ID    string  `json:"id"`
+			Title string  `json:"title"`
+			Type  float64 `json:"type"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal views response: %w", err)
+	}
+
+	views := make([]ViewInfo, 0, len(response.List))
+	for _, view := range response.List {
+		views = append(views, ViewInfo{ID: view.ID, Title: view.Title, Type: viewTypeNames[int(view.Type)]})
+	}
+
+	return views, nil
+}
+
+// createViewBuilder is used to build a view creation with a fluent API
+type createViewBuilder struct {
+	table    *Table
+	title    string
+	viewType string
+
+	contextProvider[*createViewBuilder]
+}
+
+// CreateView creates a new view of the given type in the table and returns it. Kanban, map and
+// calendar views are created with the default settings of NocoDB, which can be changed in the
+// user interface.
+//
+// Parameters:
+//   - title:    The title of the view.
+//   - viewType: The type of the view ("grid", "form", "gallery", "kanban", "map" or "calendar").
+//
+// Example:
+//
+//	view, err := table.CreateView("Open tasks", "grid").Execute()
+//	// Handle error
+//	err = view.CreateSort(nocodbgo.ViewSort{ColumnID: "cl_xxxxxxxxxxxxxx", Direction: "desc"}).Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-grid-create
+func (t *Table) CreateView(title string, viewType string) *createViewBuilder {
+	b := &createViewBuilder{
+		table:    t,
+		title:    title,
+		viewType: viewType,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createViewBuilder) Execute() (*View, error) {
+	segment, ok := viewCreatePaths[b.viewType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported view type %q", b.viewType)
+	}
+
+	body := map[string]any{
+		"title": b.title,
+	}
+	for viewType, name := range viewTypeNames {
+		if name == b.viewType {
+			body["type"] = viewType
+		}
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/tables/%s/%s", b.table.tableID, segment)
+	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodPost, path, body, nil)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to create view: %w", err), "CreateView", b.table.tableID, RecordID{})
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal view response: %w", err)
+	}
+
+	view := b.table.client.View(response.ID)
+	view.viewType = body["type"].(int)
+
+	return view, nil
+}
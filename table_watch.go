@@ -0,0 +1,245 @@
+package nocodbgo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ChangeType is the kind of change of a record reported by Watch
+type ChangeType string
+
+const (
+	// ChangeCreated is reported for records created since the previous poll
+	ChangeCreated ChangeType = "created"
+	// ChangeUpdated is reported for records updated since the previous poll
+	ChangeUpdated ChangeType = "updated"
+	// ChangeDeleted is reported for records deleted since the previous poll
+	ChangeDeleted ChangeType = "deleted"
+)
+
+// ChangeEvent is a change of a record of a watched table
+type ChangeEvent struct {
+	// Type is the kind of change, empty when the event reports an error
+	Type ChangeType
+	// RecordID is the ID of the changed record
+	RecordID string
+	// Record is the record after the change, nil for deleted records
+	Record map[string]any
+	// Err is the error of a poll that failed, the watch keeps polling after it
+	Err error
+}
+
+// WatchOptions are the options of Watch
+type WatchOptions struct {
+	// Filters restrict the watched records (e.g. "(Status,eq,active)"), records that stop matching
+	// them are reported as deleted
+	Filters []string
+	// Fields are the fields of the records of the events, all of them if empty
+	Fields []string
+	// UpdatedAtField is the DateTime field used to detect updated records, "UpdatedAt" if empty
+	UpdatedAtField string
+}
+
+// Watch polls the table for changes and emits an event on the returned channel for every record
+// created, updated or deleted since the previous poll, giving a change feed where webhooks can't
+// be used.
+//
+// The records present when Watch is called are taken as the starting point and are not emitted.
+// Each poll fetches the records whose updated at field changed, and the record count is used to
+// detect deleted records, which are then found by listing the IDs of the table.
+//
+// The channel is closed when the context is done. Errors of a poll are emitted as events with Err
+// set and the watch keeps polling, while errors of the initial fetch are returned.
+//
+// Parameters:
+//   - ctx: The context that stops the watch
+//   - interval: The time between polls, one minute if zero
+//   - opts: The options of the watch
+//
+// Example:
+//
+//	events, err := table.Watch(ctx, 10*time.Second, nocodbgo.WatchOptions{})
+//	if err != nil {
+//		return err
+//	}
+//	for event := range events {
+//		if event.Err != nil {
+//			log.Println(event.Err)
+//			continue
+//		}
+//		log.Println(event.Type, event.RecordID)
+//	}
+func (t *Table) Watch(ctx context.Context, interval time.Duration, opts WatchOptions) (<-chan ChangeEvent, error) {
+	if interval <= 0 {
+		interval = defaultMirrorInterval
+	}
+
+	w := &watcher{
+		table:    t,
+		ctx:      ctx,
+		interval: interval,
+		opts:     opts,
+		state:    &mirrorState{boundary: map[string]string{}},
+		known:    map[string]bool{},
+	}
+	w.mirror = t.Mirror(nil).
+		WithContext(ctx).
+		WithUpdatedAtField(opts.UpdatedAtField).
+		ReturnFields(opts.Fields...)
+	for _, filter := range opts.Filters {
+		w.mirror.Where(filter)
+	}
+
+	records, err := w.mirror.fetch(w.state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch initial records: %w", err)
+	}
+	for _, record := range records {
+		id, err := watchedID(record)
+		if err != nil {
+			return nil, err
+		}
+		w.known[id] = true
+	}
+
+	events := make(chan ChangeEvent)
+	go w.run(events)
+
+	return events, nil
+}
+
+// watcher holds the state of a Watch
+type watcher struct {
+	table    *Table
+	ctx      context.Context
+	interval time.Duration
+	opts     WatchOptions
+	mirror   *mirrorBuilder
+	state    *mirrorState
+
+	// known are the IDs of the records seen by the watch
+	known map[string]bool
+}
+
+// run polls the table until the context is done, then closes the events channel.
+func (w *watcher) run(events chan<- ChangeEvent) {
+	defer close(events)
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-w.table.client.clock.After(w.interval):
+		}
+
+		changes, err := w.poll()
+		if err != nil {
+			changes = append(changes, ChangeEvent{Err: fmt.Errorf("failed to poll changes: %w", err)})
+		}
+
+		for _, change := range changes {
+			select {
+			case events <- change:
+			case <-w.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// poll returns the changes since the previous poll, along with the changes found before an error.
+func (w *watcher) poll() ([]ChangeEvent, error) {
+	records, err := w.mirror.fetch(w.state)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]ChangeEvent, 0, len(records))
+	for _, record := range records {
+		id, err := watchedID(record)
+		if err != nil {
+			return changes, err
+		}
+		change := ChangeEvent{Type: ChangeUpdated, RecordID: id, Record: record}
+		if !w.known[id] {
+			change.Type = ChangeCreated
+			w.known[id] = true
+		}
+		changes = append(changes, change)
+	}
+
+	count, err := w.count()
+	if err != nil {
+		return changes, err
+	}
+	if count == len(w.known) {
+		return changes, nil
+	}
+
+	deleted, err := w.deleted()
+	if err != nil {
+		return changes, err
+	}
+
+	return append(changes, deleted...), nil
+}
+
+// count returns the number of watched records in the table.
+func (w *watcher) count() (int, error) {
+	query := w.table.WithDefaults(QueryDefaults{}).CountRecords().WithContext(w.ctx)
+	for _, filter := range w.opts.Filters {
+		query.Where(filter)
+	}
+	return query.Execute()
+}
+
+// deleted lists the IDs of the watched records and returns the known records that are missing,
+// which are removed from the known records.
+func (w *watcher) deleted() ([]ChangeEvent, error) {
+	query := w.table.WithDefaults(QueryDefaults{}).ListRecords().WithContext(w.ctx).ReturnFields(SystemFieldID)
+	for _, filter := range w.opts.Filters {
+		query.Where(filter)
+	}
+
+	response, err := query.ExecuteAll()
+	if err != nil {
+		return nil, err
+	}
+
+	present := make(map[string]bool, len(response.List))
+	for _, record := range response.List {
+		id, err := watchedID(record)
+		if err != nil {
+			return nil, err
+		}
+		present[id] = true
+	}
+
+	missing := []string{}
+	for id := range w.known {
+		if !present[id] {
+			missing = append(missing, id)
+		}
+	}
+	slices.Sort(missing)
+
+	changes := make([]ChangeEvent, 0, len(missing))
+	for _, id := range missing {
+		changes = append(changes, ChangeEvent{Type: ChangeDeleted, RecordID: id})
+		delete(w.known, id)
+	}
+
+	return changes, nil
+}
+
+// watchedID returns the ID of a watched record as it appears in the change events, formatting
+// large numeric IDs as integers.
+func watchedID(record map[string]any) (string, error) {
+	id, err := parseRecordID(record[SystemFieldID])
+	if err != nil {
+		return "", fmt.Errorf("failed to read the ID of a watched record: %w", err)
+	}
+	return id.String(), nil
+}
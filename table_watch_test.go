@@ -0,0 +1,75 @@
+package nocodbgo
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.Seed("users", map[string]any{"Name": "John"}, map[string]any{"Name": "Jane"})
+	table := client.Table("users")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := table.Watch(ctx, 5*time.Millisecond, WatchOptions{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+
+	receive := func() ChangeEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			if event.Err != nil {
+				t.Fatalf("event error = %v", event.Err)
+			}
+			return event
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for watch events")
+			return ChangeEvent{}
+		}
+	}
+
+	if err := table.UpdateRecord(map[string]any{"Id": 2, "Name": "Jane Doe"}).Execute(); err != nil {
+		t.Fatalf("UpdateRecord() error = %v", err)
+	}
+	if event := receive(); event.Type != ChangeUpdated || event.RecordID != "2" || event.Record["Name"] != "Jane Doe" {
+		t.Errorf("event = %+v, want Jane Doe updated", event)
+	}
+
+	if _, err := table.CreateRecord(map[string]any{"Name": "Ana"}).Execute(); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if event := receive(); event.Type != ChangeCreated || event.RecordID != "3" {
+		t.Errorf("event = %+v, want record 3 created", event)
+	}
+
+	if err := table.DeleteRecord(1).Execute(); err != nil {
+		t.Fatalf("DeleteRecord() error = %v", err)
+	}
+	if event := receive(); event.Type != ChangeDeleted || event.RecordID != "1" || event.Record != nil {
+		t.Errorf("event = %+v, want record 1 deleted", event)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		for ok {
+			_, ok = <-events
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("events channel not closed after the context was canceled")
+	}
+}
+
+func TestWatchedID(t *testing.T) {
+	// IDs decoded from JSON are float64, which fmt.Sprint formats as 1e+06
+	for value, want := range map[any]string{float64(1_000_000): "1000000", float64(7): "7", "a1b2": "a1b2"} {
+		if id, err := watchedID(map[string]any{SystemFieldID: value}); err != nil || id != want {
+			t.Errorf("watchedID(%v) = %q, %v, want %q", value, id, err, want)
+		}
+	}
+}
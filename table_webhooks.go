@@ -0,0 +1,460 @@
+package nocodbgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Webhook is a webhook of a table, which calls a URL when records are inserted, updated or deleted
+type Webhook struct {
+	// ID is the unique identifier of the webhook
+	ID string `json:"id"`
+	// Title is the title of the webhook
+	Title string `json:"title"`
+	// Event is when the webhook is triggered relative to the operation ("after")
+	Event string `json:"event"`
+	// Operation is the operation that triggers the webhook ("insert", "update" or "delete")
+	Operation string `json:"operation"`
+	// Active indicates if the webhook is enabled
+	Active bool `json:"active"`
+	// Condition indicates if the webhook is only triggered for the records matching its filters
+	Condition bool `json:"condition"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Webhook.
+// It handles the boolean flags being returned either as booleans, as 0/1 integers or as null.
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	type Alias Webhook
+	var raw struct {
+		Alias
+		Active    any `json:"active"`
+		Condition any `json:"condition"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal webhook: %w", err)
+	}
+
+	*w = Webhook(raw.Alias)
+	w.Active = metaBool(raw.Active)
+	w.Condition = metaBool(raw.Condition)
+
+	return nil
+}
+
+// createWebhookBuilder is used to build a webhook creation with a fluent API
+type createWebhookBuilder struct {
+	table     *Table
+	title     string
+	operation string
+	method    string
+	url       string
+	body      string
+
+	contextProvider[*createWebhookBuilder]
+	filterProvider[*createWebhookBuilder]
+}
+
+// CreateWebhook creates a webhook that sends a request to a URL after records of the table are
+// inserted, updated or deleted. By default it is triggered after inserts.
+//
+// The condition of the webhook is defined with the same filter methods used for queries, which
+// are converted to the filters of the webhook using the schema of the table. Filters combining
+// "~and" and "~or" are converted to groups of filters, and "~not" is not supported.
+//
+// Parameters:
+//   - title: The title of the webhook.
+//
+// Example:
+//
+//	hook, err := table.CreateWebhook("Notify big orders").
+//		OnUpdate().
+//		CallURL(http.MethodPost, "https://example.com/hooks/orders").
+//		WhereIsGreaterThan("Amount", "1000").
+//		Where("(Status,eq,paid)~or(Status,eq,shipped)").
+//		Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Webhook/operation/db-table-webhook-create
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Webhook-Filter/operation/db-table-webhook-filter-create
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Webhook/operation/db-table-webhook-update
+func (t *Table) CreateWebhook(title string) *createWebhookBuilder {
+	b := &createWebhookBuilder{
+		table:     t,
+		title:     title,
+		operation: "insert",
+		method:    http.MethodPost,
+		body:      "{{ json data }}",
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.filterProvider = newFilterProvider(b)
+
+	return b
+}
+
+// OnInsert triggers the webhook after records are inserted.
+func (b *createWebhookBuilder) OnInsert() *createWebhookBuilder {
+	b.operation = "insert"
+	return b
+}
+
+// OnUpdate triggers the webhook after records are updated.
+func (b *createWebhookBuilder) OnUpdate() *createWebhookBuilder {
+	b.operation = "update"
+	return b
+}
+
+// OnDelete triggers the webhook after records are deleted.
+func (b *createWebhookBuilder) OnDelete() *createWebhookBuilder {
+	b.operation = "delete"
+	return b
+}
+
+// CallURL sets the request sent by the webhook.
+//
+// Parameters:
+//   - method: The HTTP method of the request (e.g. http.MethodPost).
+//   - url: The URL the request is sent to.
+func (b *createWebhookBuilder) CallURL(method string, url string) *createWebhookBuilder {
+	b.method = method
+	b.url = url
+	return b
+}
+
+// WithBody sets the template of the body of the request, which is "{{ json data }}" by default to
+// send the records as JSON.
+func (b *createWebhookBuilder) WithBody(template string) *createWebhookBuilder {
+	b.body = template
+	return b
+}
+
+// Execute finalizes and executes the operation.
+//
+// A webhook with filters is created inactive and activated once all its filters are created, so
+// it is never triggered without its condition. If the filters can't be created or the webhook
+// can't be activated, the webhook is deleted.
+func (b *createWebhookBuilder) Execute() (Webhook, error) {
+	if b.filterProvider.chainErr != nil {
+		return Webhook{}, fmt.Errorf("error in the chain of methods: %w", b.filterProvider.chainErr)
+	}
+	if b.title == "" {
+		return Webhook{}, fmt.Errorf("%w: missing webhook title", ErrInvalidQuery)
+	}
+	if b.url == "" {
+		return Webhook{}, fmt.Errorf("%w: missing webhook URL", ErrInvalidQuery)
+	}
+
+	ctx := b.contextProvider.ctx
+
+	var filters []hookFilter
+	if len(b.filterProvider.rawFilters) > 0 {
+		schema, err := b.table.ReadSchema().WithContext(ctx).Execute()
+		if err != nil {
+			return Webhook{}, fmt.Errorf("failed to read table schema: %w", err)
+		}
+
+		filters, err = parseHookFilters(b.filterProvider.rawFilters, schema)
+		if err != nil {
+			return Webhook{}, err
+		}
+	}
+
+	body := map[string]any{
+		"title":     b.title,
+		"event":     "after",
+		"operation": b.operation,
+		"active":    len(filters) == 0,
+		"condition": len(filters) > 0,
+		"version":   "v2",
+		"notification": map[string]any{
+			"type": "URL",
+			"payload": map[string]any{
+				"method": b.method,
+				"path":   b.url,
+				"body":   b.body,
+			},
+		},
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/tables/%s/hooks", b.table.tableID)
+	respBody, err := b.table.client.request(ctx, http.MethodPost, path, body, nil)
+	if err != nil {
+		return Webhook{}, withOperation(fmt.Errorf("failed to create webhook: %w", err), "CreateWebhook", b.table.tableID, RecordID{})
+	}
+
+	var hook Webhook
+	if err := json.Unmarshal(respBody, &hook); err != nil {
+		return Webhook{}, fmt.Errorf("failed to unmarshal webhook response: %w", err)
+	}
+
+	if len(filters) == 0 {
+		return hook, nil
+	}
+
+	hookPath := fmt.Sprintf("/api/v2/meta/hooks/%s", hook.ID)
+	err = createHookFilters(ctx, b.table.client, hook.ID, "", filters)
+	if err == nil {
+		if _, err = b.table.client.request(ctx, http.MethodPatch, hookPath, map[string]any{"active": true}, nil); err != nil {
+			err = fmt.Errorf("failed to activate webhook: %w", err)
+		}
+	}
+	if err != nil {
+		if _, deleteErr := b.table.client.request(ctx, http.MethodDelete, hookPath, nil, nil); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to delete webhook: %w", deleteErr))
+		}
+		return Webhook{}, withOperation(err, "CreateWebhook", b.table.tableID, RecordID{})
+	}
+
+	hook.Active = true
+	return hook, nil
+}
+
+// hookFilter is a filter of a webhook with the filters of its group, if it is one
+type hookFilter struct {
+	filter   ViewFilter
+	children []hookFilter
+}
+
+// createHookFilters creates the filters of a webhook, creating the groups before their children.
+func createHookFilters(ctx context.Context, client *Client, hookID string, parentID string, filters []hookFilter) error {
+	path := fmt.Sprintf("/api/v2/meta/hooks/%s/filters", hookID)
+
+	for _, f := range filters {
+		filter := f.filter
+		filter.ParentID = parentID
+
+		respBody, err := client.request(ctx, http.MethodPost, path, filter, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create webhook filter: %w", err)
+		}
+
+		if len(f.children) == 0 {
+			continue
+		}
+
+		var created ViewFilter
+		if err := json.Unmarshal(respBody, &created); err != nil {
+			return fmt.Errorf("failed to unmarshal webhook filter response: %w", err)
+		}
+		if err := createHookFilters(ctx, client, hookID, created.ID, f.children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseHookFilters converts the "where" expressions of a query to the filters of a webhook,
+// resolving the column titles to their identifiers with the schema of the table.
+//
+// Every expression is combined with AND, like in a query. Within an expression, the terms of a
+// level joined by "~and" and "~or" are converted to sibling filters with the same logical
+// operator. Since "~and" has higher precedence, the "~and" terms of a level that also has "~or"
+// terms are grouped, and so are expressions with "~or" terms.
+func parseHookFilters(where []string, schema TableSchema) ([]hookFilter, error) {
+	filters := []hookFilter{}
+
+	for _, expression := range where {
+		p := &hookFilterParser{input: expression, schema: schema}
+		siblings, err := p.parseExpression()
+		if err == nil && p.pos != len(p.input) {
+			err = fmt.Errorf("unexpected %q at position %d", p.input[p.pos:], p.pos)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid webhook condition %q: %w", ErrInvalidQuery, expression, err)
+		}
+
+		if len(siblings) > 1 && siblings[0].filter.LogicalOp == "or" {
+			siblings = []hookFilter{{filter: ViewFilter{IsGroup: true}, children: siblings}}
+		}
+		filters = append(filters, withLogicalOp(siblings, "and")...)
+	}
+
+	return filters, nil
+}
+
+// hookFilterParser is a recursive descent parser that converts "where" expressions to webhook filters
+type hookFilterParser struct {
+	input  string
+	pos    int
+	schema TableSchema
+}
+
+// consume advances the parser past the given token if the remaining input starts with it.
+func (p *hookFilterParser) consume(token string) bool {
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// parseExpression parses terms joined by "~and" and "~or" into sibling filters.
+func (p *hookFilterParser) parseExpression() ([]hookFilter, error) {
+	var ors [][]hookFilter
+	var ands []hookFilter
+
+	for {
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		ands = append(ands, term)
+
+		if p.consume("~and") {
+			continue
+		}
+		ors = append(ors, ands)
+		ands = nil
+		if !p.consume("~or") {
+			break
+		}
+	}
+
+	if len(ors) == 1 {
+		return withLogicalOp(ors[0], "and"), nil
+	}
+
+	filters := make([]hookFilter, 0, len(ors))
+	for _, terms := range ors {
+		if len(terms) == 1 {
+			filters = append(filters, terms[0])
+			continue
+		}
+		filters = append(filters, hookFilter{
+			filter:   ViewFilter{IsGroup: true},
+			children: withLogicalOp(terms, "and"),
+		})
+	}
+	return withLogicalOp(filters, "or"), nil
+}
+
+// parseTerm parses a parenthesized group or a single condition.
+func (p *hookFilterParser) parseTerm() (hookFilter, error) {
+	if strings.HasPrefix(p.input[p.pos:], "~not") {
+		return hookFilter{}, fmt.Errorf("\"~not\" is not supported at position %d", p.pos)
+	}
+	if !p.consume("(") {
+		return hookFilter{}, fmt.Errorf("expected \"(\" at position %d", p.pos)
+	}
+
+	if rest := p.input[p.pos:]; strings.HasPrefix(rest, "(") || strings.HasPrefix(rest, "~not") {
+		children, err := p.parseExpression()
+		if err != nil {
+			return hookFilter{}, err
+		}
+		if !p.consume(")") {
+			return hookFilter{}, fmt.Errorf("expected \")\" at position %d", p.pos)
+		}
+		if len(children) == 1 {
+			return children[0], nil
+		}
+		return hookFilter{filter: ViewFilter{IsGroup: true}, children: children}, nil
+	}
+
+	start := p.pos
+	depth := 0
+	for ; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth < 0 {
+			break
+		}
+	}
+	if p.pos >= len(p.input) {
+		return hookFilter{}, fmt.Errorf("unclosed condition at position %d", start)
+	}
+
+	raw := p.input[start:p.pos]
+	p.pos++
+
+	filter, err := p.condition(raw)
+	if err != nil {
+		return hookFilter{}, err
+	}
+	return hookFilter{filter: filter}, nil
+}
+
+// condition converts a "column,operator,value" comparison to a webhook filter.
+func (p *hookFilterParser) condition(raw string) (ViewFilter, error) {
+	parts := strings.SplitN(raw, ",", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return ViewFilter{}, fmt.Errorf("invalid condition %q", raw)
+	}
+
+	column, ok := p.schema.Column(parts[0])
+	if !ok {
+		return ViewFilter{}, fmt.Errorf("unknown column %q", parts[0])
+	}
+
+	filter := ViewFilter{ColumnID: column.ID, ComparisonOp: parts[1]}
+	value := ""
+	if len(parts) == 3 {
+		value = parts[2]
+	}
+
+	switch parts[1] {
+	case "is", "isnot":
+		// The meta API has dedicated operators for the null and boolean comparisons
+		ops := map[string][2]string{
+			"null":  {"blank", "notblank"},
+			"true":  {"checked", "notchecked"},
+			"false": {"notchecked", "checked"},
+		}[value]
+		if ops[0] == "" {
+			return ViewFilter{}, fmt.Errorf("invalid value %q for %q in condition %q", value, parts[1], raw)
+		}
+		filter.ComparisonOp = ops[0]
+		if parts[1] == "isnot" {
+			filter.ComparisonOp = ops[1]
+		}
+	case "within":
+		subOp, subValue, _ := strings.Cut(value, ",")
+		filter.ComparisonOp = "isWithin"
+		filter.ComparisonSubOp = subOp
+		if subValue != "" {
+			filter.Value = subValue
+		}
+	default:
+		// Date comparisons take their sub-operation as the first part of the value, which the meta
+		// API expects in its own field
+		if slices.Contains(dateColumnTypes, column.UIDT) {
+			if subOp, subValue, _ := strings.Cut(value, ","); slices.Contains(dateComparisonSubOps, subOp) {
+				filter.ComparisonSubOp = subOp
+				value = subValue
+			}
+		}
+		if value != "" {
+			filter.Value = value
+		}
+	}
+
+	return filter, nil
+}
+
+// dateColumnTypes are the column types compared with the date sub-operations
+var dateColumnTypes = []ColumnType{ColumnTypeDate, ColumnTypeDateTime, ColumnTypeCreatedTime, ColumnTypeLastModifiedTime}
+
+// dateComparisonSubOps are the sub-operations of the comparisons of dates other than within
+var dateComparisonSubOps = []string{
+	"today", "tomorrow", "yesterday", "oneWeekAgo", "oneWeekFromNow", "oneMonthAgo", "oneMonthFromNow",
+	"daysAgo", "daysFromNow", "exactDate",
+}
+
+// withLogicalOp sets the logical operator of the given sibling filters.
+func withLogicalOp(filters []hookFilter, op string) []hookFilter {
+	for i := range filters {
+		filters[i].filter.LogicalOp = op
+	}
+	return filters
+}
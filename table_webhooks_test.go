@@ -0,0 +1,129 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	var hook map[string]any
+	var filters []ViewFilter
+	var requests []string
+	deleted := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/tables/orders", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"orders","columns":[
+			{"id":"cl_amount","title":"Amount","uidt":"Decimal"},
+			{"id":"cl_status","title":"Status","uidt":"SingleSelect"},
+			{"id":"cl_paid","title":"Paid","uidt":"Checkbox"}
+		]}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/tables/orders/hooks", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "create")
+		_ = json.NewDecoder(r.Body).Decode(&hook)
+		_, _ = w.Write([]byte(`{"id":"hk_1","title":"Big orders","event":"after","operation":"update","active":0,"condition":1}`))
+	})
+	mux.HandleFunc("PATCH /api/v2/meta/hooks/hk_1", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, fmt.Sprintf("update active=%v", body["active"]))
+		_, _ = w.Write([]byte(`{"id":"hk_1","active":1}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/hooks/hk_1/filters", func(w http.ResponseWriter, r *http.Request) {
+		var filter ViewFilter
+		_ = json.NewDecoder(r.Body).Decode(&filter)
+		filter.ID = fmt.Sprintf("fi_%d", len(filters)+1)
+		filters = append(filters, filter)
+		requests = append(requests, "filter")
+		_ = json.NewEncoder(w).Encode(filter)
+	})
+	mux.HandleFunc("DELETE /api/v2/meta/hooks/hk_1", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	orders := client.Table("orders")
+
+	created, err := orders.CreateWebhook("Big orders").
+		OnUpdate().
+		CallURL(http.MethodPost, "https://example.com/hooks").
+		WhereIsGreaterThan("Amount", "1000").
+		Where("(Status,eq,paid)~or((Status,eq,shipped)~and(Paid,is,true))").
+		Execute()
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+	if created.ID != "hk_1" || !created.Active || !created.Condition {
+		t.Errorf("CreateWebhook() = %+v", created)
+	}
+	if hook["operation"] != "update" || hook["active"] != false || hook["condition"] != true || hook["notification"].(map[string]any)["payload"].(map[string]any)["path"] != "https://example.com/hooks" {
+		t.Errorf("CreateWebhook() sent %v", hook)
+	}
+
+	want := []ViewFilter{
+		{ID: "fi_1", ColumnID: "cl_amount", ComparisonOp: "gt", LogicalOp: "and", Value: "1000"},
+		{ID: "fi_2", LogicalOp: "and", IsGroup: true},
+		{ID: "fi_3", ParentID: "fi_2", ColumnID: "cl_status", ComparisonOp: "eq", LogicalOp: "or", Value: "paid"},
+		{ID: "fi_4", ParentID: "fi_2", LogicalOp: "or", IsGroup: true},
+		{ID: "fi_5", ParentID: "fi_4", ColumnID: "cl_status", ComparisonOp: "eq", LogicalOp: "and", Value: "shipped"},
+		{ID: "fi_6", ParentID: "fi_4", ColumnID: "cl_paid", ComparisonOp: "checked", LogicalOp: "and"},
+	}
+	if !reflect.DeepEqual(filters, want) {
+		t.Errorf("CreateWebhook() filters =\n%+v\nwant\n%+v", filters, want)
+	}
+	if deleted {
+		t.Errorf("CreateWebhook() deleted the webhook")
+	}
+
+	// The webhook is only activated once all its filters exist
+	wantRequests := []string{"create", "filter", "filter", "filter", "filter", "filter", "filter", "update active=true"}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("CreateWebhook() requests = %v, want %v", requests, wantRequests)
+	}
+
+	_, err = orders.CreateWebhook("Unknown").CallURL(http.MethodPost, "https://example.com/hooks").WhereIsEqualTo("Missing", "1").Execute()
+	if !errors.Is(err, ErrInvalidQuery) {
+		t.Errorf("CreateWebhook() error = %v, want %v", err, ErrInvalidQuery)
+	}
+}
+
+func TestParseHookFilters(t *testing.T) {
+	schema := TableSchema{Columns: []Column{{ID: "cl_date", Title: "Date", UIDT: ColumnTypeDate}, {ID: "cl_name", Title: "Name"}}}
+
+	filters, err := parseHookFilters([]string{
+		"(Date,within,pastNumberOfDays,7)~and(Name,isnot,null)",
+		"(Name,btw,a,c)",
+		"(Date,eq,exactDate,2024-01-01)~and(Date,lt,today)~and(Name,eq,today)",
+	}, schema)
+	if err != nil {
+		t.Fatalf("parseHookFilters() error = %v", err)
+	}
+	want := []hookFilter{
+		{filter: ViewFilter{ColumnID: "cl_date", ComparisonOp: "isWithin", ComparisonSubOp: "pastNumberOfDays", LogicalOp: "and", Value: "7"}},
+		{filter: ViewFilter{ColumnID: "cl_name", ComparisonOp: "notblank", LogicalOp: "and"}},
+		{filter: ViewFilter{ColumnID: "cl_name", ComparisonOp: "btw", LogicalOp: "and", Value: "a,c"}},
+		{filter: ViewFilter{ColumnID: "cl_date", ComparisonOp: "eq", ComparisonSubOp: "exactDate", LogicalOp: "and", Value: "2024-01-01"}},
+		{filter: ViewFilter{ColumnID: "cl_date", ComparisonOp: "lt", ComparisonSubOp: "today", LogicalOp: "and"}},
+		{filter: ViewFilter{ColumnID: "cl_name", ComparisonOp: "eq", LogicalOp: "and", Value: "today"}},
+	}
+	if !reflect.DeepEqual(filters, want) {
+		t.Errorf("parseHookFilters() =\n%+v\nwant\n%+v", filters, want)
+	}
+
+	for _, where := range []string{"~not(Name,eq,a)", "(Name,eq,a", "(Name,is,maybe)", "(Name,eq,a)~xor(Name,eq,b)"} {
+		if _, err := parseHookFilters([]string{where}, schema); !errors.Is(err, ErrInvalidQuery) {
+			t.Errorf("parseHookFilters(%q) error = %v, want %v", where, err, ErrInvalidQuery)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package nocodbgo
+
+// View represents a view of a table in NocoDB and provides methods for configuring it through the meta API
+type View struct {
+	client *Client
+	viewID string
+
+	// viewType is the type of the view when known, zero otherwise
+	viewType int
+}
+
+// View returns a new View instance for the specified view ID.
+//
+// The view ID can be found in the URL of the view in the NocoDB user interface (e.g. "vw_xxxxxxxxxxxxxx").
+func (c *Client) View(viewID string) *View {
+	return &View{
+		client: c,
+		viewID: viewID,
+	}
+}
@@ -0,0 +1,237 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ViewFilter is a filter persisted in a view
+type ViewFilter struct {
+	// ID is the unique identifier of the filter, empty for filters not yet created
+	ID string `json:"id,omitempty"`
+	// ColumnID is the identifier of the column the filter applies to, empty for groups
+	ColumnID string `json:"fk_column_id,omitempty"`
+	// ParentID is the identifier of the filter group the filter belongs to, empty for top level filters
+	ParentID string `json:"fk_parent_id,omitempty"`
+	// ComparisonOp is the comparison operator (e.g. "eq", "like", "gt", "blank")
+	ComparisonOp string `json:"comparison_op,omitempty"`
+	// ComparisonSubOp is the comparison sub operator used by date filters (e.g. "today", "exactDate")
+	ComparisonSubOp string `json:"comparison_sub_op,omitempty"`
+	// LogicalOp is the logical operator used to combine the filter with its siblings ("and", "or" or "not")
+	LogicalOp string `json:"logical_op,omitempty"`
+	// Value is the value compared against, can be nil for some operators
+	Value any `json:"value,omitempty"`
+	// IsGroup indicates if the filter is a group of filters
+	IsGroup bool `json:"is_group,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for ViewFilter.
+// It handles the group flag being returned either as a boolean, as a 0/1 integer or as null.
+func (f *ViewFilter) UnmarshalJSON(data []byte) error {
+	type Alias ViewFilter
+	var raw struct {
+		Alias
+		IsGroup any `json:"is_group"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal view filter: %w", err)
+	}
+
+	*f = ViewFilter(raw.Alias)
+	f.IsGroup = metaBool(raw.IsGroup)
+
+	return nil
+}
+
+// listViewFiltersBuilder is used to build a query that lists the filters of a view with a fluent API
+type listViewFiltersBuilder struct {
+	view          *View
+	filterGroupID string
+
+	contextProvider[*listViewFiltersBuilder]
+}
+
+// ListFilters lists the top level filters persisted in the view.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Filter/operation/db-table-filter-read
+func (v *View) ListFilters() *listViewFiltersBuilder {
+	b := &listViewFiltersBuilder{
+		view: v,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// ListFilterChildren lists the filters that belong to a filter group of the view.
+//
+// Parameters:
+//   - filterGroupID: The identifier of the filter group.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Filter/operation/db-table-filter-children-read
+func (v *View) ListFilterChildren(filterGroupID string) *listViewFiltersBuilder {
+	b := v.ListFilters()
+	b.filterGroupID = filterGroupID
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listViewFiltersBuilder) Execute() ([]ViewFilter, error) {
+	path := fmt.Sprintf("/api/v2/meta/views/%s/filters", b.view.viewID)
+	if b.filterGroupID != "" {
+		path = fmt.Sprintf("/api/v2/meta/filters/%s/children", b.filterGroupID)
+	}
+
+	respBody, err := b.view.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list view filters: %w", err)
+	}
+
+	var response struct {
+		List []ViewFilter `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal view filters response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// createViewFilterBuilder is used to build a filter creation with a fluent API
+type createViewFilterBuilder struct {
+	view   *View
+	filter ViewFilter
+
+	contextProvider[*createViewFilterBuilder]
+}
+
+// CreateFilter persists a new filter in the view.
+//
+// Parameters:
+//   - filter: The filter to create, the ID is ignored.
+//
+// Example:
+//
+//	filter, err := view.CreateFilter(nocodbgo.ViewFilter{
+//		ColumnID:     "cl_xxxxxxxxxxxxxx",
+//		ComparisonOp: "eq",
+//		LogicalOp:    "and",
+//		Value:        "Active",
+//	}).Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Filter/operation/db-table-filter-create
+func (v *View) CreateFilter(filter ViewFilter) *createViewFilterBuilder {
+	b := &createViewFilterBuilder{
+		view:   v,
+		filter: filter,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createViewFilterBuilder) Execute() (ViewFilter, error) {
+	filter := b.filter
+	filter.ID = ""
+
+	path := fmt.Sprintf("/api/v2/meta/views/%s/filters", b.view.viewID)
+	respBody, err := b.view.client.request(b.contextProvider.ctx, http.MethodPost, path, filter, nil)
+	if err != nil {
+		return ViewFilter{}, fmt.Errorf("failed to create view filter: %w", err)
+	}
+
+	var response ViewFilter
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return ViewFilter{}, fmt.Errorf("failed to unmarshal view filter response: %w", err)
+	}
+
+	return response, nil
+}
+
+// updateViewFilterBuilder is used to build a filter update with a fluent API
+type updateViewFilterBuilder struct {
+	view   *View
+	filter ViewFilter
+
+	contextProvider[*updateViewFilterBuilder]
+}
+
+// UpdateFilter updates a filter persisted in the view.
+//
+// Parameters:
+//   - filter: The filter with its new values, the ID is required.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Filter/operation/db-table-filter-update
+func (v *View) UpdateFilter(filter ViewFilter) *updateViewFilterBuilder {
+	b := &updateViewFilterBuilder{
+		view:   v,
+		filter: filter,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *updateViewFilterBuilder) Execute() error {
+	if b.filter.ID == "" {
+		return ErrFilterIDRequired
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/filters/%s", b.filter.ID)
+	if _, err := b.view.client.request(b.contextProvider.ctx, http.MethodPatch, path, b.filter, nil); err != nil {
+		return fmt.Errorf("failed to update view filter: %w", err)
+	}
+
+	return nil
+}
+
+// deleteViewFilterBuilder is used to build a filter deletion with a fluent API
+type deleteViewFilterBuilder struct {
+	view     *View
+	filterID string
+
+	contextProvider[*deleteViewFilterBuilder]
+}
+
+// DeleteFilter deletes a filter persisted in the view.
+//
+// Parameters:
+//   - filterID: The identifier of the filter to delete.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Filter/operation/db-table-filter-delete
+func (v *View) DeleteFilter(filterID string) *deleteViewFilterBuilder {
+	b := &deleteViewFilterBuilder{
+		view:     v,
+		filterID: filterID,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *deleteViewFilterBuilder) Execute() error {
+	if b.filterID == "" {
+		return ErrFilterIDRequired
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/filters/%s", b.filterID)
+	if _, err := b.view.client.request(b.contextProvider.ctx, http.MethodDelete, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete view filter: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestViewFilterUnmarshalJSON(t *testing.T) {
+	data := `{"id":"fi_1","fk_column_id":null,"fk_parent_id":null,"comparison_op":null,"logical_op":"or","is_group":1,"value":null}`
+
+	var filter ViewFilter
+	if err := json.Unmarshal([]byte(data), &filter); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	want := ViewFilter{ID: "fi_1", LogicalOp: "or", IsGroup: true}
+	if filter != want {
+		t.Errorf("Unmarshal() = %+v, want %+v", filter, want)
+	}
+}
+
+func TestViewFilters(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/meta/views/vw_1/filters", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["id"]; ok {
+			t.Errorf("create body = %v, want no id", body)
+		}
+		body["id"] = "fi_new"
+		_ = json.NewEncoder(w).Encode(body)
+	})
+	mux.HandleFunc("GET /api/v2/meta/views/vw_1/filters", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"id":"fi_1","fk_column_id":"cl_1","comparison_op":"eq","value":"foo","is_group":null}]}`))
+	})
+	mux.HandleFunc("DELETE /api/v2/meta/filters/fi_1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`true`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	view := client.View("vw_1")
+
+	created, err := view.CreateFilter(ViewFilter{ID: "ignored", ColumnID: "cl_1", ComparisonOp: "eq", Value: "foo"}).Execute()
+	if err != nil {
+		t.Fatalf("CreateFilter() error = %v", err)
+	}
+	if created.ID != "fi_new" || created.ColumnID != "cl_1" {
+		t.Errorf("CreateFilter() = %+v", created)
+	}
+
+	filters, err := view.ListFilters().Execute()
+	if err != nil {
+		t.Fatalf("ListFilters() error = %v", err)
+	}
+	if len(filters) != 1 || filters[0].Value != "foo" {
+		t.Errorf("ListFilters() = %+v", filters)
+	}
+
+	if err := view.DeleteFilter("fi_1").Execute(); err != nil {
+		t.Errorf("DeleteFilter() error = %v", err)
+	}
+	if err := view.UpdateFilter(ViewFilter{}).Execute(); err != ErrFilterIDRequired {
+		t.Errorf("UpdateFilter() without ID error = %v, want %v", err, ErrFilterIDRequired)
+	}
+}
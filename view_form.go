@@ -0,0 +1,406 @@
+package nocodbgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// viewTypeForm is the type of form views in the meta API
+	viewTypeForm = 1
+)
+
+// createFormViewBuilder is used to build a form view creation with a fluent API
+type createFormViewBuilder struct {
+	table *Table
+	title string
+
+	contextProvider[*createFormViewBuilder]
+}
+
+// CreateFormView creates a new form view in the table and returns it, so it can be configured
+// with ConfigureForm and shared with Share.
+//
+// Parameters:
+//   - title: The title of the form view.
+//
+// Example:
+//
+//	form, err := table.CreateFormView("Contact us").Execute()
+//	// Handle error
+//	err = form.ConfigureForm().Heading("Contact us").Required("cl_email").Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-form-create
+func (t *Table) CreateFormView(title string) *createFormViewBuilder {
+	b := &createFormViewBuilder{
+		table: t,
+		title: title,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createFormViewBuilder) Execute() (*View, error) {
+	body := map[string]any{
+		"title": b.title,
+		"type":  viewTypeForm,
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/tables/%s/forms", b.table.tableID)
+	respBody, err := b.table.client.request(b.contextProvider.ctx, http.MethodPost, path, body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create form view: %w", err)
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal form view response: %w", err)
+	}
+
+	view := b.table.client.View(response.ID)
+	view.viewType = viewTypeForm
+
+	return view, nil
+}
+
+// FormView describes a form view as returned by the meta API
+type FormView struct {
+	// ID is the unique identifier of the view
+	ID string
+	// Title is the title of the view
+	Title string
+	// Heading is the heading shown at the top of the form
+	Heading string
+	// Subheading is the text shown under the heading
+	Subheading string
+	// SuccessMessage is the message shown after the form is submitted
+	SuccessMessage string
+	// RedirectURL is the URL the user is redirected to after the form is submitted
+	RedirectURL string
+	// Columns are the fields of the form
+	Columns []FormColumn
+}
+
+// FormColumn describes how a column of the table is displayed in a form view
+type FormColumn struct {
+	// ID is the unique identifier of the form view column
+	ID string
+	// ColumnID is the identifier of the table column it displays
+	ColumnID string
+	// Label is the label shown instead of the column title, empty to use the title
+	Label string
+	// Description is the help text shown under the field
+	Description string
+	// Required indicates if the field must be filled to submit the form
+	Required bool
+	// Show indicates if the field is shown in the form
+	Show bool
+	// Order is the position of the field in the form
+	Order float64
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for FormView.
+// It handles the nullable texts returned by the meta API.
+func (f *FormView) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID          string       `json:"id"`
+		Title       string       `json:"title"`
+		Heading     *string      `json:"heading"`
+		Subheading  *string      `json:"subheading"`
+		SuccessMsg  *string      `json:"success_msg"`
+		RedirectURL *string      `json:"redirect_url"`
+		Columns     []FormColumn `json:"columns"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal form view: %w", err)
+	}
+
+	*f = FormView{
+		ID:             raw.ID,
+		Title:          raw.Title,
+		Heading:        stringOrEmpty(raw.Heading),
+		Subheading:     stringOrEmpty(raw.Subheading),
+		SuccessMessage: stringOrEmpty(raw.SuccessMsg),
+		RedirectURL:    stringOrEmpty(raw.RedirectURL),
+		Columns:        raw.Columns,
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for FormColumn.
+// It handles the boolean flags being returned either as booleans, as 0/1 integers or as null.
+func (c *FormColumn) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID          string  `json:"id"`
+		ColumnID    string  `json:"fk_column_id"`
+		Label       *string `json:"label"`
+		Description *string `json:"description"`
+		Required    any     `json:"required"`
+		Show        any     `json:"show"`
+		Order       float64 `json:"order"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal form column: %w", err)
+	}
+
+	*c = FormColumn{
+		ID:          raw.ID,
+		ColumnID:    raw.ColumnID,
+		Label:       stringOrEmpty(raw.Label),
+		Description: stringOrEmpty(raw.Description),
+		Required:    metaBool(raw.Required),
+		Show:        metaBool(raw.Show),
+		Order:       raw.Order,
+	}
+
+	return nil
+}
+
+// readFormBuilder is used to build a query that reads a form view with a fluent API
+type readFormBuilder struct {
+	view *View
+
+	contextProvider[*readFormBuilder]
+}
+
+// ReadForm reads the configuration of a form view, including its fields.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-form-read
+func (v *View) ReadForm() *readFormBuilder {
+	b := &readFormBuilder{
+		view: v,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *readFormBuilder) Execute() (FormView, error) {
+	return b.view.readForm(b.contextProvider.ctx)
+}
+
+// readForm fetches the form view configuration.
+func (v *View) readForm(ctx context.Context) (FormView, error) {
+	path := fmt.Sprintf("/api/v2/meta/forms/%s", v.viewID)
+	respBody, err := v.client.request(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return FormView{}, fmt.Errorf("failed to read form view: %w", err)
+	}
+
+	var response FormView
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return FormView{}, fmt.Errorf("failed to unmarshal form view response: %w", err)
+	}
+
+	return response, nil
+}
+
+// configureFormBuilder is used to change the settings and fields of a form view with a fluent API
+type configureFormBuilder struct {
+	view     *View
+	settings map[string]any
+	columns  map[string]map[string]any
+	order    []string
+
+	contextProvider[*configureFormBuilder]
+}
+
+// ConfigureForm initializes a builder that changes the settings and fields of a form view.
+//
+// Fields are referenced by the identifier of the table column (e.g. "cl_xxxxxxxxxxxxxx"), and only
+// the fields with changes are updated.
+//
+// Example:
+//
+//	err := view.ConfigureForm().
+//		Heading("Contact us").
+//		SuccessMessage("Thanks, we will get back to you soon").
+//		Show("cl_name", "cl_email", "cl_message").
+//		Order("cl_name", "cl_email", "cl_message").
+//		Label("cl_message", "How can we help?").
+//		Required("cl_email", "cl_message").
+//		Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-form-update
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-form-column-update
+func (v *View) ConfigureForm() *configureFormBuilder {
+	b := &configureFormBuilder{
+		view:     v,
+		settings: map[string]any{},
+		columns:  map[string]map[string]any{},
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Heading sets the heading shown at the top of the form.
+func (b *configureFormBuilder) Heading(heading string) *configureFormBuilder {
+	b.settings["heading"] = heading
+	return b
+}
+
+// Subheading sets the text shown under the heading of the form.
+func (b *configureFormBuilder) Subheading(subheading string) *configureFormBuilder {
+	b.settings["subheading"] = subheading
+	return b
+}
+
+// SuccessMessage sets the message shown after the form is submitted.
+func (b *configureFormBuilder) SuccessMessage(message string) *configureFormBuilder {
+	b.settings["success_msg"] = message
+	return b
+}
+
+// RedirectURL sets the URL the user is redirected to after the form is submitted.
+func (b *configureFormBuilder) RedirectURL(url string) *configureFormBuilder {
+	b.settings["redirect_url"] = url
+	return b
+}
+
+// Show shows the given fields in the form.
+func (b *configureFormBuilder) Show(columnIDs ...string) *configureFormBuilder {
+	return b.setColumns("show", true, columnIDs)
+}
+
+// Hide hides the given fields from the form.
+func (b *configureFormBuilder) Hide(columnIDs ...string) *configureFormBuilder {
+	return b.setColumns("show", false, columnIDs)
+}
+
+// Required makes the given fields required to submit the form.
+func (b *configureFormBuilder) Required(columnIDs ...string) *configureFormBuilder {
+	return b.setColumns("required", true, columnIDs)
+}
+
+// Optional makes the given fields optional.
+func (b *configureFormBuilder) Optional(columnIDs ...string) *configureFormBuilder {
+	return b.setColumns("required", false, columnIDs)
+}
+
+// Label sets the label shown for a field instead of the column title.
+func (b *configureFormBuilder) Label(columnID string, label string) *configureFormBuilder {
+	return b.setColumns("label", label, []string{columnID})
+}
+
+// Description sets the help text shown under a field.
+func (b *configureFormBuilder) Description(columnID string, description string) *configureFormBuilder {
+	return b.setColumns("description", description, []string{columnID})
+}
+
+// Order places the given fields first in the form, in the given order. The rest of the fields
+// keep their relative order after them.
+func (b *configureFormBuilder) Order(columnIDs ...string) *configureFormBuilder {
+	b.order = columnIDs
+	return b
+}
+
+// setColumns sets a property of the given fields.
+func (b *configureFormBuilder) setColumns(property string, value any, columnIDs []string) *configureFormBuilder {
+	for _, columnID := range columnIDs {
+		if b.columns[columnID] == nil {
+			b.columns[columnID] = map[string]any{}
+		}
+		b.columns[columnID][property] = value
+	}
+	return b
+}
+
+// Execute finalizes and executes the operation.
+//
+// All the field identifiers are checked against the columns of the form before the first change,
+// so an unknown field returns ErrColumnNotFound without changing the view.
+func (b *configureFormBuilder) Execute() error {
+	ctx := b.contextProvider.ctx
+	view := b.view
+
+	updates, err := b.columnUpdates(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(b.settings) > 0 {
+		path := fmt.Sprintf("/api/v2/meta/forms/%s", view.viewID)
+		if _, err := view.client.request(ctx, http.MethodPatch, path, b.settings, nil); err != nil {
+			return fmt.Errorf("failed to update form view: %w", err)
+		}
+	}
+
+	for formColumnID, update := range updates {
+		path := fmt.Sprintf("/api/v2/meta/form-columns/%s", formColumnID)
+		if _, err := view.client.request(ctx, http.MethodPatch, path, update, nil); err != nil {
+			return fmt.Errorf("failed to update form column: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// columnUpdates reads the columns of the form and returns the changes of each form column, indexed
+// by its identifier, or ErrColumnNotFound if any field isn't a column of the form.
+func (b *configureFormBuilder) columnUpdates(ctx context.Context) (map[string]map[string]any, error) {
+	if len(b.columns) == 0 && len(b.order) == 0 {
+		return nil, nil
+	}
+
+	form, err := b.view.readForm(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byColumnID := make(map[string]FormColumn, len(form.Columns))
+	gridColumns := make([]GridColumn, 0, len(form.Columns))
+	for _, column := range form.Columns {
+		byColumnID[column.ColumnID] = column
+		gridColumns = append(gridColumns, GridColumn{ColumnID: column.ColumnID, Order: column.Order})
+	}
+
+	updates := map[string]map[string]any{}
+	for columnID, changes := range b.columns {
+		column, ok := byColumnID[columnID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrColumnNotFound, columnID)
+		}
+		updates[column.ID] = copyMap(changes)
+	}
+
+	for position, columnID := range gridColumnOrder(gridColumns, b.order) {
+		column, ok := byColumnID[columnID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrColumnNotFound, columnID)
+		}
+		if column.Order == float64(position+1) {
+			continue
+		}
+		if updates[column.ID] == nil {
+			updates[column.ID] = map[string]any{}
+		}
+		updates[column.ID]["order"] = position + 1
+	}
+
+	return updates, nil
+}
+
+// stringOrEmpty returns the value of a nullable string, or an empty string if it's nil.
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,100 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestFormView(t *testing.T) {
+	var mu sync.Mutex
+	updates := map[string]map[string]any{}
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		updates[r.URL.Path] = body
+		mu.Unlock()
+		_, _ = w.Write([]byte(`1`))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v2/meta/tables/md_1/forms", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"vw_form","type":1}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/forms/vw_form", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"vw_form","title":"Contact","heading":null,"columns":[
+			{"id":"fvc_1","fk_column_id":"cl_name","label":null,"required":null,"show":1,"order":1},
+			{"id":"fvc_2","fk_column_id":"cl_email","label":"Email","required":1,"show":0,"order":2}
+		]}`))
+	})
+	mux.HandleFunc("PATCH /api/v2/meta/forms/vw_form", record)
+	mux.HandleFunc("PATCH /api/v2/meta/form-columns/{id}", record)
+	mux.HandleFunc("POST /api/v2/meta/views/vw_form/share", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"uuid":"abc-123"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	form, err := client.Table("md_1").CreateFormView("Contact").Execute()
+	if err != nil {
+		t.Fatalf("CreateFormView() error = %v", err)
+	}
+
+	read, err := form.ReadForm().Execute()
+	if err != nil {
+		t.Fatalf("ReadForm() error = %v", err)
+	}
+	if len(read.Columns) != 2 || !read.Columns[1].Required || read.Columns[1].Label != "Email" || read.Columns[0].Required {
+		t.Errorf("ReadForm() columns = %+v", read.Columns)
+	}
+
+	err = form.ConfigureForm().
+		Heading("Contact us").
+		Show("cl_email").
+		Order("cl_email").
+		Label("cl_name", "Full name").
+		Execute()
+	if err != nil {
+		t.Fatalf("ConfigureForm() error = %v", err)
+	}
+
+	want := map[string]map[string]any{
+		"/api/v2/meta/forms/vw_form":      {"heading": "Contact us"},
+		"/api/v2/meta/form-columns/fvc_2": {"show": true, "order": float64(1)},
+		"/api/v2/meta/form-columns/fvc_1": {"label": "Full name", "order": float64(2)},
+	}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("updates = %v, want %v", updates, want)
+	}
+
+	for name, builder := range map[string]*configureFormBuilder{
+		"shown":   form.ConfigureForm().Heading("Changed").Show("cl_missing"),
+		"ordered": form.ConfigureForm().Heading("Changed").Order("cl_email", "cl_missing"),
+	} {
+		clear(updates)
+		if err := builder.Execute(); !errors.Is(err, ErrColumnNotFound) {
+			t.Errorf("ConfigureForm() with unknown %s field error = %v, want %v", name, err, ErrColumnNotFound)
+		}
+		if len(updates) != 0 {
+			t.Errorf("ConfigureForm() with unknown %s field sent updates %v, want none", name, updates)
+		}
+	}
+
+	share, err := form.Share().Execute()
+	if err != nil {
+		t.Fatalf("Share() error = %v", err)
+	}
+	if share.URL != server.URL+"/#/nc/form/abc-123" {
+		t.Errorf("Share() URL = %q", share.URL)
+	}
+}
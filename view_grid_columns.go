@@ -0,0 +1,290 @@
+package nocodbgo
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// listGridColumnsBuilder is used to build a query that lists the columns of a grid view with a fluent API
+type listGridColumnsBuilder struct {
+	view *View
+
+	contextProvider[*listGridColumnsBuilder]
+}
+
+// ListGridColumns lists the columns of a grid view with their visibility, order and width.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-grid-columns-list
+func (v *View) ListGridColumns() *listGridColumnsBuilder {
+	b := &listGridColumnsBuilder{
+		view: v,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// GridColumn describes how a column of the table is displayed in a grid view
+type GridColumn struct {
+	// ID is the unique identifier of the grid view column
+	ID string
+	// ColumnID is the identifier of the table column it displays
+	ColumnID string
+	// Show indicates if the column is visible in the view
+	Show bool
+	// Order is the position of the column in the view
+	Order float64
+	// Width is the width of the column (e.g. "200px")
+	Width string
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for GridColumn.
+// It handles the show flag being returned either as a boolean or as a 0/1 integer.
+func (c *GridColumn) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID       string  `json:"id"`
+		ColumnID string  `json:"fk_column_id"`
+		Show     any     `json:"show"`
+		Order    float64 `json:"order"`
+		Width    string  `json:"width"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal grid column: %w", err)
+	}
+
+	*c = GridColumn{
+		ID:       raw.ID,
+		ColumnID: raw.ColumnID,
+		Show:     metaBool(raw.Show),
+		Order:    raw.Order,
+		Width:    raw.Width,
+	}
+
+	return nil
+}
+
+// Execute finalizes and executes the operation.
+func (b *listGridColumnsBuilder) Execute() ([]GridColumn, error) {
+	return b.view.listGridColumns(b.contextProvider.ctx)
+}
+
+// listGridColumns fetches the columns of the grid view.
+func (v *View) listGridColumns(ctx context.Context) ([]GridColumn, error) {
+	path := fmt.Sprintf("/api/v2/meta/grids/%s/grid-columns", v.viewID)
+	respBody, err := v.client.request(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grid columns: %w", err)
+	}
+
+	var response []GridColumn
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal grid columns response: %w", err)
+	}
+
+	return response, nil
+}
+
+// configureGridColumnsBuilder is used to change the visibility, order and width of the columns of a
+// grid view with a fluent API
+type configureGridColumnsBuilder struct {
+	view    *View
+	showAll *bool
+	shown   map[string]bool
+	order   []string
+	widths  map[string]string
+
+	contextProvider[*configureGridColumnsBuilder]
+}
+
+// ConfigureGridColumns initializes a builder that changes how the columns of a grid view are displayed.
+//
+// Columns are referenced by the identifier of the table column (e.g. "cl_xxxxxxxxxxxxxx"), and only
+// the columns with changes are updated.
+//
+// Example:
+//
+//	err := client.View("vw_xxxxxxxxxxxxxx").
+//		ConfigureGridColumns().
+//		HideAll().
+//		Show("cl_name", "cl_email").
+//		Order("cl_email", "cl_name").
+//		Width("cl_email", "300px").
+//		Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-column-update
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-View/operation/db-view-grid-column-update
+func (v *View) ConfigureGridColumns() *configureGridColumnsBuilder {
+	b := &configureGridColumnsBuilder{
+		view:   v,
+		shown:  map[string]bool{},
+		widths: map[string]string{},
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// ShowAll shows all the columns of the view before applying the other changes.
+func (b *configureGridColumnsBuilder) ShowAll() *configureGridColumnsBuilder {
+	showAll := true
+	b.showAll = &showAll
+	return b
+}
+
+// HideAll hides all the columns of the view before applying the other changes.
+func (b *configureGridColumnsBuilder) HideAll() *configureGridColumnsBuilder {
+	showAll := false
+	b.showAll = &showAll
+	return b
+}
+
+// Show makes the given columns visible in the view.
+func (b *configureGridColumnsBuilder) Show(columnIDs ...string) *configureGridColumnsBuilder {
+	for _, columnID := range columnIDs {
+		b.shown[columnID] = true
+	}
+	return b
+}
+
+// Hide hides the given columns from the view.
+func (b *configureGridColumnsBuilder) Hide(columnIDs ...string) *configureGridColumnsBuilder {
+	for _, columnID := range columnIDs {
+		b.shown[columnID] = false
+	}
+	return b
+}
+
+// Order places the given columns first in the view, in the given order. The rest of the columns
+// keep their relative order after them.
+func (b *configureGridColumnsBuilder) Order(columnIDs ...string) *configureGridColumnsBuilder {
+	b.order = columnIDs
+	return b
+}
+
+// Width sets the width of a column (e.g. "200px").
+func (b *configureGridColumnsBuilder) Width(columnID string, width string) *configureGridColumnsBuilder {
+	b.widths[columnID] = width
+	return b
+}
+
+// Execute finalizes and executes the operation.
+//
+// All the column identifiers are checked against the columns of the view before the first change,
+// so an unknown column returns ErrColumnNotFound without leaving the view half configured.
+func (b *configureGridColumnsBuilder) Execute() error {
+	ctx := b.contextProvider.ctx
+	view := b.view
+
+	columns, err := view.listGridColumns(ctx)
+	if err != nil {
+		return err
+	}
+
+	byColumnID := make(map[string]GridColumn, len(columns))
+	for _, column := range columns {
+		byColumnID[column.ColumnID] = column
+	}
+
+	columnIDs := slices.Clone(b.order)
+	for columnID := range b.shown {
+		columnIDs = append(columnIDs, columnID)
+	}
+	for columnID := range b.widths {
+		columnIDs = append(columnIDs, columnID)
+	}
+	for _, columnID := range columnIDs {
+		if _, ok := byColumnID[columnID]; !ok {
+			return fmt.Errorf("%w: %s", ErrColumnNotFound, columnID)
+		}
+	}
+
+	updates := map[string]map[string]any{}
+	for columnID, show := range b.shown {
+		column := byColumnID[columnID]
+		if updates[column.ID] == nil {
+			updates[column.ID] = map[string]any{}
+		}
+		updates[column.ID]["show"] = show
+	}
+
+	for position, columnID := range gridColumnOrder(columns, b.order) {
+		column := byColumnID[columnID]
+		if column.Order == float64(position+1) {
+			continue
+		}
+		if updates[column.ID] == nil {
+			updates[column.ID] = map[string]any{}
+		}
+		updates[column.ID]["order"] = position + 1
+	}
+
+	if b.showAll != nil {
+		action := "hide-all"
+		if *b.showAll {
+			action = "show-all"
+		}
+		path := fmt.Sprintf("/api/v2/meta/views/%s/%s", view.viewID, action)
+		if _, err := view.client.request(ctx, http.MethodPost, path, nil, nil); err != nil {
+			return fmt.Errorf("failed to %s grid columns: %w", action, err)
+		}
+	}
+
+	for gridColumnID, update := range updates {
+		path := fmt.Sprintf("/api/v2/meta/views/%s/columns/%s", view.viewID, gridColumnID)
+		if _, err := view.client.request(ctx, http.MethodPatch, path, update, nil); err != nil {
+			return fmt.Errorf("failed to update grid column: %w", err)
+		}
+	}
+
+	for columnID, width := range b.widths {
+		path := fmt.Sprintf("/api/v2/meta/grid-columns/%s", byColumnID[columnID].ID)
+		body := map[string]any{"fk_column_id": columnID, "width": width}
+		if _, err := view.client.request(ctx, http.MethodPatch, path, body, nil); err != nil {
+			return fmt.Errorf("failed to update grid column width: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// gridColumnOrder returns the table column IDs in their new order, with the given columns first
+// and the rest keeping their current relative order. It returns nil when no order is given.
+func gridColumnOrder(columns []GridColumn, first []string) []string {
+	if len(first) == 0 {
+		return nil
+	}
+
+	ordered := make([]string, 0, len(columns))
+	placed := map[string]bool{}
+	for _, columnID := range first {
+		if !placed[columnID] {
+			placed[columnID] = true
+			ordered = append(ordered, columnID)
+		}
+	}
+
+	rest := make([]GridColumn, 0, len(columns))
+	for _, column := range columns {
+		if !placed[column.ColumnID] {
+			rest = append(rest, column)
+		}
+	}
+	slices.SortStableFunc(rest, func(a, b GridColumn) int {
+		return cmp.Compare(a.Order, b.Order)
+	})
+
+	for _, column := range rest {
+		ordered = append(ordered, column.ColumnID)
+	}
+
+	return ordered
+}
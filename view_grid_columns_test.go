@@ -0,0 +1,94 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestGridColumnOrder(t *testing.T) {
+	columns := []GridColumn{
+		{ColumnID: "a", Order: 1},
+		{ColumnID: "b", Order: 3},
+		{ColumnID: "c", Order: 2},
+		{ColumnID: "d", Order: 4},
+	}
+
+	got := gridColumnOrder(columns, []string{"d", "b", "d"})
+	want := []string{"d", "b", "a", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("gridColumnOrder() = %v, want %v", got, want)
+	}
+
+	if got := gridColumnOrder(columns, nil); got != nil {
+		t.Errorf("gridColumnOrder() without order = %v, want nil", got)
+	}
+}
+
+func TestConfigureGridColumns(t *testing.T) {
+	var mu sync.Mutex
+	updates := map[string]map[string]any{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/grids/vw_1/grid-columns", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id":"nc_1","fk_column_id":"cl_name","show":1,"order":1,"width":"200px"},
+			{"id":"nc_2","fk_column_id":"cl_email","show":0,"order":2,"width":"200px"}
+		]`))
+	})
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		updates[r.URL.Path] = body
+		mu.Unlock()
+		_, _ = w.Write([]byte(`1`))
+	}
+	mux.HandleFunc("PATCH /api/v2/meta/views/vw_1/columns/{id}", record)
+	mux.HandleFunc("PATCH /api/v2/meta/grid-columns/{id}", record)
+	mux.HandleFunc("POST /api/v2/meta/views/vw_1/{action}", record)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	err = client.View("vw_1").
+		ConfigureGridColumns().
+		Show("cl_email").
+		Order("cl_email").
+		Width("cl_name", "120px").
+		Execute()
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	want := map[string]map[string]any{
+		"/api/v2/meta/views/vw_1/columns/nc_2": {"show": true, "order": float64(1)},
+		"/api/v2/meta/views/vw_1/columns/nc_1": {"order": float64(2)},
+		"/api/v2/meta/grid-columns/nc_1":       {"fk_column_id": "cl_name", "width": "120px"},
+	}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("updates = %v, want %v", updates, want)
+	}
+
+	for name, builder := range map[string]*configureGridColumnsBuilder{
+		"hidden":  client.View("vw_1").ConfigureGridColumns().HideAll().Show("cl_name").Hide("cl_missing"),
+		"ordered": client.View("vw_1").ConfigureGridColumns().ShowAll().Show("cl_name").Order("cl_email", "cl_missing"),
+		"resized": client.View("vw_1").ConfigureGridColumns().Show("cl_name").Width("cl_missing", "120px"),
+	} {
+		clear(updates)
+		if err := builder.Execute(); !errors.Is(err, ErrColumnNotFound) {
+			t.Errorf("Execute() with unknown %s column error = %v, want %v", name, err, ErrColumnNotFound)
+		}
+		if len(updates) != 0 {
+			t.Errorf("Execute() with unknown %s column sent updates %v, want none", name, updates)
+		}
+	}
+}
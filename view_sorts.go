@@ -0,0 +1,182 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ViewSort is a sort persisted in a view
+type ViewSort struct {
+	// ID is the unique identifier of the sort, empty for sorts not yet created
+	ID string `json:"id,omitempty"`
+	// ColumnID is the identifier of the column to sort by
+	ColumnID string `json:"fk_column_id,omitempty"`
+	// Direction is the sort direction, "asc" or "desc"
+	Direction string `json:"direction,omitempty"`
+	// Order is the position of the sort among the sorts of the view
+	Order float64 `json:"order,omitempty"`
+}
+
+// listViewSortsBuilder is used to build a query that lists the sorts of a view with a fluent API
+type listViewSortsBuilder struct {
+	view *View
+
+	contextProvider[*listViewSortsBuilder]
+}
+
+// ListSorts lists the sorts persisted in the view.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Sort/operation/db-table-sort-list
+func (v *View) ListSorts() *listViewSortsBuilder {
+	b := &listViewSortsBuilder{
+		view: v,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listViewSortsBuilder) Execute() ([]ViewSort, error) {
+	path := fmt.Sprintf("/api/v2/meta/views/%s/sorts", b.view.viewID)
+	respBody, err := b.view.client.request(b.contextProvider.ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list view sorts: %w", err)
+	}
+
+	var response struct {
+		List []ViewSort `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal view sorts response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// createViewSortBuilder is used to build a sort creation with a fluent API
+type createViewSortBuilder struct {
+	view *View
+	sort ViewSort
+
+	contextProvider[*createViewSortBuilder]
+}
+
+// CreateSort persists a new sort in the view.
+//
+// Parameters:
+//   - sort: The sort to create, only the column and direction are used.
+//
+// Example:
+//
+//	err := view.CreateSort(nocodbgo.ViewSort{ColumnID: "cl_xxxxxxxxxxxxxx", Direction: "desc"}).Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Sort/operation/db-table-sort-create
+func (v *View) CreateSort(sort ViewSort) *createViewSortBuilder {
+	b := &createViewSortBuilder{
+		view: v,
+		sort: sort,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createViewSortBuilder) Execute() error {
+	body := ViewSort{ColumnID: b.sort.ColumnID, Direction: b.sort.Direction}
+
+	path := fmt.Sprintf("/api/v2/meta/views/%s/sorts", b.view.viewID)
+	if _, err := b.view.client.request(b.contextProvider.ctx, http.MethodPost, path, body, nil); err != nil {
+		return fmt.Errorf("failed to create view sort: %w", err)
+	}
+
+	return nil
+}
+
+// updateViewSortBuilder is used to build a sort update with a fluent API
+type updateViewSortBuilder struct {
+	view *View
+	sort ViewSort
+
+	contextProvider[*updateViewSortBuilder]
+}
+
+// UpdateSort updates a sort persisted in the view.
+//
+// Parameters:
+//   - sort: The sort with its new column and direction, the ID is required.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Sort/operation/db-table-sort-update
+func (v *View) UpdateSort(sort ViewSort) *updateViewSortBuilder {
+	b := &updateViewSortBuilder{
+		view: v,
+		sort: sort,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *updateViewSortBuilder) Execute() error {
+	if b.sort.ID == "" {
+		return ErrSortIDRequired
+	}
+
+	body := ViewSort{ColumnID: b.sort.ColumnID, Direction: b.sort.Direction}
+
+	path := fmt.Sprintf("/api/v2/meta/sorts/%s", b.sort.ID)
+	if _, err := b.view.client.request(b.contextProvider.ctx, http.MethodPatch, path, body, nil); err != nil {
+		return fmt.Errorf("failed to update view sort: %w", err)
+	}
+
+	return nil
+}
+
+// deleteViewSortBuilder is used to build a sort deletion with a fluent API
+type deleteViewSortBuilder struct {
+	view   *View
+	sortID string
+
+	contextProvider[*deleteViewSortBuilder]
+}
+
+// DeleteSort deletes a sort persisted in the view.
+//
+// Parameters:
+//   - sortID: The identifier of the sort to delete.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/DB-Table-Sort/operation/db-table-sort-delete
+func (v *View) DeleteSort(sortID string) *deleteViewSortBuilder {
+	b := &deleteViewSortBuilder{
+		view:   v,
+		sortID: sortID,
+	}
+
+	b.contextProvider = newContextProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *deleteViewSortBuilder) Execute() error {
+	if b.sortID == "" {
+		return ErrSortIDRequired
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/sorts/%s", b.sortID)
+	if _, err := b.view.client.request(b.contextProvider.ctx, http.MethodDelete, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete view sort: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package nocodbgo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestViewSorts(t *testing.T) {
+	var requests []string
+	record := func(response string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			request := r.Method + " " + r.URL.Path
+			if len(body) > 0 {
+				request += " " + string(body)
+			}
+			requests = append(requests, request)
+			_, _ = w.Write([]byte(response))
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/views/vw_1/sorts", record(`{"list":[{"id":"so_1","fk_column_id":"cl_1","direction":"desc","order":1}]}`))
+	mux.HandleFunc("POST /api/v2/meta/views/vw_1/sorts", record(`{"id":"so_2"}`))
+	mux.HandleFunc("PATCH /api/v2/meta/sorts/so_1", record(`1`))
+	mux.HandleFunc("DELETE /api/v2/meta/sorts/so_1", record(`1`))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	view := client.View("vw_1")
+
+	sorts, err := view.ListSorts().Execute()
+	if err != nil {
+		t.Fatalf("ListSorts() error = %v", err)
+	}
+	want := []ViewSort{{ID: "so_1", ColumnID: "cl_1", Direction: "desc", Order: 1}}
+	if !reflect.DeepEqual(sorts, want) {
+		t.Errorf("ListSorts() = %+v, want %+v", sorts, want)
+	}
+
+	// Only the column and the direction are sent
+	if err := view.CreateSort(ViewSort{ID: "ignored", ColumnID: "cl_2", Direction: "asc", Order: 3}).Execute(); err != nil {
+		t.Fatalf("CreateSort() error = %v", err)
+	}
+	if err := view.UpdateSort(ViewSort{ID: "so_1", ColumnID: "cl_1", Direction: "asc"}).Execute(); err != nil {
+		t.Fatalf("UpdateSort() error = %v", err)
+	}
+	if err := view.DeleteSort("so_1").Execute(); err != nil {
+		t.Fatalf("DeleteSort() error = %v", err)
+	}
+
+	wantRequests := []string{
+		"GET /api/v2/meta/views/vw_1/sorts",
+		`POST /api/v2/meta/views/vw_1/sorts {"fk_column_id":"cl_2","direction":"asc"}`,
+		`PATCH /api/v2/meta/sorts/so_1 {"fk_column_id":"cl_1","direction":"asc"}`,
+		"DELETE /api/v2/meta/sorts/so_1",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %q, want %q", requests, wantRequests)
+	}
+
+	requests = nil
+	if err := view.UpdateSort(ViewSort{ColumnID: "cl_1"}).Execute(); err != ErrSortIDRequired {
+		t.Errorf("UpdateSort() without ID error = %v, want %v", err, ErrSortIDRequired)
+	}
+	if err := view.DeleteSort("").Execute(); err != ErrSortIDRequired {
+		t.Errorf("DeleteSort() without ID error = %v, want %v", err, ErrSortIDRequired)
+	}
+	if len(requests) != 0 {
+		t.Errorf("requests without sort ID = %q, want none", requests)
+	}
+}
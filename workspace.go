@@ -0,0 +1,208 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Workspace represents a workspace of NocoDB Cloud and provides methods for working with it through
+// the meta API. Workspaces are only available in NocoDB Cloud, self-hosted servers respond to their
+// endpoints with an error.
+type Workspace struct {
+	client      *Client
+	workspaceID string
+}
+
+// Workspace returns a new Workspace instance for the specified workspace ID.
+//
+// The workspace ID can be found in the URL of the workspace in the NocoDB user interface (e.g. "w_xxxxxxxx").
+func (c *Client) Workspace(workspaceID string) *Workspace {
+	return &Workspace{
+		client:      c,
+		workspaceID: workspaceID,
+	}
+}
+
+// WorkspaceInfo describes a workspace of NocoDB Cloud
+type WorkspaceInfo struct {
+	// ID is the unique identifier of the workspace
+	ID string `json:"id"`
+	// Title is the name of the workspace
+	Title string `json:"title"`
+	// Description is the description of the workspace
+	Description string `json:"description"`
+	// OwnerID is the identifier of the user that owns the workspace
+	OwnerID string `json:"fk_user_id"`
+	// OrgID is the identifier of the organization the workspace belongs to, if any
+	OrgID string `json:"fk_org_id"`
+}
+
+// WorkspaceMember describes a user of a workspace
+type WorkspaceMember struct {
+	// UserID is the unique identifier of the user
+	UserID string `json:"fk_user_id"`
+	// Email is the email of the user
+	Email string `json:"email"`
+	// Roles is the role of the user in the workspace (e.g. "workspace-level-owner")
+	Roles string `json:"roles"`
+	// InviteAccepted indicates if the user accepted the invitation to the workspace
+	InviteAccepted bool `json:"invite_accepted"`
+}
+
+// listWorkspacesBuilder is used to build a query that lists the workspaces with a fluent API
+type listWorkspacesBuilder struct {
+	client *Client
+
+	contextProvider[*listWorkspacesBuilder]
+	headerProvider[*listWorkspacesBuilder]
+}
+
+// ListWorkspaces lists the workspaces of NocoDB Cloud the API token has access to.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Workspace/operation/workspace-list
+func (c *Client) ListWorkspaces() *listWorkspacesBuilder {
+	b := &listWorkspacesBuilder{
+		client: c,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listWorkspacesBuilder) Execute() ([]WorkspaceInfo, error) {
+	respBody, err := b.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, "/api/v2/meta/workspaces", nil, nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list workspaces: %w", err), "ListWorkspaces", "", RecordID{})
+	}
+
+	var response struct {
+		List []WorkspaceInfo `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workspaces response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// listWorkspaceMembersBuilder is used to build a query that lists the users of a workspace with a fluent API
+type listWorkspaceMembersBuilder struct {
+	workspace *Workspace
+
+	contextProvider[*listWorkspaceMembersBuilder]
+	headerProvider[*listWorkspaceMembersBuilder]
+}
+
+// ListMembers lists the users of the workspace, including the ones that haven't accepted their
+// invitation yet.
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Workspace-user/operation/workspace-user-list
+func (workspace *Workspace) ListMembers() *listWorkspaceMembersBuilder {
+	b := &listWorkspaceMembersBuilder{
+		workspace: workspace,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *listWorkspaceMembersBuilder) Execute() ([]WorkspaceMember, error) {
+	if b.workspace.workspaceID == "" {
+		return nil, ErrWorkspaceIDRequired
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/workspaces/%s/users", b.workspace.workspaceID)
+	respBody, err := b.workspace.client.requestWithHeader(b.contextProvider.ctx, http.MethodGet, path, nil, nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to list workspace members: %w", err), "ListWorkspaceMembers", "", RecordID{})
+	}
+
+	var response struct {
+		List []WorkspaceMember `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workspace members response: %w", err)
+	}
+
+	return response.List, nil
+}
+
+// createBaseBuilder is used to build a base creation operation with a fluent API
+type createBaseBuilder struct {
+	workspace   *Workspace
+	title       string
+	description string
+
+	contextProvider[*createBaseBuilder]
+	headerProvider[*createBaseBuilder]
+}
+
+// CreateBase creates a new base in the workspace and returns it, ready to work with its tables.
+//
+// Parameters:
+//   - title: The name of the base
+//
+// Example:
+//
+//	base, err := client.Workspace("w_xxxxxxxx").CreateBase("CRM").
+//		WithDescription("Customers and deals").
+//		Execute()
+//
+// Documentation:
+//   - https://meta-apis-v2.nocodb.com/#tag/Workspace-base/operation/workspace-base-create
+func (workspace *Workspace) CreateBase(title string) *createBaseBuilder {
+	b := &createBaseBuilder{
+		workspace: workspace,
+		title:     title,
+	}
+
+	b.contextProvider = newContextProvider(b)
+	b.headerProvider = newHeaderProvider(b)
+
+	return b
+}
+
+// WithDescription sets the description of the base.
+func (b *createBaseBuilder) WithDescription(description string) *createBaseBuilder {
+	b.description = description
+	return b
+}
+
+// Execute finalizes and executes the operation.
+func (b *createBaseBuilder) Execute() (*Base, error) {
+	if b.workspace.workspaceID == "" {
+		return nil, ErrWorkspaceIDRequired
+	}
+
+	body := map[string]any{"title": b.title}
+	if b.description != "" {
+		body["description"] = b.description
+	}
+
+	path := fmt.Sprintf("/api/v2/meta/workspaces/%s/bases", b.workspace.workspaceID)
+	respBody, err := b.workspace.client.requestWithHeader(b.contextProvider.ctx, http.MethodPost, path, body, nil, b.headerProvider.rawHeader)
+	if err != nil {
+		return nil, withOperation(fmt.Errorf("failed to create base: %w", err), "CreateBase", "", RecordID{})
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create base response: %w", err)
+	}
+	if response.ID == "" {
+		return nil, fmt.Errorf("failed to create base: the response has no base ID")
+	}
+
+	return b.workspace.client.Base(response.ID), nil
+}
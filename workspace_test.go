@@ -0,0 +1,66 @@
+package nocodbgo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWorkspace(t *testing.T) {
+	var created map[string]any
+	var tenant string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/meta/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Tenant-ID")
+		_, _ = w.Write([]byte(`{"list":[{"id":"w_1","title":"Acme","fk_user_id":"us_1"}],"pageInfo":{"isLastPage":true}}`))
+	})
+	mux.HandleFunc("GET /api/v2/meta/workspaces/w_1/users", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"list":[{"fk_user_id":"us_1","email":"ana@example.com","roles":"workspace-level-owner","invite_accepted":true}]}`))
+	})
+	mux.HandleFunc("POST /api/v2/meta/workspaces/w_1/bases", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&created)
+		_, _ = w.Write([]byte(`{"id":"p_new","title":"CRM"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient().WithBaseURL(server.URL).WithAPIToken("test-token").Create()
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	workspaces, err := client.ListWorkspaces().WithHeader("X-Tenant-ID", "acme").Execute()
+	if err != nil {
+		t.Fatalf("ListWorkspaces() error = %v", err)
+	}
+	if len(workspaces) != 1 || workspaces[0].ID != "w_1" || workspaces[0].OwnerID != "us_1" {
+		t.Errorf("ListWorkspaces() = %+v", workspaces)
+	}
+	if tenant != "acme" {
+		t.Errorf("ListWorkspaces() X-Tenant-ID header = %q, want acme", tenant)
+	}
+
+	workspace := client.Workspace("w_1")
+	members, err := workspace.ListMembers().Execute()
+	if err != nil {
+		t.Fatalf("ListMembers() error = %v", err)
+	}
+	if len(members) != 1 || members[0].Email != "ana@example.com" || !members[0].InviteAccepted {
+		t.Errorf("ListMembers() = %+v", members)
+	}
+
+	base, err := workspace.CreateBase("CRM").WithDescription("Customers").Execute()
+	if err != nil {
+		t.Fatalf("CreateBase() error = %v", err)
+	}
+	if base.ID() != "p_new" || created["title"] != "CRM" || created["description"] != "Customers" {
+		t.Errorf("CreateBase() = %q with body %v", base.ID(), created)
+	}
+
+	if _, err := client.Workspace("").ListMembers().Execute(); !errors.Is(err, ErrWorkspaceIDRequired) {
+		t.Errorf("ListMembers() without workspace error = %v, want %v", err, ErrWorkspaceIDRequired)
+	}
+}
@@ -0,0 +1,177 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// yamlNode is a JSON value decoded keeping the order of the keys of its objects
+type yamlNode struct {
+	scalar any
+	keys   []string
+	values []yamlNode
+	object bool
+	array  bool
+}
+
+// yamlPlainString matches the strings that can be written without quotes in YAML
+var yamlPlainString = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_ ./()-]*$`)
+
+// yamlReserved are the plain strings YAML reads as booleans or null
+var yamlReserved = []string{"true", "false", "yes", "no", "on", "off", "y", "n", "null"}
+
+// encodeYAML writes the JSON encoding of v as a YAML document, keeping the order of the fields.
+// It supports the subset of YAML needed to represent JSON values, so the output is also read by
+// any YAML parser as the same value.
+func encodeYAML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	node, err := decodeYAMLNode(decoder)
+	if err != nil {
+		return fmt.Errorf("failed to decode value: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if node.object && len(node.keys) > 0 {
+		writeYAMLObject(&buf, node, 0)
+	} else if node.array && len(node.values) > 0 {
+		writeYAMLArray(&buf, node, 0)
+	} else {
+		buf.WriteString(yamlInline(node) + "\n")
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// decodeYAMLNode decodes the next JSON value of the decoder.
+func decodeYAMLNode(decoder *json.Decoder) (yamlNode, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return yamlNode{}, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		node := yamlNode{object: true}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return yamlNode{}, err
+			}
+			value, err := decodeYAMLNode(decoder)
+			if err != nil {
+				return yamlNode{}, err
+			}
+			node.keys = append(node.keys, key.(string))
+			node.values = append(node.values, value)
+		}
+		_, err := decoder.Token()
+		return node, err
+	case json.Delim('['):
+		node := yamlNode{array: true}
+		for decoder.More() {
+			value, err := decodeYAMLNode(decoder)
+			if err != nil {
+				return yamlNode{}, err
+			}
+			node.values = append(node.values, value)
+		}
+		_, err := decoder.Token()
+		return node, err
+	}
+
+	return yamlNode{scalar: token}, nil
+}
+
+// yamlBlock reports if the node is written as an indented block instead of inline.
+func yamlBlock(node yamlNode) bool {
+	return (node.object || node.array) && len(node.values) > 0
+}
+
+// writeYAMLObject writes the keys of an object, one per line, at the given indentation.
+func writeYAMLObject(buf *bytes.Buffer, node yamlNode, indent int) {
+	for i, key := range node.keys {
+		if i > 0 || buf.Len() == 0 || buf.Bytes()[buf.Len()-1] == '\n' {
+			buf.WriteString(strings.Repeat(" ", indent))
+		}
+		writeYAMLEntry(buf, yamlString(key)+":", node.values[i], indent)
+	}
+}
+
+// writeYAMLArray writes the items of an array, one per line, at the given indentation.
+func writeYAMLArray(buf *bytes.Buffer, node yamlNode, indent int) {
+	for i, value := range node.values {
+		if i > 0 || buf.Len() == 0 || buf.Bytes()[buf.Len()-1] == '\n' {
+			buf.WriteString(strings.Repeat(" ", indent))
+		}
+		if value.object && len(value.keys) > 0 {
+			// The first key of an object goes on the line of the dash
+			buf.WriteString("- ")
+			writeYAMLObject(buf, value, indent+2)
+			continue
+		}
+		writeYAMLEntry(buf, "-", value, indent)
+	}
+}
+
+// writeYAMLEntry writes the value of a key or array item after its prefix.
+func writeYAMLEntry(buf *bytes.Buffer, prefix string, value yamlNode, indent int) {
+	switch {
+	case value.object && yamlBlock(value):
+		buf.WriteString(prefix + "\n")
+		writeYAMLObject(buf, value, indent+2)
+	case value.array && yamlBlock(value):
+		buf.WriteString(prefix + "\n")
+		writeYAMLArray(buf, value, indent+2)
+	default:
+		buf.WriteString(prefix + " " + yamlInline(value) + "\n")
+	}
+}
+
+// yamlInline returns the inline representation of a scalar or an empty object or array.
+func yamlInline(node yamlNode) string {
+	switch {
+	case node.object:
+		return "{}"
+	case node.array:
+		return "[]"
+	}
+
+	switch v := node.scalar.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		return yamlString(v)
+	}
+	return fmt.Sprint(node.scalar)
+}
+
+// yamlString returns the string as a plain scalar when possible, or double quoted otherwise.
+func yamlString(s string) string {
+	plain := yamlPlainString.MatchString(s) && !strings.HasSuffix(s, " ")
+	for _, reserved := range yamlReserved {
+		if strings.EqualFold(s, reserved) {
+			plain = false
+		}
+	}
+
+	if plain {
+		return s
+	}
+	return strconv.Quote(s)
+}
@@ -0,0 +1,30 @@
+package nocodbgo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeYAML(t *testing.T) {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{map[string]any{}, "{}\n"},
+		{[]any{}, "[]\n"},
+		{"yes", "\"yes\"\n"},
+		{[]any{"a", 1.5, nil, true, "", " padded", "line\nbreak", "10"}, "- a\n- 1.5\n- null\n- true\n- \"\"\n- \" padded\"\n- \"line\\nbreak\"\n- \"10\"\n"},
+		{[]any{[]any{"a"}, map[string]any{"k": []any{}}}, "-\n  - a\n- k: []\n"},
+		{map[string]any{"a b": map[string]any{"c": "#d"}}, "a b:\n  c: \"#d\"\n"},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := encodeYAML(&buf, tt.value); err != nil {
+			t.Fatalf("encodeYAML(%v) error = %v", tt.value, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("encodeYAML(%v) = %q, want %q", tt.value, buf.String(), tt.want)
+		}
+	}
+}